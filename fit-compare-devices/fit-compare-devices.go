@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-compare-devices compares two recordings of the same activity, for
+// example from a watch and a head unit, and reports how far apart their
+// sensors were.
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var offsetFlag = flag.String("offset", "auto", "Time to add to the second file's timestamps, e.g. 3s, or auto to estimate it")
+var alignField = flag.String("align", "HeartRate", "Record field used to estimate the offset: HeartRate or Speed")
+var maxOffset = flag.Duration("max-offset", time.Minute, "Largest offset to consider when estimating it")
+var csvOut = flag.String("csv", "", "Write the paired samples as CSV to this path")
+
+// Record fields compared between the two files
+var compareFields = []string{
+	"HeartRate",
+	"Power",
+	"Speed",
+	"Altitude",
+	"Distance",
+}
+
+// Estimating the offset needs at least this many overlapping samples
+const minOverlap = 30
+
+func decodeActivity(path string) (*fit.ActivityFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fitf, err := cli.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	activity, err := fitf.Activity()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return activity, nil
+}
+
+// fieldValue returns the physical value of a record field, or false if it's
+// invalid.
+func fieldValue(r *fit.RecordMsg, field string) (float64, bool) {
+	info, ok := fitdump.FieldInfo("Record", field)
+	if !ok {
+		return 0, false
+	}
+
+	v := reflect.ValueOf(r).Elem().FieldByName(field)
+	if info.IsInvalid(v) {
+		return 0, false
+	}
+	return info.Value(v)
+}
+
+// bySecond indexes records by their Unix timestamp
+func bySecond(records []*fit.RecordMsg) map[int64]*fit.RecordMsg {
+	m := make(map[int64]*fit.RecordMsg, len(records))
+	for _, r := range records {
+		m[r.Timestamp.Unix()] = r
+	}
+	return m
+}
+
+// correlation returns the Pearson correlation of field between the records
+// of a and those of b shifted by offset seconds, and the number of samples
+// it was computed from.
+func correlation(a []*fit.RecordMsg, b map[int64]*fit.RecordMsg, field string, offset int64) (float64, int) {
+	var sx, sy, sxx, syy, sxy float64
+	n := 0
+	for _, ra := range a {
+		rb, ok := b[ra.Timestamp.Unix()-offset]
+		if !ok {
+			continue
+		}
+		x, okx := fieldValue(ra, field)
+		y, oky := fieldValue(rb, field)
+		if !okx || !oky {
+			continue
+		}
+		sx += x
+		sy += y
+		sxx += x * x
+		syy += y * y
+		sxy += x * y
+		n++
+	}
+
+	if n < minOverlap {
+		return math.NaN(), n
+	}
+
+	fn := float64(n)
+	cov := sxy - sx*sy/fn
+	vx := sxx - sx*sx/fn
+	vy := syy - sy*sy/fn
+	if vx <= 0 || vy <= 0 {
+		return math.NaN(), n
+	}
+
+	return cov / math.Sqrt(vx*vy), n
+}
+
+// estimateOffset finds the whole-second offset which best lines up field
+// between the two files.
+func estimateOffset(a, b []*fit.RecordMsg, field string, max time.Duration) (time.Duration, float64, error) {
+	bm := bySecond(b)
+	limit := int64(max.Seconds())
+
+	best, bestR := int64(0), math.Inf(-1)
+	for off := -limit; off <= limit; off++ {
+		r, _ := correlation(a, bm, field, off)
+		if !math.IsNaN(r) && r > bestR {
+			best, bestR = off, r
+		}
+	}
+
+	if math.IsInf(bestR, -1) {
+		return 0, 0, fmt.Errorf("not enough overlapping %s samples to estimate the offset", field)
+	}
+
+	return time.Duration(best) * time.Second, bestR, nil
+}
+
+type pair struct {
+	t    time.Time
+	a, b *fit.RecordMsg
+}
+
+// pairRecords matches each record of a with the record of b at the same
+// time, once offset has been added to b's timestamps.
+func pairRecords(a, b []*fit.RecordMsg, offset time.Duration) []pair {
+	bm := bySecond(b)
+	shift := int64(offset.Seconds())
+
+	var pairs []pair
+	for _, ra := range a {
+		if rb, ok := bm[ra.Timestamp.Unix()-shift]; ok {
+			pairs = append(pairs, pair{ra.Timestamp, ra, rb})
+		}
+	}
+	return pairs
+}
+
+type divergence struct {
+	sumAbs, sum, max float64
+	n                int
+}
+
+func (d *divergence) add(diff float64) {
+	d.sumAbs += math.Abs(diff)
+	d.sum += diff
+	if math.Abs(diff) > math.Abs(d.max) {
+		d.max = diff
+	}
+	d.n++
+}
+
+// lastValue returns the last valid value of field in records
+func lastValue(records []*fit.RecordMsg, field string) (float64, bool) {
+	for i := len(records) - 1; i >= 0; i-- {
+		if v, ok := fieldValue(records[i], field); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// ascent totals the climbing in the records' altitude
+func ascent(records []*fit.RecordMsg) float64 {
+	total := 0.0
+	prev, havePrev := 0.0, false
+	for _, r := range records {
+		alt, ok := fieldValue(r, "Altitude")
+		if !ok {
+			continue
+		}
+		if havePrev && alt > prev {
+			total += alt - prev
+		}
+		prev, havePrev = alt, true
+	}
+	return total
+}
+
+func printComparison(a, b []*fit.RecordMsg, pairs []pair) {
+	fmt.Printf("Paired records: %d\n", len(pairs))
+
+	for _, field := range compareFields {
+		var d divergence
+		for _, p := range pairs {
+			x, okx := fieldValue(p.a, field)
+			y, oky := fieldValue(p.b, field)
+			if okx && oky {
+				d.add(y - x)
+			}
+		}
+		if d.n == 0 {
+			continue
+		}
+
+		info, _ := fitdump.FieldInfo("Record", field)
+		unit := ""
+		if info.Unit != "" {
+			unit = " " + info.Unit
+		}
+		fmt.Printf("%s: mean abs diff %.2f, bias %+.2f, max diff %+.2f%s (%d samples)\n",
+			field, d.sumAbs/float64(d.n), d.sum/float64(d.n), d.max, unit, d.n)
+	}
+
+	if da, ok := lastValue(a, "Distance"); ok {
+		if db, ok := lastValue(b, "Distance"); ok {
+			fmt.Printf("Total distance: %.1f m vs %.1f m (%+.1f m)\n", da, db, db-da)
+		}
+	}
+
+	aa, ab := ascent(a), ascent(b)
+	fmt.Printf("Total ascent: %.1f m vs %.1f m (%+.1f m)\n", aa, ab, ab-aa)
+}
+
+func writePairs(path string, pairs []pair) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	opts := cli.Options()
+	cw := csv.NewWriter(f)
+	header := []string{"Timestamp"}
+	for _, field := range compareFields {
+		a, b := field+"A", field+"B"
+		info, _ := fitdump.FieldInfo("Record", field)
+		if unit := opts.ConvertedUnit(info); unit != "" {
+			a += " (" + unit + ")"
+			b += " (" + unit + ")"
+		}
+		header = append(header, a, b)
+	}
+	cw.Write(header)
+
+	for _, p := range pairs {
+		row := []string{p.t.UTC().Format(time.RFC3339)}
+		for _, field := range compareFields {
+			info, _ := fitdump.FieldInfo("Record", field)
+			row = append(row,
+				fitdump.FormatCell(reflect.ValueOf(p.a).Elem().FieldByName(field), info, opts),
+				fitdump.FormatCell(reflect.ValueOf(p.b).Elem().FieldByName(field), info, opts))
+		}
+		cw.Write(row)
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 2 {
+		return cli.Usagef("Expected two arguments: FILE_A FILE_B")
+	}
+
+	if *alignField != "HeartRate" && *alignField != "Speed" {
+		return cli.Usagef("-align must be 'HeartRate' or 'Speed'")
+	}
+
+	a, err := decodeActivity(args[0])
+	if err != nil {
+		return err
+	}
+
+	b, err := decodeActivity(args[1])
+	if err != nil {
+		return err
+	}
+
+	var offset time.Duration
+	if *offsetFlag == "auto" {
+		var r float64
+		offset, r, err = estimateOffset(a.Records, b.Records, *alignField, *maxOffset)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Offset: %v (estimated from %s, correlation %.3f)\n", offset, *alignField, r)
+	} else {
+		offset, err = time.ParseDuration(*offsetFlag)
+		if err != nil {
+			return cli.Usagef("-offset: %v", err)
+		}
+		fmt.Printf("Offset: %v\n", offset)
+	}
+
+	pairs := pairRecords(a.Records, b.Records, offset)
+	if len(pairs) == 0 {
+		cli.Warnf("no records line up at an offset of %v", offset)
+	}
+
+	printComparison(a.Records, b.Records, pairs)
+
+	if *csvOut != "" {
+		return writePairs(*csvOut, pairs)
+	}
+
+	return nil
+}
+
+func main() {
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,358 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-course-check compares an activity with the course it was meant to
+// follow. For each point on the course it finds the nearest point of the
+// ridden track, and reports how far off course the activity went and which
+// sections of the course were skipped.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var tolerance = flag.Float64("tolerance", 30, "Furthest in metres a course point can be from the track and still count as covered")
+var geojsonOut = flag.String("geojson", "", "Write the off-course sections of the course to this path as GeoJSON")
+
+// Metres per degree of latitude, near enough for sizing grid cells
+const metresPerDegree = 111320
+
+type point struct {
+	lat, lng float64
+	// Distance along the course, in metres
+	distance float64
+}
+
+// trackPoints returns the positions of the records in a course or activity
+// file, with the distance along it from the Distance field, or from the
+// positions if it's missing.
+func trackPoints(path string) ([]point, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fitf, err := cli.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	var records []*fit.RecordMsg
+	if course, err := fitf.Course(); err == nil {
+		records = course.Records
+	} else if activity, err := fitf.Activity(); err == nil {
+		records = activity.Records
+	} else {
+		return nil, fmt.Errorf("%s: not a course or activity file", path)
+	}
+
+	var points []point
+	for _, r := range records {
+		if r.PositionLat.Invalid() || r.PositionLong.Invalid() {
+			continue
+		}
+
+		p := point{lat: r.PositionLat.Degrees(), lng: r.PositionLong.Degrees()}
+		if d := r.GetDistanceScaled(); !math.IsNaN(d) {
+			p.distance = d
+		} else if n := len(points); n > 0 {
+			last := points[n-1]
+			p.distance = last.distance + fitdump.DistanceBetween(last.lat, last.lng, p.lat, p.lng)
+		}
+		points = append(points, p)
+	}
+
+	if len(points) == 0 {
+		return nil, fmt.Errorf("%s: no positions", path)
+	}
+
+	return points, nil
+}
+
+type cell struct {
+	lat, lng int
+}
+
+// grid buckets points into cells of roughly size metres, so that the
+// nearest one can be found by only looking at the cells around a position.
+type grid struct {
+	size             float64
+	latStep, lngStep float64
+	cells            map[cell][]point
+	// Bounds of the occupied cells, to know when to stop searching
+	min, max cell
+}
+
+func newGrid(points []point, size float64) *grid {
+	g := &grid{
+		size:    size,
+		latStep: size / metresPerDegree,
+		// Cells get narrower towards the poles, so size them by the first
+		// point. Courses don't span enough latitude for it to matter.
+		lngStep: size / (metresPerDegree * math.Cos(points[0].lat*math.Pi/180)),
+		cells:   make(map[cell][]point),
+	}
+
+	for i, p := range points {
+		c := g.cellOf(p.lat, p.lng)
+		g.cells[c] = append(g.cells[c], p)
+		if i == 0 {
+			g.min, g.max = c, c
+		}
+		g.min.lat = minInt(g.min.lat, c.lat)
+		g.min.lng = minInt(g.min.lng, c.lng)
+		g.max.lat = maxInt(g.max.lat, c.lat)
+		g.max.lng = maxInt(g.max.lng, c.lng)
+	}
+
+	return g
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (g *grid) cellOf(lat, lng float64) cell {
+	return cell{int(math.Floor(lat / g.latStep)), int(math.Floor(lng / g.lngStep))}
+}
+
+// nearest returns the distance in metres from (lat, lng) to the closest
+// point in the grid. It searches rings of cells outwards, stopping once a
+// ring can't hold anything closer than the best so far, or falling back to
+// checking every point once the rings cover more cells than are occupied.
+func (g *grid) nearest(lat, lng float64) float64 {
+	c := g.cellOf(lat, lng)
+	// No point searching further than the ring reaching the far edge
+	maxRing := maxInt(maxInt(c.lat-g.min.lat, g.max.lat-c.lat), maxInt(c.lng-g.min.lng, g.max.lng-c.lng))
+
+	best := math.Inf(1)
+	for ring := 0; ring <= maxRing; ring++ {
+		// Anything in this ring is at least (ring - 1) cells away
+		if float64(ring-1)*g.size > best {
+			break
+		}
+
+		// Far from the track the rings get huge and mostly empty, so once
+		// they'd cover more cells than are occupied, check those instead
+		if side := 2*ring + 1; side*side > len(g.cells) {
+			for _, points := range g.cells {
+				for _, p := range points {
+					best = math.Min(best, fitdump.DistanceBetween(lat, lng, p.lat, p.lng))
+				}
+			}
+			break
+		}
+
+		for dlat := -ring; dlat <= ring; dlat++ {
+			for dlng := -ring; dlng <= ring; dlng++ {
+				if maxInt(abs(dlat), abs(dlng)) != ring {
+					continue
+				}
+				for _, p := range g.cells[cell{c.lat + dlat, c.lng + dlng}] {
+					if d := fitdump.DistanceBetween(lat, lng, p.lat, p.lng); d < best {
+						best = d
+					}
+				}
+			}
+		}
+	}
+
+	return best
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// section is a run of course points which are all off course
+type section struct {
+	points       []point
+	maxDeviation float64
+}
+
+type check struct {
+	deviations []float64
+	// Metres of the course within tolerance, out of the total
+	covered, total float64
+	offCourse      []section
+}
+
+// checkCourse finds how far each course point is from the track
+func checkCourse(course, track []point, tolerance float64) *check {
+	g := newGrid(track, math.Max(tolerance, 10))
+
+	c := &check{deviations: make([]float64, len(course))}
+	var current *section
+	for i, p := range course {
+		dev := g.nearest(p.lat, p.lng)
+		c.deviations[i] = dev
+
+		// Each point stands for the course up to the next one
+		length := 0.0
+		if i < len(course)-1 {
+			length = math.Max(course[i+1].distance-p.distance, 0)
+		}
+		c.total += length
+
+		if dev <= tolerance {
+			c.covered += length
+			current = nil
+			continue
+		}
+
+		if current == nil {
+			c.offCourse = append(c.offCourse, section{})
+			current = &c.offCourse[len(c.offCourse)-1]
+		}
+		current.points = append(current.points, p)
+		current.maxDeviation = math.Max(current.maxDeviation, dev)
+	}
+
+	return c
+}
+
+func (c *check) print(tolerance float64) {
+	var max, sum float64
+	for _, d := range c.deviations {
+		max = math.Max(max, d)
+		sum += d
+	}
+
+	fmt.Printf("Course: %d points, %.2f km\n", len(c.deviations), c.total/1000)
+	fmt.Printf("Deviation: max %.1f m, avg %s\n", max, cli.Options().FormatFloat(sum/float64(len(c.deviations)), 1, "m"))
+	if c.total > 0 {
+		fmt.Printf("Covered: %.1f%% within %.0f m\n", 100*c.covered/c.total, tolerance)
+	}
+
+	if len(c.offCourse) == 0 {
+		fmt.Println("Off course: none")
+		return
+	}
+
+	fmt.Println("Off course:")
+	for i, s := range c.offCourse {
+		start, end := s.points[0].distance, s.points[len(s.points)-1].distance
+		fmt.Printf("\t%d: %.2f km to %.2f km, max %.1f m\n", i+1, start/1000, end/1000, s.maxDeviation)
+	}
+	fmt.Println("---")
+}
+
+// writeGeoJSON writes the off-course sections as LineString features. A
+// section of a single point is written as a Point.
+func writeGeoJSON(path string, sections []section) error {
+	type geometry struct {
+		Type        string      `json:"type"`
+		Coordinates interface{} `json:"coordinates"`
+	}
+	type feature struct {
+		Type       string                 `json:"type"`
+		Geometry   geometry               `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	}
+
+	features := []feature{}
+	for _, s := range sections {
+		// GeoJSON positions are longitude first
+		var coords [][]float64
+		for _, p := range s.points {
+			coords = append(coords, []float64{p.lng, p.lat})
+		}
+
+		geom := geometry{"LineString", coords}
+		if len(coords) == 1 {
+			geom = geometry{"Point", coords[0]}
+		}
+
+		features = append(features, feature{
+			Type:     "Feature",
+			Geometry: geom,
+			Properties: map[string]interface{}{
+				"start_m":         s.points[0].distance,
+				"end_m":           s.points[len(s.points)-1].distance,
+				"max_deviation_m": s.maxDeviation,
+			},
+		})
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "\t")
+	err = enc.Encode(struct {
+		Type     string    `json:"type"`
+		Features []feature `json:"features"`
+	}{"FeatureCollection", features})
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 2 {
+		return cli.Usagef("Expected two arguments: COURSE ACTIVITY")
+	}
+
+	if *tolerance <= 0 {
+		return cli.Usagef("-tolerance must be positive")
+	}
+
+	course, err := trackPoints(args[0])
+	if err != nil {
+		return err
+	}
+
+	track, err := trackPoints(args[1])
+	if err != nil {
+		return err
+	}
+
+	c := checkCourse(course, track, *tolerance)
+	c.print(*tolerance)
+
+	if *geojsonOut != "" {
+		return writeGeoJSON(*geojsonOut, c.offCourse)
+	}
+
+	return nil
+}
+
+func main() {
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+type batteryEntry struct {
+	timestamp time.Time
+	desc      string
+}
+
+func describeDevice(di *fit.DeviceInfoMsg) string {
+	parts := []string{fmt.Sprintf("Device %d", di.DeviceIndex)}
+	if di.Manufacturer != fit.ManufacturerInvalid {
+		parts = append(parts, di.Manufacturer.String())
+	}
+	if fitdump.FieldValid(di, "Product") {
+		parts = append(parts, fmt.Sprintf("product %d", di.Product))
+	}
+	if fitdump.FieldValid(di, "SerialNumber") {
+		parts = append(parts, fmt.Sprintf("serial %d", di.SerialNumber))
+	}
+	return strings.Join(parts, " ")
+}
+
+func dumpBattery(activity *fit.ActivityFile) {
+	var entries []batteryEntry
+
+	voltage, _ := fitdump.FieldInfo("DeviceInfo", "BatteryVoltage")
+
+	// Each device is only identified fully in its first message, later
+	// ones may only carry the index.
+	devices := make(map[fit.DeviceIndex]string)
+	for _, di := range activity.DeviceInfos {
+		if _, ok := devices[di.DeviceIndex]; !ok {
+			devices[di.DeviceIndex] = describeDevice(di)
+		}
+
+		var status []string
+		if !voltage.IsInvalid(reflect.ValueOf(di.BatteryVoltage)) {
+			status = append(status, fmt.Sprintf("%.2f %s", voltage.Apply(float64(di.BatteryVoltage)), voltage.Unit))
+		}
+		if di.BatteryStatus != fit.BatteryStatusInvalid {
+			status = append(status, di.BatteryStatus.String())
+		}
+		if len(status) == 0 {
+			continue
+		}
+
+		entries = append(entries, batteryEntry{
+			timestamp: di.Timestamp,
+			desc:      fmt.Sprintf("%s: %s", devices[di.DeviceIndex], strings.Join(status, ", ")),
+		})
+	}
+
+	for _, ev := range activity.Events {
+		switch ev.Event {
+		case fit.EventBatteryLow:
+			entries = append(entries, batteryEntry{ev.Timestamp, "Event: battery low"})
+		case fit.EventBattery:
+			desc := "Event: battery"
+			if fitdump.FieldValid(ev, "Data") {
+				// The battery_level subfield is in V, scale 1000
+				desc += fmt.Sprintf(" %.2f V", float64(ev.Data)/1000)
+			}
+			entries = append(entries, batteryEntry{ev.Timestamp, desc})
+		}
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No battery information found")
+		return
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].timestamp.Before(entries[j].timestamp)
+	})
+
+	printIndent(0, "Battery:\n")
+	for _, e := range entries {
+		printIndent(1, "%v: %s\n", e.timestamp, e.desc)
+	}
+	printIndent(0, "---\n")
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// curveField returns the record field named for -curve, which is "power",
+// "hr" or any other numeric record field in snake_case
+func curveField(name string) (string, error) {
+	if name == "hr" {
+		name = "heart_rate"
+	}
+
+	t := reflect.TypeOf(fit.RecordMsg{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i).Name
+		if fitdump.SnakeCase(field) != name {
+			continue
+		}
+		if info, ok := fitdump.FieldInfo("Record", field); ok && !strings.HasSuffix(info.BaseType, "[]") && info.Invalid != nil {
+			return field, nil
+		}
+	}
+	return "", fmt.Errorf("'%s' isn't a numeric record field, such as power or hr", name)
+}
+
+// curveDuration formats d without the zero units, such as 1h rather than
+// 1h0m0s
+func curveDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// dumpCurve prints the best average of the field over the standard
+// durations
+func dumpCurve(records []*fit.RecordMsg, field string, opts fitdump.Options) {
+	curve := fitbuild.Curve(records, field, nil, 0)
+	if len(curve) == 0 {
+		fmt.Printf("No %s found\n", fitdump.SnakeCase(field))
+		return
+	}
+
+	info, _ := fitdump.FieldInfo("Record", field)
+
+	printIndent(0, "Curve (%s):\n", fitdump.SnakeCase(field))
+	for _, p := range curve {
+		avg := opts.FormatFloat(p.Average, 1, info.Unit)
+		if info.IsTemperature() {
+			avg = opts.FormatTemperature(p.Average, 1)
+		}
+		printIndent(1, "%s: %s\n", curveDuration(p.Duration), avg)
+	}
+	printIndent(0, "---\n")
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// device is one sensor, made up of all the device_info messages about it
+type device struct {
+	key         string
+	info        fit.DeviceInfoMsg
+	first, last time.Time
+	messages    int
+}
+
+// validAntDeviceNumber reports whether di has an ANT+ device number. Some
+// devices fill it with the invalid value of a plain uint16 rather than the
+// uint16z one the profile gives it, so both are treated as unset.
+func validAntDeviceNumber(di *fit.DeviceInfoMsg) bool {
+	return fitdump.FieldValid(di, "AntDeviceNumber") &&
+		!fitdump.IsInvalid(reflect.ValueOf(di.AntDeviceNumber))
+}
+
+// deviceKey identifies a sensor across reconnections, which can give it a
+// different device index. It's "" for messages which only carry the index.
+func deviceKey(di *fit.DeviceInfoMsg) string {
+	if validAntDeviceNumber(di) {
+		return fmt.Sprintf("ant %d/%d/%d", di.DeviceType, di.AntDeviceNumber, di.AntTransmissionType)
+	}
+	if fitdump.FieldValid(di, "SerialNumber") {
+		return fmt.Sprintf("serial %d", di.SerialNumber)
+	}
+	return ""
+}
+
+// merge fills in the fields of the device which earlier messages didn't
+// have
+func (d *device) merge(di *fit.DeviceInfoMsg) {
+	if d.messages == 0 || di.Timestamp.Before(d.first) {
+		d.first = di.Timestamp
+	}
+	if di.Timestamp.After(d.last) {
+		d.last = di.Timestamp
+	}
+	d.messages++
+
+	dst := reflect.ValueOf(&d.info).Elem()
+	src := reflect.ValueOf(di).Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		info, _ := fitdump.FieldInfo("DeviceInfo", dst.Type().Field(i).Name)
+		if info.IsInvalid(dst.Field(i)) && !info.IsInvalid(src.Field(i)) {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+}
+
+// deviceList merges the device_info messages into one entry per sensor
+func deviceList(infos []*fit.DeviceInfoMsg) []*device {
+	var devices []*device
+	byKey := make(map[string]*device)
+	byIndex := make(map[fit.DeviceIndex]*device)
+
+	for _, di := range infos {
+		key := deviceKey(di)
+
+		d := byKey[key]
+		if key == "" || d == nil {
+			d = byIndex[di.DeviceIndex]
+			// The index was reused by a different sensor
+			if d != nil && key != "" && d.key != "" {
+				d = nil
+			}
+		}
+		if d == nil {
+			d = &device{info: *fit.NewDeviceInfoMsg()}
+			devices = append(devices, d)
+		}
+
+		if d.key == "" && key != "" {
+			d.key = key
+			byKey[key] = d
+		}
+		byIndex[di.DeviceIndex] = d
+		d.merge(di)
+	}
+
+	return devices
+}
+
+// deviceType resolves the device type, which is an ANT+ device type for
+// external sensors. Files older than the source_type field only have ANT+
+// sensors.
+func deviceType(di *fit.DeviceInfoMsg) string {
+	if di.DeviceIndex == fit.DeviceIndexCreator {
+		return "creator"
+	}
+	if !fitdump.FieldValid(di, "DeviceType") {
+		return "unknown"
+	}
+
+	switch di.SourceType {
+	case fit.SourceTypeAntplus, fit.SourceTypeInvalid:
+		return fitdump.SnakeCase(fit.AntplusDeviceType(di.DeviceType).String())
+	case fit.SourceTypeLocal:
+		return fitdump.SnakeCase(fit.LocalDeviceType(di.DeviceType).String())
+	}
+	return fmt.Sprintf("type %d", di.DeviceType)
+}
+
+// dumpDevices prints each device in the file once, however many times it
+// reconnected
+func dumpDevices(activity *fit.ActivityFile) {
+	devices := deviceList(activity.DeviceInfos)
+	if len(devices) == 0 {
+		fmt.Println("No devices found")
+		return
+	}
+
+	printIndent(0, "Devices:\n")
+	for _, d := range devices {
+		di := &d.info
+		printIndent(1, "%s:\n", deviceType(di))
+		if di.Manufacturer != fit.ManufacturerInvalid {
+			printIndent(2, "Manufacturer: %v\n", di.Manufacturer)
+		}
+		if di.ProductName != "" {
+			printIndent(2, "Product: %s\n", di.ProductName)
+		} else if fitdump.FieldValid(di, "Product") {
+			printIndent(2, "Product: %v\n", di.GetProduct())
+		}
+		if fitdump.FieldValid(di, "SerialNumber") {
+			printIndent(2, "SerialNumber: %d\n", di.SerialNumber)
+		}
+		if di.SourceType != fit.SourceTypeInvalid {
+			printIndent(2, "Source: %s\n", fitdump.SnakeCase(di.SourceType.String()))
+		}
+		if validAntDeviceNumber(di) {
+			printIndent(2, "AntDeviceNumber: %d\n", di.AntDeviceNumber)
+		}
+		if di.AntNetwork != fit.AntNetworkInvalid {
+			printIndent(2, "AntNetwork: %s\n", fitdump.SnakeCase(di.AntNetwork.String()))
+		}
+		if di.SensorPosition != fit.BodyLocationInvalid {
+			printIndent(2, "SensorPosition: %s\n", fitdump.SnakeCase(di.SensorPosition.String()))
+		}
+		if d.messages > 1 {
+			printIndent(2, "Messages: %d, from %v to %v\n", d.messages, d.first, d.last)
+		}
+	}
+	printIndent(0, "---\n")
+}
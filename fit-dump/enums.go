@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"reflect"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// dumpUnknownEnums prints each enum value in the file which the fit package
+// has no name for, with its message and field, for reporting upstream
+func dumpUnknownEnums(fitf *fit.File) error {
+	unknown := fitdump.UnknownEnums(reflect.ValueOf(*fitf))
+
+	body, err := getFileValue(fitf)
+	if err != nil {
+		return err
+	}
+	if body.IsValid() {
+		unknown = append(unknown, fitdump.UnknownEnums(body)...)
+	}
+
+	printIndent(0, "Unknown enums:\n")
+	for _, u := range unknown {
+		messages := "messages"
+		if u.Count == 1 {
+			messages = "message"
+		}
+		printIndent(1, "%v, in %d %s\n", u, u.Count, messages)
+	}
+	if len(unknown) == 0 {
+		printIndent(1, "None\n")
+	}
+	printIndent(0, "---\n")
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// fileEvents returns the events of the file types which have them
+func fileEvents(fitf *fit.File) ([]*fit.EventMsg, error) {
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, err := fitf.Activity()
+		if err != nil {
+			return nil, err
+		}
+		return activity.Events, nil
+	case fit.FileTypeCourse:
+		course, err := fitf.Course()
+		if err != nil {
+			return nil, err
+		}
+		return course.Events, nil
+	}
+
+	return nil, fmt.Errorf("file type '%v' has no events", fitf.Type())
+}
+
+// dumpEvents prints a timeline of the events, with their data decoded.
+// Gear changes show the gears before and after the shift.
+func dumpEvents(events []*fit.EventMsg, opts fitdump.Options) {
+	if len(events) == 0 {
+		fmt.Println("No events found")
+		return
+	}
+
+	// The fit package keeps them in file order, which should already be
+	// chronological, but make sure
+	sorted := make([]*fit.EventMsg, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	var gear string
+	printIndent(0, "Events:\n")
+	for _, ev := range sorted {
+		desc := fitdump.DescribeEvent(ev, opts)
+
+		if ev.Event == fit.EventFrontGearChange || ev.Event == fit.EventRearGearChange {
+			if gear != "" && desc != "" {
+				gear, desc = desc, gear+" -> "+desc
+			} else {
+				gear = desc
+			}
+		}
+
+		name := fitdump.SnakeCase(ev.Event.String())
+		if ev.EventType != fit.EventTypeInvalid && ev.EventType != fit.EventTypeMarker {
+			name += " " + fitdump.SnakeCase(ev.EventType.String())
+		}
+
+		if desc != "" {
+			printIndent(1, "%v: %s: %s\n", ev.Timestamp, name, desc)
+		} else {
+			printIndent(1, "%v: %s\n", ev.Timestamp, name)
+		}
+	}
+	printIndent(0, "---\n")
+}
+
+// dumpPauses prints when the timer was stopped, and for how long
+func dumpPauses(events []*fit.EventMsg) {
+	pauses := fitdump.Pauses(events)
+	if len(pauses) == 0 {
+		fmt.Println("No pauses found")
+		return
+	}
+
+	var total time.Duration
+	printIndent(0, "Pauses:\n")
+	for _, p := range pauses {
+		if p.Trigger != "" {
+			printIndent(1, "%v: %v (%s)\n", p.Start, p.Duration, p.Trigger)
+		} else {
+			printIndent(1, "%v: %v\n", p.Start, p.Duration)
+		}
+		total += p.Duration
+	}
+	printIndent(1, "Count: %d\n", len(pauses))
+	printIndent(1, "Total: %v\n", total)
+	printIndent(0, "---\n")
+}
@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/dyncrc16"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+// fileTypeMessages are the messages which mark out each type of file, for
+// guessing the type of a file without a FileId. They're checked in order,
+// as courses have records too, and activities can hold a workout.
+var fileTypeMessages = []struct {
+	fileType fit.FileType
+	messages []fit.MesgNum
+}{
+	{fit.FileTypeCourse, []fit.MesgNum{fit.MesgNumCourse, fit.MesgNumCoursePoint}},
+	{fit.FileTypeActivity, []fit.MesgNum{fit.MesgNumActivity, fit.MesgNumSession, fit.MesgNumLap, fit.MesgNumRecord}},
+	{fit.FileTypeWorkout, []fit.MesgNum{fit.MesgNumWorkout, fit.MesgNumWorkoutStep}},
+	{fit.FileTypeWeight, []fit.MesgNum{fit.MesgNumWeightScale}},
+	{fit.FileTypeBloodPressure, []fit.MesgNum{fit.MesgNumBloodPressure}},
+	{fit.FileTypeMonitoringB, []fit.MesgNum{fit.MesgNumMonitoring}},
+	{fit.FileTypeTotals, []fit.MesgNum{fit.MesgNumTotals}},
+	{fit.FileTypeGoals, []fit.MesgNum{fit.MesgNumGoal}},
+	{fit.FileTypeSport, []fit.MesgNum{fit.MesgNumSport}},
+	{fit.FileTypeSchedules, []fit.MesgNum{fit.MesgNumSchedule}},
+	{fit.FileTypeSegment, []fit.MesgNum{fit.MesgNumSegmentId, fit.MesgNumSegmentLap}},
+	{fit.FileTypeSegmentList, []fit.MesgNum{fit.MesgNumSegmentFile}},
+	{fit.FileTypeSettings, []fit.MesgNum{fit.MesgNumUserProfile, fit.MesgNumDeviceSettings}},
+}
+
+// scanMessages returns the global number of each data message in data, a
+// whole FIT file, stopping at the first which is cut short or otherwise
+// broken. It returns false if the first message is a FileId.
+func scanMessages(data []byte) (map[fit.MesgNum]bool, bool) {
+	sr, err := fitstream.NewRawReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, false
+	}
+
+	found := make(map[fit.MesgNum]bool)
+	for {
+		raw, err := sr.NextRaw()
+		if err != nil {
+			break
+		}
+		if len(found) == 0 && raw.Num == fit.MesgNumFileId {
+			return nil, false
+		}
+		found[raw.Num] = true
+	}
+	return found, len(found) > 0
+}
+
+// decodeWithoutFileID decodes a file which is missing the FileId message
+// fit.Decode needs first, by adding one with the type guessed from the
+// messages in the file. It returns false if the file does have a FileId,
+// or is too broken to tell.
+func decodeWithoutFileID(r io.ReadSeeker, opts []fit.DecodeOption) (*fit.File, bool) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, false
+	}
+	data, err := io.ReadAll(r)
+	if err != nil || len(data) < 12 {
+		return nil, false
+	}
+	hdrSize := int(data[0])
+	dataSize := int(binary.LittleEndian.Uint32(data[4:8]))
+	if hdrSize < 12 || hdrSize+6 > len(data) {
+		return nil, false
+	}
+	if hdrSize+dataSize > len(data) {
+		// Truncated, so keep what's there
+		dataSize = len(data) - hdrSize
+	}
+	messages := data[hdrSize : hdrSize+dataSize]
+	if len(messages) < 6 {
+		// Too short for even a definition message
+		return nil, false
+	}
+
+	found, ok := scanMessages(data)
+	if !ok {
+		return nil, false
+	}
+	fileType := fit.FileTypeActivity
+guess:
+	for _, t := range fileTypeMessages {
+		for _, num := range t.messages {
+			if found[num] {
+				fileType = t.fileType
+				break guess
+			}
+		}
+	}
+
+	// A definition of file_id with only its type, an enum, as local
+	// message 0 (record header 0x40), and the data message holding it.
+	// The file's own first definition replaces it.
+	fileID := []byte{
+		0x40, 0, 0, byte(fit.MesgNumFileId), 0, 1,
+		0, 1, 0,
+		0, byte(fileType),
+	}
+
+	var buf bytes.Buffer
+	header := append([]byte(nil), data[:hdrSize]...)
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(fileID)+len(messages)))
+	if hdrSize >= 14 {
+		// No header CRC, which is always valid
+		binary.LittleEndian.PutUint16(header[12:14], 0)
+	}
+	buf.Write(header)
+	buf.Write(fileID)
+	buf.Write(messages)
+	var crc [2]byte
+	binary.LittleEndian.PutUint16(crc[:], dyncrc16.Checksum(buf.Bytes()))
+	buf.Write(crc[:])
+
+	fitf, err := cli.Decode(&buf, opts...)
+	if err != nil {
+		return nil, false
+	}
+	cli.Warnf("no FileId message, so decoded as a%s %v file going by the messages in it, with a FileId holding only the type",
+		article(fileType), fileType)
+	return fitf, true
+}
+
+func article(t fit.FileType) string {
+	switch t.String()[0] {
+	case 'A', 'E', 'I', 'O', 'U':
+		return "n"
+	}
+	return ""
+}
+
+// readRawMessages returns the data messages of the file after its FileId,
+// as they are in the file. A message which can't be read ends them, with a
+// warning.
+func readRawMessages(r io.ReadSeeker) []*fitstream.RawMessage {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil
+	}
+	sr, err := fitstream.NewRawReader(r)
+	if err != nil {
+		return nil
+	}
+
+	var raw []*fitstream.RawMessage
+	for {
+		m, err := sr.NextRaw()
+		if err == io.EOF {
+			return raw
+		}
+		if err != nil {
+			cli.Warnf("only the first %d messages could be read: %v", len(raw), err)
+			return raw
+		}
+		if m.Num == fit.MesgNumFileId && len(raw) == 0 {
+			continue
+		}
+		raw = append(raw, m)
+	}
+}
+
+// readDroppedMessages returns the messages of a file which the fit package
+// leaves out when it decodes it, such as the sport message of an activity
+// holding the name written by fit-edit, for the text dump. See fitstream's
+// Dropped. If they can't be read, there's a debug message and none.
+func readDroppedMessages(r io.ReadSeeker) []*fitstream.RawMessage {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil
+	}
+	sr, err := fitstream.NewReader(r)
+	if err != nil {
+		cli.Debugf("can't read the messages the fit package doesn't keep: %v", err)
+		return nil
+	}
+	raw, _, err := sr.Dropped()
+	if err != nil {
+		cli.Debugf("can't read the messages the fit package doesn't keep: %v", err)
+		return nil
+	}
+	return raw
+}
+
+// devField is a developer field, by its developer data index and number
+type devField struct {
+	devIndex, num byte
+}
+
+// devFieldDescription is the name and base type of a developer field, from
+// its field description message
+type devFieldDescription struct {
+	name     string
+	baseType byte
+}
+
+// Field numbers of the field description message
+const (
+	fieldDescriptionDevDataIndex = 0
+	fieldDescriptionFieldNum     = 1
+	fieldDescriptionBaseType     = 2
+	fieldDescriptionName         = 3
+)
+
+// devFieldDescriptions returns the developer fields described by the field
+// description messages in raw
+func devFieldDescriptions(raw []*fitstream.RawMessage) map[devField]devFieldDescription {
+	descs := make(map[devField]devFieldDescription)
+	for _, m := range raw {
+		if m.Num != fit.MesgNumFieldDescription {
+			continue
+		}
+		index, ok1 := m.Uint(fieldDescriptionDevDataIndex)
+		num, ok2 := m.Uint(fieldDescriptionFieldNum)
+		baseType, ok3 := m.Uint(fieldDescriptionBaseType)
+		if !ok1 || !ok2 || !ok3 {
+			continue
+		}
+		desc := devFieldDescription{baseType: byte(baseType)}
+		if name, ok := m.Field(fieldDescriptionName); ok {
+			desc.name = strings.TrimRight(string(name), "\x00")
+		}
+		descs[devField{byte(index), byte(num)}] = desc
+	}
+	return descs
+}
+
+// dumpRawMessages prints raw messages under title, each with its global
+// number, and the value of each field by its name in the profile where the
+// fit package knows it. Developer fields are named, and their values shown,
+// where there's a field description for them among the messages.
+func dumpRawMessages(w io.Writer, title string, raw []*fitstream.RawMessage) {
+	fmt.Fprintf(w, "%s (%d elems):\n", title, len(raw))
+	descs := devFieldDescriptions(raw)
+
+	// Each type of message is counted separately, as in the other dumps
+	counts := make(map[fit.MesgNum]int)
+	for _, m := range raw {
+		msg := m.Num.String()
+		name := fmt.Sprintf("%s[%d]", hexMessageName(m.Num), counts[m.Num])
+		if !strings.HasPrefix(msg, "MesgNum(") {
+			name += fmt.Sprintf(" (%d)", m.Num)
+		}
+		fmt.Fprintf(w, "\t%s:\n", name)
+		counts[m.Num]++
+
+		data := m.Bytes()
+		pos := 1
+		for _, f := range m.Fields() {
+			b := data[pos : pos+int(f.Size)]
+			v := hexValue(b, f.BaseType, m.ByteOrder())
+			if v == "" {
+				v = fmt.Sprintf("% x", b)
+			}
+			field := hexFieldName(msg, f.Num)
+			if _, ok := fitdump.FieldName(msg, int(f.Num)); ok {
+				field += fmt.Sprintf(" (%d)", f.Num)
+			}
+			fmt.Fprintf(w, "\t\t%s: %s\n", field, v)
+			pos += int(f.Size)
+		}
+		for _, f := range m.DevFields() {
+			b := data[pos : pos+int(f.Size)]
+			name := fmt.Sprintf("developer field %d of developer %d", f.Num, f.DevIndex)
+			v := fmt.Sprintf("% x", b)
+			if desc, ok := descs[devField{f.DevIndex, f.Num}]; ok {
+				if desc.name != "" {
+					name = fmt.Sprintf("%s (%s)", desc.name, name)
+				}
+				if text := hexValue(b, desc.baseType, m.ByteOrder()); text != "" {
+					v = text
+				}
+			}
+			fmt.Fprintf(w, "\t\t%s: %s\n", name, v)
+			pos += int(f.Size)
+		}
+		fmt.Fprintf(w, "\t---\n")
+	}
+}
+
+// decodeFallback handles files which fit.Decode rejects because of their
+// type, such as locations files or manufacturer-specific types. The fit
+// package has nowhere to put the messages from those, so only the header and
+// FileId are decoded, and the rest of the messages are returned as they are
+// in the file, for dumpRawMessages. Files missing their FileId are decoded
+// as the type their messages suggest, see decodeWithoutFileID, with opts. If
+// the failure was for some other reason, decodeErr is returned unchanged.
+func decodeFallback(r io.ReadSeeker, decodeErr error, opts []fit.DecodeOption) (*fit.File, []*fitstream.RawMessage, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, decodeErr
+	}
+
+	h, fileId, err := fit.DecodeHeaderAndFileID(r)
+	if err != nil {
+		if fitf, ok := decodeWithoutFileID(r, opts); ok {
+			return fitf, nil, nil
+		}
+		return nil, nil, decodeErr
+	}
+
+	if _, err := fit.NewFile(fileId.Type, h); err == nil {
+		// The type is fine, so the problem is elsewhere
+		return nil, nil, decodeErr
+	}
+
+	cli.Warnf("unhandled file type '%v' (%v), so the text dump shows its messages by number, with their raw values",
+		fileId.Type, decodeErr)
+
+	fitf := &fit.File{
+		Header: h,
+		FileId: fileId,
+	}
+
+	// The file CRC is the last two bytes
+	var crc [2]byte
+	if _, err := r.Seek(-2, io.SeekEnd); err == nil {
+		if _, err := io.ReadFull(r, crc[:]); err == nil {
+			fitf.CRC = binary.LittleEndian.Uint16(crc[:])
+		}
+	}
+
+	return fitf, readRawMessages(r), nil
+}
@@ -6,14 +6,17 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
-	"math"
 	"reflect"
 	"strings"
-	"unicode"
-	"unicode/utf8"
+	"text/template"
+	"time"
 
 	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
 )
 
 func printIndent(level int, format string, args ...interface{}) {
@@ -21,135 +24,6 @@ func printIndent(level int, format string, args ...interface{}) {
 	fmt.Printf(format, args...)
 }
 
-var invalidValues = map[reflect.Kind]func(reflect.Value) bool {
-	reflect.Bool: func(v reflect.Value) bool {
-		return v.Bool() == false
-	},
-
-	reflect.Int8: func(v reflect.Value) bool {
-		return v.Int() == 0x7f
-	},
-
-	reflect.Int16: func(v reflect.Value) bool {
-		return v.Int() == 0x7fff
-	},
-
-	reflect.Int32: func(v reflect.Value) bool {
-		return v.Int() == 0x7fffffff
-	},
-
-	reflect.Int64: func(v reflect.Value) bool {
-		return v.Int() == 0x7fffffffffffffff
-	},
-
-	reflect.Uint8: func(v reflect.Value) bool {
-		return v.Uint() == 0xff
-	},
-
-	reflect.Uint16: func(v reflect.Value) bool {
-		return v.Uint() == 0xffff
-	},
-
-	reflect.Uint32: func(v reflect.Value) bool {
-		return v.Uint() == 0xffffffff
-	},
-
-	reflect.Uint64: func(v reflect.Value) bool {
-		return v.Uint() == 0xffffffffffffffff
-	},
-
-	reflect.Float32: func(v reflect.Value) bool {
-		return float32(v.Float()) == math.Float32frombits(0xFFFFFFFF)
-	},
-
-	reflect.Float64: func(v reflect.Value) bool {
-		return v.Float() == math.Float64frombits(0xFFFFFFFFFFFFFFFF)
-	},
-
-	reflect.String: func(v reflect.Value) bool {
-		return v.String() == ""
-	},
-}
-
-func dumpField(field reflect.Value, name string, level int) {
-	if method := field.MethodByName("String"); method.IsValid() {
-		str := method.Call(nil)[0].String()
-		if strings.HasSuffix(str, "Invalid") {
-			return
-		}
-		printIndent(level, "%s: %s\n", name, str);
-	} else if invalidFunc, ok := invalidValues[field.Kind()]; ok {
-		// FIXME: This doesn't handle the 'z' variants, but I'm not sure
-		// there's much that can be done about it as the information on
-		// the field type is hidden.
-		// This also means that a field might be incorrectly excluded,
-		// if it's a 'z' type and holds a value which looks invalid for
-		// a non-'z' type.
-		// Fixing this without modifying the fit package probably means
-		// auto-generating a map of message type -> constructor, to
-		// compare against. Alternatively, the fit package could be
-		// extended to provide information on invalid values, but I'm
-		// not sure what a good interface for that would look like.
-		if invalidFunc(field) {
-			return
-		}
-		printIndent(level, "%s: %v\n", name, field);
-	} else {
-		printIndent(level, "%s: %+v\n", name, field);
-	}
-}
-
-func exported(name string) bool {
-	r, l := utf8.DecodeRune([]byte(name))
-	if r == utf8.RuneError && (l <= 1) {
-		// I guess this should never be able to happen
-		panic("unicode error")
-	}
-
-	return unicode.IsUpper(r)
-}
-
-func dumpRecursive(val reflect.Value, name string, level int) {
-	// TODO: I'm not very happy with all the different conditions/branches
-	// here. It's a bit spaghetti
-	if method := val.MethodByName("String"); method.IsValid() {
-		// For Stringers, dump them right away
-		dumpField(val, name, level)
-	} else {
-		switch val.Kind() {
-		case reflect.Struct:
-			// TODO: If all fields are invalid or unexported,
-			// should we skip it entirely?
-			printIndent(level, "%s:\n", name)
-			for i := 0; i < val.NumField(); i++ {
-				v := val.Field(i)
-				name = val.Type().Field(i).Name
-				if !exported(name) {
-					continue
-				}
-				dumpRecursive(v, val.Type().Field(i).Name, level+1)
-			}
-			printIndent(level, "---\n")
-		case reflect.Ptr:
-			if val.IsNil() {
-				break
-			}
-			dumpRecursive(reflect.Indirect(val), name, level)
-		case reflect.Slice:
-			if val.Len() == 0 {
-				break
-			}
-			printIndent(level, "%s (%d elems):\n", name, val.Len())
-			for i := 0; i < val.Len(); i++ {
-				name = fmt.Sprintf("[%d]", i)
-				dumpRecursive(reflect.Indirect(val.Index(i)), name, level+1)
-			}
-		default:
-			dumpField(val, name, level)
-		}
-	}
-}
-
 func getFileValue(fitf *fit.File) (reflect.Value, error) {
 	// Take care not to shadow these
 	var data reflect.Value
@@ -263,50 +137,687 @@ func getFileValue(fitf *fit.File) (reflect.Value, error) {
 			data = reflect.ValueOf(*segmentList)
 		}
 	default:
-		return data, fmt.Errorf("unknown filetype '%v'", fitf.Type())
+		// Only reachable for files from decodeFallback, which has
+		// already warned. Leave data invalid, there's no body to dump.
 	}
 
 	return data, err
 }
 
-func run() error {
-	if flag.NArg() != 1 {
-		return fmt.Errorf("Expected a single argument: FILE")
+// decimate thins out the records of the file types which have them
+func decimate(fitf *fit.File, d fitdump.Decimation) {
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, _ := fitf.Activity()
+		activity.Records = fitdump.Decimate(activity.Records, activity.Laps, d)
+	case fit.FileTypeCourse:
+		course, _ := fitf.Course()
+		course.Records = fitdump.Decimate(course.Records, course.Laps, d)
+	}
+}
+
+// fillGaps fills the gaps of more than -fill-gaps between the records of
+// the file types which have them with interpolated records, leaving the
+// pauses unfilled
+func fillGaps(fitf *fit.File) {
+	var records *[]*fit.RecordMsg
+	var events []*fit.EventMsg
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, _ := fitf.Activity()
+		records, events = &activity.Records, activity.Events
+	case fit.FileTypeCourse:
+		course, _ := fitf.Course()
+		records, events = &course.Records, course.Events
+	default:
+		return
+	}
+
+	var added int
+	*records, added = fitbuild.FillGaps(*records, *fillGapsOver, fitdump.Pauses(events))
+	cli.Warnf("filled gaps with %d interpolated records", added)
+}
+
+// exportRecords returns the records for the csv, tsv and influx output,
+// smoothed if -smooth-speed was given
+func exportRecords(fitf *fit.File) ([]*fit.RecordMsg, error) {
+	records, err := fileRecords(fitf)
+	if err != nil || *smoothSpeed == 0 {
+		return records, err
+	}
+
+	fields := []string{"Speed", "EnhancedSpeed"}
+	if *smoothHR {
+		fields = append(fields, "HeartRate")
 	}
+	return fitdump.SmoothRecords(records, time.Duration(*smoothSpeed)*time.Second, fields...), nil
+}
 
-	f, err := os.Open(flag.Args()[0])
+// fileRecords returns the records of the file types which have them
+func fileRecords(fitf *fit.File) ([]*fit.RecordMsg, error) {
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, err := fitf.Activity()
+		if err != nil {
+			return nil, err
+		}
+		return activity.Records, nil
+	case fit.FileTypeCourse:
+		course, err := fitf.Course()
+		if err != nil {
+			return nil, err
+		}
+		return course.Records, nil
+	}
+
+	return nil, fmt.Errorf("file type '%v' has no records", fitf.Type())
+}
+
+// readExtras reads the respiration rate, SpO2 and developer fields from f
+// again, for when they couldn't be collected while decoding it
+func readExtras(f *os.File) *fitdump.Extras {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		cli.Warnf("can't read respiration rate, SpO2 and developer fields: %v", err)
+		return nil
+	}
+
+	extras, err := fitdump.ReadExtras(f)
 	if err != nil {
-		return err
+		cli.Warnf("can't read respiration rate, SpO2 and developer fields: %v", err)
+		return nil
 	}
-	defer f.Close()
+	return extras
+}
 
-	fitf, err := fit.Decode(f, fit.WithStdLogger())
+// fileSport returns the sport of an activity's first session or of a course
+func fileSport(fitf *fit.File) fit.Sport {
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, _ := fitf.Activity()
+		if len(activity.Sessions) > 0 {
+			return activity.Sessions[0].Sport
+		}
+	case fit.FileTypeCourse:
+		course, _ := fitf.Course()
+		if course.Course != nil {
+			return course.Course.Sport
+		}
+	}
+	return fit.SportInvalid
+}
+
+// redactFile removes the device identifiers from the whole of fitf
+func redactFile(fitf *fit.File) {
+	n := fitdump.Redact(reflect.ValueOf(fitf))
+	if body, err := getFileValue(fitf); err == nil && body.IsValid() {
+		n += fitdump.Redact(body)
+	}
+	cli.Debugf("redacted %d fields", n)
+}
+
+var headerOnly = flag.Bool("header", false, "Only print the raw file header fields")
+var verify = flag.Bool("verify", false, "Only verify the file CRC, exiting non-zero if it doesn't match")
+var batteryReport = flag.Bool("battery", false, "Print a timeline of device battery status instead of the full dump")
+var deviceReport = flag.Bool("devices", false, "Print each device and sensor recorded in an activity once, merging the messages from reconnections, instead of the full dump")
+var gearReport = flag.Bool("gears", false, "Print a timeline of gear changes instead of the full dump")
+var eventReport = flag.Bool("events", false, "Print a timeline of events with their data decoded instead of the full dump")
+var pauseReport = flag.Bool("pauses", false, "Print when the timer was stopped and for how long, and the total time paused, instead of the full dump")
+var splitReport = flag.Bool("splits", false, "Print the split times and paces of an activity instead of the full dump, using its laps if they were split by distance")
+var selectSession = flag.Int("select-session", 0, "Cut an activity down to its Nth session, counting from 1, such as one leg of a multisport file, for the dump and the reports. The records, events, laps and lengths are those within the session's start and elapsed time")
+var lapReport = flag.Bool("laps", false, "Print each session and its laps, with their time, distance and what triggered them, such as a distance auto lap or the lap button, instead of the full dump")
+var curve = flag.String("curve", "", "Print the best average of a record field, such as power or hr, over standard durations from 1s up instead of the full dump. The averages don't span gaps in the records")
+var markDistance = flag.String("mark", "", "Print the time and elapsed time at every multiple of this distance along the records, such as 1km, 0.5mi or 400m, instead of the full dump, whatever laps were recorded")
+var splitUnit = flag.String("split-unit", "km", "Distance of each split for -splits when the laps aren't used: km or mi")
+var workoutReport = flag.Bool("workout", false, "Print the steps of a workout file as a readable list instead of the full dump")
+var unknownEnums = flag.Bool("unknown-enums", false, "List each enum value the fit library has no name for, such as a new manufacturer or sport, with its message and field, instead of the full dump")
+var expectSchema = flag.String("expect-schema", "", "Check the file against a schema listing the messages and fields expected, one such as record or record.heart_rate per line, instead of the full dump. Expected fields which are missing or never valid, and any other fields with values, are reported, and the exit code is non-zero")
+var compareProfile = flag.Bool("compare-profile", false, "Compare the file's profile version with the fit library's, listing messages and fields the library doesn't know")
+var speedUnitFlag = flag.String("speed-unit", "", "Unit for speed fields: ms, kmh, mph, minkm or minmi (default raw)")
+var utcOffset = flag.Duration("utc-offset", 0, "Show the UTC times in the dump at this fixed offset instead, such as +2h, for files without a local time")
+var decodeStats = flag.Bool("stats", false, "After the output, print how long decoding took, the number of messages, the file size and the throughput to stderr")
+var lint = flag.Bool("lint", false, "After the output, warn about suspicious values, such as speeds over 200 km/h or records out of order, and print how many were found")
+var lintErrors = flag.Bool("lint-errors", false, "Like -lint, but exit non-zero if anything suspicious was found")
+var nth = flag.String("nth", "", "Only dump one message, selected by its type and index from 0 as in the dump, e.g. lap[5]")
+var preferEnhanced = flag.Bool("prefer-enhanced", true, "Leave out legacy fields, such as Speed, when the message has a valid enhanced one, such as EnhancedSpeed. Set it to false to dump both")
+var preferLegacy = flag.Bool("prefer-legacy", false, "Leave out enhanced fields, such as EnhancedSpeed, when the message has a valid legacy one, such as Speed, instead")
+var exclude = flag.String("exclude", "", "Leave these messages out of the text or xml dump, as a comma-separated list of names in any of the forms -nth takes or numbers, e.g. record,hrv")
+var hexMsg = flag.String("hex", "", "Print an annotated hex dump of the raw bytes of each of the named messages, such as record or 20, with its definition, instead of the full dump")
+var invalidFlag = flag.String("invalid", "hide", "What to print for fields holding invalid values in the text and xml dumps and the csv and tsv output: hide to leave them out, show for the raw value, or mark for <invalid> (NA in csv and tsv)")
+var fieldNumbers = flag.Bool("with-field-numbers", false, "Print each field's number and base type from the FIT profile after its name. -v does too")
+var toc = flag.Bool("toc", false, "Before the dump, list each message and slice of messages in it, with the line and byte offset it starts at. These count from the start of the dump after the list, not from the top of the output")
+var paths = flag.Bool("paths", false, "Print each value of the dump on its own line after its full path, such as Records[3].HeartRate: 142, which -nth takes the message and index of")
+var minimal = flag.Bool("minimal", false, "Omit the element counts and '---' terminators from the dump")
+var components = flag.Bool("components", false, "Print the fields each composite field expands into beneath it, such as the gears packed into an event's data")
+var index = flag.Bool("index", false, "Prefix each message with its sequence number across all slices")
+var snake = flag.Bool("snake", false, "Print names in the FIT profile's snake_case form")
+var every = flag.String("every", "", "Thin out the records, keeping one per bucket of N records or of a duration such as 5s")
+var decimateMode = flag.String("decimate", "drop", "How to thin out records with -every: drop, or avg to average each bucket")
+var format = flag.String("format", "text", "Output format: text, or csv, tsv or influx (line protocol) for the records of activity and course files, or gpx-route for course files, or xml for the whole file, or html for a page to share with a summary, a chart and each message type")
+var gpxCues = flag.Bool("gpx-cues", false, "With -format gpx-route, include the course points as named route points")
+var smoothSpeed = flag.Int("smooth-speed", 0, "Smooth speed in the csv, tsv and influx output with a moving average over this many seconds, to hide GPS spikes. The other reports are unaffected")
+var smoothHR = flag.Bool("smooth-hr", false, "With -smooth-speed, smooth heart rate over the same window too")
+var deriveList = flag.String("derive", "", "Add columns derived from each record to the csv and tsv output, as a comma-separated list of accel for the change in speed since the previous record in m/s², grade for the change in altitude over distance in %, and vam for the climbing rate in vertical metres per hour. They're worked out after -smooth-speed, and left empty where they can't be, such as for the first record or while stopped")
+var deriveWindow = flag.Duration("derive-window", 10*time.Second, "With -derive, work out grade and vam over this much time before each record, to steady them")
+var onlyGPS = flag.Bool("only-gps", false, "Leave records without a valid position out of the csv, tsv and influx output, before any -every")
+var fillGapsOver = flag.Duration("fill-gaps", 0, "Fill gaps between records of more than this, such as 5s, with records every second in the csv, tsv, influx and gpx-route output, interpolating position, altitude, distance and speed. Pauses aren't filled. The other reports are unaffected")
+var tempLag = flag.Duration("temp-lag", 0, "Move the temperature of the records earlier by this much, such as 3m, in the csv, tsv and influx output, for a sensor which is slow to respond. The last records are left without a temperature, and the timestamps don't change")
+var stream = flag.Bool("stream", false, "With -format csv, tsv or influx, decode the records one at a time, for files too big to hold in memory")
+var redact = flag.Bool("redact", false, "Blank serial numbers, product IDs and ANT device numbers, in the dump and in files written by -merge")
+var mergeOut = flag.String("merge", "", "Merge all of the activity FILEs into one, written to this path, gzipped if it ends in .gz")
+var reportPath = flag.String("report", "", "With -merge, write the outcome for each input FILE to this path as a JSON array, updated as each one is decoded")
+var templateText = flag.String("template", "", "Execute this Go text/template against the decoded file body instead of dumping it")
+var templateFile = flag.String("template-file", "", "Like -template, but read the template from this file")
+var maxDepth = flag.Int("max-depth", 0, "Don't descend into structures nested deeper than this (0 for no limit)")
+var maxSlice = flag.Int("max-slice", 0, "Only dump the first N elements of each slice (0 for no limit)")
+var tail = flag.Int("tail", 0, "Only dump the last N elements of each slice, such as the end of an activity. With -max-slice, the first elements are dumped too, with a count of those left out between")
+
+func dumpHeader(h fit.Header) {
+	printIndent(0, "Header:\n")
+	printIndent(1, "Size: %d\n", h.Size)
+	printIndent(1, "ProtocolVersion: %d (%v)\n", h.ProtocolVersion, fit.ProtocolVersion(h.ProtocolVersion))
+	printIndent(1, "ProfileVersion: %d (%d.%02d)\n", h.ProfileVersion, h.ProfileVersion/100, h.ProfileVersion%100)
+	printIndent(1, "DataSize: %d\n", h.DataSize)
+	printIndent(1, "DataType: %q\n", string(h.DataType[:]))
+	// Legacy 12-byte headers don't have a CRC field
+	if h.Size > 12 {
+		printIndent(1, "CRC: 0x%04x\n", h.CRC)
+	}
+	printIndent(0, "---\n")
+}
+
+func run(args []string) (err error) {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	privacy, err := parsePrivacy()
 	if err != nil {
 		return err
 	}
+	normalizeStart, err := parseNormalizeTime()
+	if err != nil {
+		return err
+	}
+
+	if *decodeStats && (*mergeOut != "" || *stream || *headerOnly || *verify) {
+		return cli.Usagef("-stats can't be used with -merge, -stream, -header or -verify")
+	}
+
+	if (*lint || *lintErrors) && (*mergeOut != "" || *stream || *headerOnly || *verify) {
+		return cli.Usagef("-lint can't be used with -merge, -stream, -header or -verify")
+	}
+
+	if *mergeOut != "" {
+		return mergeActivities(*mergeOut, args, privacy, normalizeStart)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE (a path or an http(s) URL)")
+	}
+
+	speedUnit, err := fitdump.ParseSpeedUnit(*speedUnitFlag)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	invalid, err := fitdump.ParseInvalidPolicy(*invalidFlag)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	if invalid != fitdump.InvalidHide && (*format == "influx" || *format == "gpx-route") {
+		return cli.Usagef("-invalid doesn't work with -format %s", *format)
+	}
+
+	if *maxSlice < 0 || *tail < 0 {
+		return cli.Usagef("-max-slice and -tail can't be negative")
+	}
+
+	shared := cli.Options()
+	opts := fitdump.Options{
+		MaxDepth:     *maxDepth,
+		MaxSlice:     *maxSlice,
+		Tail:         *tail,
+		SpeedUnit:    speedUnit,
+		Units:        shared.Units,
+		Missing:      shared.Missing,
+		Minimal:      *minimal,
+		SnakeCase:    *snake,
+		Debugf:       cli.DebugFunc(),
+		Index:        *index,
+		Components:   *components,
+		FieldNumbers: *fieldNumbers || cli.Verbose(),
+		Invalid:      invalid,
+		Paths:        *paths,
+	}
+
+	if *paths && (*format != "text" || *templateText != "" || *templateFile != "" || *index || *fieldNumbers) {
+		return cli.Usagef("-paths only works with the text dump, and not with -index or -with-field-numbers")
+	}
+
+	if *utcOffset != 0 {
+		if *utcOffset < -14*time.Hour || *utcOffset > 14*time.Hour || *utcOffset%time.Minute != 0 {
+			return cli.Usagef("-utc-offset must be whole minutes, between -14h and +14h")
+		}
+		offset := time.FixedZone("", int(utcOffset.Seconds()))
+		opts.Location = time.FixedZone("UTC"+time.Unix(0, 0).In(offset).Format("-07:00"), int(utcOffset.Seconds()))
+	}
+
+	if *preferLegacy {
+		opts.Enhanced = fitdump.PreferLegacy
+	} else if *preferEnhanced {
+		opts.Enhanced = fitdump.PreferEnhanced
+	}
+
+	if *toc && (*format != "text" || *templateText != "" || *templateFile != "") {
+		return cli.Usagef("-toc only works with the text dump")
+	}
+
+	if *nth != "" {
+		sel, err := fitdump.ParseSelector(*nth)
+		if err != nil {
+			return cli.Usagef("-nth: %v", err)
+		}
+		if *format != "text" || *templateText != "" || *templateFile != "" {
+			return cli.Usagef("-nth only works with the text dump")
+		}
+		opts.Select = &sel
+	}
+
+	if *exclude != "" {
+		excl, err := fitdump.ParseExclusion(*exclude)
+		if err != nil {
+			return cli.Usagef("-exclude: %v", err)
+		}
+		// Normalised to the message names, so that numbers work too
+		opts.Exclude = make(fitdump.Exclusion)
+		for name := range excl {
+			num, err := parseHexMessage(name)
+			if err != nil {
+				return cli.Usagef("-exclude: %v", err)
+			}
+			opts.Exclude[hexMessageName(num)] = true
+		}
+		if *nth != "" {
+			return cli.Usagef("-exclude and -nth contradict each other, use one or the other")
+		}
+		if (*format != "text" && *format != "xml") || *templateText != "" || *templateFile != "" {
+			return cli.Usagef("-exclude only works with the text and xml dumps")
+		}
+	}
+
+	var hexNum fit.MesgNum
+	if *hexMsg != "" {
+		hexNum, err = parseHexMessage(*hexMsg)
+		if err != nil {
+			return cli.Usagef("-hex: %v", err)
+		}
+		if *format != "text" || *stream || *templateText != "" || *templateFile != "" || *toc || *nth != "" {
+			return cli.Usagef("-hex can't be used with -format, -stream, -template, -toc or -nth")
+		}
+		if *decodeStats || *lint || *lintErrors {
+			return cli.Usagef("-hex can't be used with -stats or -lint")
+		}
+	}
 
-	// Dump all of the exported fields
-	dumpRecursive(reflect.ValueOf(*fitf), flag.Args()[0], 0)
+	var schema fitdump.Schema
+	if *expectSchema != "" {
+		if *compareProfile || *unknownEnums {
+			return cli.Usagef("-expect-schema can't be used with -compare-profile or -unknown-enums")
+		}
+		schema, err = readSchema(*expectSchema)
+		if err != nil {
+			return cli.Usagef("-expect-schema: %v", err)
+		}
+	}
+
+	if _, ok := fitdump.LookupFormat(*format); !ok {
+		return cli.Usagef("unknown format '%s', expected one of %s", *format, strings.Join(fitdump.FormatNames(), ", "))
+	}
+	// For -stream, which writes the records as they're decoded
+	var comma rune
+	switch *format {
+	case "csv":
+		comma = ','
+	case "tsv":
+		comma = '\t'
+	}
+
+	decimation, err := fitdump.ParseEvery(*every)
+	if err != nil {
+		return cli.Usagef("-every: %v", err)
+	}
+	decimation.Mode, err = fitdump.ParseDecimateMode(*decimateMode)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if *onlyGPS && *format == "text" {
+		return cli.Usagef("-only-gps only works with -format csv, tsv or influx")
+	}
+
+	if *stream && (privacyEnabled() || *normalizeTime != "") {
+		return cli.Usagef("-fuzz-position, -privacy-zone and -normalize-time can't be used with -stream")
+	}
+	if *selectSession < 0 {
+		return cli.Usagef("-select-session must be a session number, counting from 1")
+	}
+	if *selectSession > 0 && *stream {
+		return cli.Usagef("-select-session can't be used with -stream")
+	}
+
+	if *smoothSpeed < 0 {
+		return cli.Usagef("-smooth-speed must be a number of seconds")
+	}
+	if *smoothSpeed > 0 && (*format == "text" || *format == "xml" || *stream) {
+		return cli.Usagef("-smooth-speed only works with -format csv, tsv or influx, without -stream")
+	}
+	if *fillGapsOver < 0 {
+		return cli.Usagef("-fill-gaps must be a positive duration")
+	}
+	if *fillGapsOver > 0 && (*format == "text" || *format == "xml" || *stream) {
+		return cli.Usagef("-fill-gaps only works with -format csv, tsv, influx or gpx-route, without -stream")
+	}
+	if *tempLag < 0 {
+		return cli.Usagef("-temp-lag can't be negative")
+	}
+	if *tempLag > 0 && *format != "csv" && *format != "tsv" && *format != "influx" || *tempLag > 0 && *stream {
+		return cli.Usagef("-temp-lag only works with -format csv, tsv or influx, without -stream")
+	}
+	if *smoothHR && *smoothSpeed == 0 {
+		return cli.Usagef("-smooth-hr needs -smooth-speed")
+	}
+	if *deriveList != "" {
+		formats.derived, err = fitdump.ParseDerived(*deriveList)
+		if err != nil {
+			return cli.Usagef("-derive: %v", err)
+		}
+		if *format != "csv" && *format != "tsv" || *stream {
+			return cli.Usagef("-derive only works with -format csv or tsv, without -stream")
+		}
+	}
+	if *deriveWindow < 0 {
+		return cli.Usagef("-derive-window can't be negative")
+	}
+
+	var curveName string
+	if *curve != "" {
+		curveName, err = curveField(*curve)
+		if err != nil {
+			return cli.Usagef("-curve: %v", err)
+		}
+	}
+
+	if *splitUnit != "km" && *splitUnit != "mi" {
+		return cli.Usagef("-split-unit must be 'km' or 'mi'")
+	}
+
+	var markLength, markScale float64
+	var markUnit string
+	if *markDistance != "" {
+		markLength, markUnit, markScale, err = parseMark(*markDistance)
+		if err != nil {
+			return cli.Usagef("-mark: %v", err)
+		}
+	}
+
+	var tmpl *template.Template
+	if *templateText != "" || *templateFile != "" {
+		if *templateText != "" && *templateFile != "" {
+			return cli.Usagef("-template and -template-file can't be used together")
+		}
+		if *format != "text" {
+			return cli.Usagef("-template can't be used with -format %s", *format)
+		}
+		tmpl, err = parseTemplate(*templateText, *templateFile, opts)
+		if err != nil {
+			return err
+		}
+	}
 
-	// Body isn't exported, so we have to handle it separately
-	body, err := getFileValue(fitf)
+	f, closeInput, err := openInput(args[0])
 	if err != nil {
 		return err
 	}
-	dumpRecursive(body, body.Type().Name(), 0)
+	defer closeInput()
 
-	return nil
-}
+	if *verify {
+		return verifyCRC(f)
+	}
 
-func main() {
+	if *hexMsg != "" {
+		return dumpHex(f, hexNum)
+	}
 
-	flag.Parse()
+	if *stream {
+		if *format == "text" || *format == "gpx-route" || *format == "xml" {
+			return fmt.Errorf("-stream needs -format csv, tsv or influx")
+		}
+		if *every != "" {
+			return fmt.Errorf("-every can't be used with -stream")
+		}
+		if *format == "influx" {
+			return streamInflux(f)
+		}
+		return streamRecords(f, comma, opts)
+	}
+
+	if *headerOnly {
+		h, err := fit.DecodeHeader(f)
+		if err != nil {
+			return err
+		}
+		dumpHeader(h)
+		return nil
+	}
+
+	var decodeOpts []fit.DecodeOption
+	if *compareProfile {
+		decodeOpts = append(decodeOpts, fit.WithUnknownMessages(), fit.WithUnknownFields())
+	}
 
-	err := run()
+	// Only the record tables have columns from the extras, so only they
+	// collect them while decoding
+	tabular := *format == "csv" || *format == "tsv"
+
+	start := time.Now()
+	var fitf *fit.File
+	var extras *fitdump.Extras
+	if tabular {
+		fitf, extras, err = cli.DecodeExtras(f, decodeOpts...)
+	} else {
+		fitf, err = cli.Decode(f, decodeOpts...)
+	}
+	if err != nil {
+		fitf, formats.raw, err = decodeFallback(f, err, decodeOpts)
+		if err != nil {
+			return err
+		}
+		if tabular {
+			extras = readExtras(f)
+		}
+	}
+	if *decodeStats {
+		// Counted now, before anything is dropped, but printed after
+		// the output
+		elapsed := time.Since(start)
+		var body interface{}
+		if v, err := getFileValue(fitf); err == nil && v.IsValid() {
+			body = v.Interface()
+		}
+		defer printDecodeStats(f, cli.Messages(fitf, body), elapsed)
+	}
+
+	if *lint || *lintErrors {
+		// Linted before anything is changed or dropped, but reported
+		// after the output
+		findings, lintErr := lintFile(fitf)
+		if lintErr != nil {
+			return lintErr
+		}
+		defer func() {
+			if err == nil {
+				err = reportLint(findings)
+			}
+		}()
+	}
+
+	if *compareProfile {
+		dumpProfileComparison(fitf)
+		return nil
+	}
+
+	if *unknownEnums {
+		return dumpUnknownEnums(fitf)
+	}
+
+	if *expectSchema != "" {
+		return dumpSchemaCheck(fitf, schema)
+	}
+
+	if *redact {
+		redactFile(fitf)
+	}
+	applyPrivacy(fitf, privacy)
+	normalizeTimes(fitf, normalizeStart)
+
+	if *selectSession > 0 {
+		activity, err := fitf.Activity()
+		if err != nil {
+			return fmt.Errorf("-select-session: %v", err)
+		}
+		if err := fitdump.SelectSession(activity, *selectSession); err != nil {
+			return fmt.Errorf("-select-session: %v", err)
+		}
+	}
+
+	if *batteryReport {
+		activity, err := fitf.Activity()
+		if err != nil {
+			return err
+		}
+		dumpBattery(activity)
+		return nil
+	}
+
+	if *deviceReport {
+		activity, err := fitf.Activity()
+		if err != nil {
+			return err
+		}
+		dumpDevices(activity)
+		return nil
+	}
+
+	if *eventReport {
+		events, err := fileEvents(fitf)
+		if err != nil {
+			return err
+		}
+		dumpEvents(events, opts)
+		return nil
+	}
+
+	if *pauseReport {
+		events, err := fileEvents(fitf)
+		if err != nil {
+			return err
+		}
+		dumpPauses(events)
+		return nil
+	}
+
+	if *workoutReport {
+		workout, err := fitf.Workout()
+		if err != nil {
+			return err
+		}
+		dumpWorkout(workout)
+		return nil
+	}
+
+	if *gearReport {
+		activity, err := fitf.Activity()
+		if err != nil {
+			return err
+		}
+		dumpGears(activity)
+		return nil
+	}
+
+	if *splitReport {
+		activity, err := fitf.Activity()
+		if err != nil {
+			return err
+		}
+		return dumpSplits(activity, *splitUnit, opts)
+	}
+
+	if markLength > 0 {
+		records, err := fileRecords(fitf)
+		if err != nil {
+			return err
+		}
+		return dumpMarks(records, markLength, markUnit, markScale)
+	}
+
+	if *lapReport {
+		activity, err := fitf.Activity()
+		if err != nil {
+			return err
+		}
+		dumpLaps(activity)
+		return nil
+	}
+
+	if curveName != "" {
+		records, err := fileRecords(fitf)
+		if err != nil {
+			return err
+		}
+		dumpCurve(records, curveName, opts)
+		return nil
+	}
+
+	if *tempLag > 0 {
+		// Over all of the records, before any are dropped or added
+		records, err := fileRecords(fitf)
+		if err != nil {
+			return err
+		}
+		fitbuild.ShiftTemperature(records, *tempLag)
+	}
+	if *onlyGPS {
+		dropNoPosition(fitf)
+	}
+	if *fillGapsOver > 0 {
+		fillGaps(fitf)
+	}
+	decimate(fitf, decimation)
+
+	if tmpl != nil {
+		body, err := getFileValue(fitf)
+		if err != nil {
+			return err
+		}
+		return executeTemplate(tmpl, body)
+	}
+
+	formats.opts, formats.extras, formats.name = opts, extras, args[0]
+	if *format == "text" && formats.raw == nil && opts.Select == nil && !*redact {
+		// They can't be redacted, so they're left out with -redact
+		formats.raw = readDroppedMessages(f)
+	}
+	formatter, _ := fitdump.LookupFormat(*format)
+	return formatter(os.Stdout, fitf)
+}
+
+func main() {
+	// For -merge, which writes a file
+	cli.RegisterUnknown()
+	err := run(cli.ParseWithEnv("FIT_DUMP_OPTS"))
 	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+		cli.Error(err)
 	}
 
-	os.Exit(0)
+	os.Exit(cli.ExitCode(err))
 }
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// formatContext is what the built-in formats need besides the decoded
+// file. run fills it in from the flags and the input before any of them are
+// called.
+type formatContext struct {
+	opts fitdump.Options
+	// extras were collected from the file while decoding it, for the
+	// columns the fit package doesn't decode
+	extras *fitdump.Extras
+	// name is the FILE argument, which heads the text dump
+	name string
+	// derived are the -derive columns for the csv and tsv output
+	derived []fitdump.Derived
+	// raw are the messages of a file whose type the fit package can't
+	// decode, from decodeFallback, or those it doesn't keep, from
+	// readDroppedMessages, for the text dump
+	raw []*fitstream.RawMessage
+}
+
+var formats formatContext
+
+func init() {
+	fitdump.RegisterFormat("text", formats.text)
+	fitdump.RegisterFormat("xml", formats.xml)
+	fitdump.RegisterFormat("csv", formats.records(','))
+	fitdump.RegisterFormat("tsv", formats.records('\t'))
+	fitdump.RegisterFormat("influx", formats.influx)
+	fitdump.RegisterFormat("gpx-route", formats.gpxRoute)
+	fitdump.RegisterFormat("html", formats.html)
+}
+
+// text is the tree dump, with a table of contents first for -toc
+func (c *formatContext) text(w io.Writer, fitf *fit.File) error {
+	body, err := getFileValue(fitf)
+	if err != nil {
+		return err
+	}
+	if c.opts.Select != nil {
+		if err := c.opts.Select.Check(body); err != nil {
+			return err
+		}
+	}
+
+	out := w
+	var buf bytes.Buffer
+	if *toc {
+		// The table of contents needs the whole dump first
+		out = &buf
+	}
+	dumper := fitdump.NewDumper(out, c.opts)
+
+	// Dump all of the exported fields
+	dumper.Dump(reflect.ValueOf(*fitf), c.name)
+
+	// Body isn't exported, so we have to handle it separately
+	if body.IsValid() {
+		name := body.Type().Name()
+		if *snake {
+			name = fitdump.SnakeCase(name)
+		}
+		dumper.Dump(body, name)
+	}
+	if len(c.raw) > 0 {
+		title := "Messages"
+		if body.IsValid() {
+			// Those the fit package doesn't keep, after the ones
+			// it does
+			title = "OtherMessages"
+		}
+		dumpRawMessages(out, title, c.raw)
+	}
+
+	if *toc {
+		dumpTOC(dumper.Sections())
+		_, err = buf.WriteTo(w)
+		return err
+	}
+
+	return nil
+}
+
+func (c *formatContext) xml(w io.Writer, fitf *fit.File) error {
+	body, err := getFileValue(fitf)
+	if err != nil {
+		return err
+	}
+	xd := fitdump.NewXMLDumper(w, c.opts)
+	xd.Dump(reflect.ValueOf(*fitf), "File")
+	if body.IsValid() {
+		xd.Dump(body, body.Type().Name())
+	}
+	if err := xd.Close(); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w)
+	return err
+}
+
+// records returns the formatter for the records as delimited text
+func (c *formatContext) records(comma rune) fitdump.Formatter {
+	return func(w io.Writer, fitf *fit.File) error {
+		records, err := exportRecords(fitf)
+		if err != nil {
+			return err
+		}
+		extra := c.extras.Columns()
+		extra = append(extra, fitdump.DerivedColumns(records, c.derived, *deriveWindow)...)
+		return fitdump.WriteRecords(w, records, comma, c.opts, extra...)
+	}
+}
+
+func (c *formatContext) influx(w io.Writer, fitf *fit.File) error {
+	records, err := exportRecords(fitf)
+	if err != nil {
+		return err
+	}
+	iw := fitdump.NewInfluxWriter(w, fitdump.InfluxTags(fitf.FileId, fileSport(fitf)))
+	for _, r := range records {
+		if err := iw.Write(r); err != nil {
+			return err
+		}
+	}
+	return iw.Flush()
+}
+
+func (c *formatContext) gpxRoute(w io.Writer, fitf *fit.File) error {
+	course, err := fitf.Course()
+	if err != nil {
+		return fmt.Errorf("-format gpx-route needs a course file: %v", err)
+	}
+	return fitdump.WriteGPXRoute(w, course, *gpxCues)
+}
+
+// html is a page for sharing, with a summary, a chart and the text dump of
+// each message type
+func (c *formatContext) html(w io.Writer, fitf *fit.File) error {
+	body, err := getFileValue(fitf)
+	if err != nil {
+		return err
+	}
+	return fitdump.WriteHTML(w, filepath.Base(c.name), fitf, body, c.opts)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+func activityEnd(activity *fit.ActivityFile) time.Time {
+	if len(activity.Records) > 0 {
+		return activity.Records[len(activity.Records)-1].Timestamp
+	}
+	if activity.Activity != nil {
+		return activity.Activity.Timestamp
+	}
+	return time.Time{}
+}
+
+func dumpGears(activity *fit.ActivityFile) {
+	var shifts []*fit.EventMsg
+	for _, ev := range activity.Events {
+		if ev.Event == fit.EventFrontGearChange || ev.Event == fit.EventRearGearChange {
+			shifts = append(shifts, ev)
+		}
+	}
+
+	if len(shifts) == 0 {
+		fmt.Println("No gear changes found")
+		return
+	}
+
+	durations := make(map[fitdump.GearChange]time.Duration)
+	end := activityEnd(activity)
+
+	printIndent(0, "Gears:\n")
+	for i, ev := range shifts {
+		gear := fitdump.DecodeGearChange(ev.Data)
+
+		side := "Rear"
+		if ev.Event == fit.EventFrontGearChange {
+			side = "Front"
+		}
+		printIndent(1, "%v: %s shift to %d-%d (%v, ratio %.2f)\n", ev.Timestamp, side,
+			gear.FrontGearNum, gear.RearGearNum, gear, gear.Ratio())
+
+		until := end
+		if i+1 < len(shifts) {
+			until = shifts[i+1].Timestamp
+		}
+		if until.After(ev.Timestamp) {
+			durations[gear] += until.Sub(ev.Timestamp)
+		}
+	}
+	printIndent(0, "---\n")
+
+	gears := make([]fitdump.GearChange, 0, len(durations))
+	for g := range durations {
+		gears = append(gears, g)
+	}
+	sort.Slice(gears, func(i, j int) bool {
+		return gears[i].Ratio() < gears[j].Ratio()
+	})
+
+	printIndent(0, "Time in gear:\n")
+	for _, g := range gears {
+		printIndent(1, "%v (ratio %.2f): %v\n", g, g.Ratio(), durations[g])
+	}
+	printIndent(0, "---\n")
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+func hasPosition(r *fit.RecordMsg) bool {
+	return !r.PositionLat.Invalid() && !r.PositionLong.Invalid()
+}
+
+func withPosition(records []*fit.RecordMsg) []*fit.RecordMsg {
+	var kept []*fit.RecordMsg
+	for _, r := range records {
+		if hasPosition(r) {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// dropNoPosition removes the records without a valid position from the
+// file types which have records, for -only-gps
+func dropNoPosition(fitf *fit.File) {
+	var records *[]*fit.RecordMsg
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, _ := fitf.Activity()
+		records = &activity.Records
+	case fit.FileTypeCourse:
+		course, _ := fitf.Course()
+		records = &course.Records
+	default:
+		return
+	}
+
+	before := len(*records)
+	*records = withPosition(*records)
+	reportDropped(before - len(*records))
+}
+
+// positionFilter wraps fn, for -only-gps when streaming, so that it's only
+// called for records with a valid position. The others are counted in
+// dropped.
+func positionFilter(fn func(*fit.RecordMsg) error, dropped *int) func(*fit.RecordMsg) error {
+	if !*onlyGPS {
+		return fn
+	}
+
+	return func(r *fit.RecordMsg) error {
+		if !hasPosition(r) {
+			*dropped++
+			return nil
+		}
+		return fn(r)
+	}
+}
+
+func reportDropped(n int) {
+	if *onlyGPS {
+		cli.Warnf("dropped %d records without a position", n)
+	}
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// hexBaseType is a FIT base type, as written in field definitions
+type hexBaseType struct {
+	name   string
+	size   int
+	signed bool
+	float  bool
+}
+
+var hexBaseTypes = map[byte]hexBaseType{
+	0x00: {"enum", 1, false, false},
+	0x01: {"sint8", 1, true, false},
+	0x02: {"uint8", 1, false, false},
+	0x07: {"string", 1, false, false},
+	0x0a: {"uint8z", 1, false, false},
+	0x0d: {"byte", 1, false, false},
+	0x83: {"sint16", 2, true, false},
+	0x84: {"uint16", 2, false, false},
+	0x8b: {"uint16z", 2, false, false},
+	0x85: {"sint32", 4, true, false},
+	0x86: {"uint32", 4, false, false},
+	0x8c: {"uint32z", 4, false, false},
+	0x88: {"float32", 4, false, true},
+	0x8e: {"sint64", 8, true, false},
+	0x8f: {"uint64", 8, false, false},
+	0x90: {"uint64z", 8, false, false},
+	0x89: {"float64", 8, false, true},
+}
+
+// hexMessageName returns the profile name of a message, such as "record",
+// or its number if the fit package doesn't know it
+func hexMessageName(num fit.MesgNum) string {
+	name := num.String()
+	if strings.HasPrefix(name, "MesgNum(") {
+		return strconv.Itoa(int(num))
+	}
+	return fitdump.SnakeCase(name)
+}
+
+// parseHexMessage parses the message named for -hex, either by its name in
+// any of the forms -nth takes, or by its global message number
+func parseHexMessage(s string) (fit.MesgNum, error) {
+	if n, err := strconv.ParseUint(s, 0, 16); err == nil {
+		return fit.MesgNum(n), nil
+	}
+
+	name := fitdump.SnakeCase(s)
+	for n := 0; n <= 0xffff; n++ {
+		num := fit.MesgNum(n)
+		if m := hexMessageName(num); m == name || m+"s" == name {
+			return num, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown message '%s'", s)
+}
+
+// hexValue describes the value of a field with a single value, or returns
+// "" for arrays and types it doesn't know
+func hexValue(b []byte, typ byte, order binary.ByteOrder) string {
+	bt, ok := hexBaseTypes[typ]
+	if !ok {
+		return ""
+	}
+	if bt.name == "string" {
+		if i := strings.IndexByte(string(b), 0); i >= 0 {
+			b = b[:i]
+		}
+		return strconv.Quote(string(b))
+	}
+	if len(b) != bt.size {
+		return fmt.Sprintf("%d values", len(b)/bt.size)
+	}
+
+	var u uint64
+	switch bt.size {
+	case 1:
+		u = uint64(b[0])
+	case 2:
+		u = uint64(order.Uint16(b))
+	case 4:
+		u = uint64(order.Uint32(b))
+	case 8:
+		u = order.Uint64(b)
+	}
+
+	invalid := uint64(1)<<(8*bt.size) - 1
+	if bt.size == 8 {
+		invalid = math.MaxUint64
+	}
+	switch {
+	case strings.HasSuffix(bt.name, "z"):
+		invalid = 0
+	case bt.signed:
+		invalid >>= 1
+	}
+	if u == invalid {
+		return "invalid"
+	}
+
+	switch {
+	case bt.float && bt.size == 4:
+		return strconv.FormatFloat(float64(math.Float32frombits(uint32(u))), 'g', -1, 32)
+	case bt.float:
+		return strconv.FormatFloat(math.Float64frombits(u), 'g', -1, 64)
+	case bt.signed:
+		// Sign extend from the field's size
+		shift := 64 - 8*uint(bt.size)
+		return strconv.FormatInt(int64(u<<shift)>>shift, 10)
+	}
+	return strconv.FormatUint(u, 10)
+}
+
+// hexLine prints the bytes b, at offset in the file, with a description of
+// them. Long runs of bytes are split over several lines, 16 to a line, with
+// the description on the first.
+func hexLine(offset int64, b []byte, format string, args ...interface{}) {
+	desc := fmt.Sprintf(format, args...)
+	for {
+		n := len(b)
+		if n > 16 {
+			n = 16
+		}
+		printIndent(2, "0x%06x: %-47s  %s\n", offset, fmt.Sprintf("% x", b[:n]), desc)
+		b, offset, desc = b[n:], offset+int64(n), ""
+		if len(b) == 0 {
+			return
+		}
+	}
+}
+
+func hexFieldName(msg string, num byte) string {
+	if name, ok := fitdump.FieldName(msg, int(num)); ok {
+		return fitdump.SnakeCase(name)
+	}
+	return fmt.Sprintf("field %d", num)
+}
+
+func hexTypeName(typ byte) string {
+	if bt, ok := hexBaseTypes[typ]; ok {
+		return bt.name
+	}
+	return fmt.Sprintf("base type 0x%02x", typ)
+}
+
+// dumpHexDefinition prints the definition message used by raw, annotated
+func dumpHexDefinition(raw *fitstream.RawMessage, msg string) {
+	def := raw.Definition()
+	offset := raw.DefinitionOffset
+
+	printIndent(1, "Definition:\n")
+	hexLine(offset, def[:1], "record header: definition, local type %d", def[0]&0xf)
+	hexLine(offset+1, def[1:2], "reserved")
+	order := "little endian"
+	if def[2] == 1 {
+		order = "big endian"
+	}
+	hexLine(offset+2, def[2:3], "architecture: %s", order)
+	hexLine(offset+3, def[3:5], "global message number: %d", raw.Num)
+	hexLine(offset+5, def[5:6], "%d fields", def[5])
+
+	pos := int64(6)
+	for _, f := range raw.Fields() {
+		hexLine(offset+pos, def[pos:pos+3], "%s (%d): size %d, %s", hexFieldName(msg, f.Num), f.Num, f.Size, hexTypeName(f.BaseType))
+		pos += 3
+	}
+
+	if devFields := raw.DevFields(); int(pos) < len(def) {
+		hexLine(offset+pos, def[pos:pos+1], "%d developer fields", len(devFields))
+		pos++
+		for _, f := range devFields {
+			hexLine(offset+pos, def[pos:pos+3], "developer field %d of developer %d: size %d", f.Num, f.DevIndex, f.Size)
+			pos += 3
+		}
+	}
+}
+
+// dumpHexData prints the data message raw, annotated
+func dumpHexData(raw *fitstream.RawMessage, msg string) {
+	data := raw.Bytes()
+	offset := raw.Offset
+
+	printIndent(1, "Data:\n")
+	if hdr := data[0]; hdr&0x80 != 0 {
+		hexLine(offset, data[:1], "record header: compressed timestamp, local type %d, time offset %d", (hdr>>5)&0x3, hdr&0x1f)
+	} else {
+		hexLine(offset, data[:1], "record header: local type %d", hdr&0xf)
+	}
+
+	pos := int64(1)
+	for _, f := range raw.Fields() {
+		b := data[pos : pos+int64(f.Size)]
+		desc := fmt.Sprintf("%s (%d)", hexFieldName(msg, f.Num), f.Num)
+		if v := hexValue(b, f.BaseType, raw.ByteOrder()); v != "" {
+			desc += ": " + v
+		}
+		hexLine(offset+pos, b, "%s", desc)
+		pos += int64(f.Size)
+	}
+	for _, f := range raw.DevFields() {
+		hexLine(offset+pos, data[pos:pos+int64(f.Size)], "developer field %d of developer %d", f.Num, f.DevIndex)
+		pos += int64(f.Size)
+	}
+}
+
+// dumpHex prints an annotated hex dump of each of the messages of type num
+// in the file, with the definition each one uses
+func dumpHex(r io.Reader, num fit.MesgNum) error {
+	sr, err := fitstream.NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	msg := num.String()
+	name := hexMessageName(num)
+
+	i := 0
+	dump := func(raw *fitstream.RawMessage) {
+		printIndent(0, "%s[%d]:\n", name, i)
+		dumpHexDefinition(raw, msg)
+		dumpHexData(raw, msg)
+		printIndent(0, "---\n")
+		i++
+	}
+
+	if raw := sr.FileIdRaw(); raw.Num == num {
+		dump(raw)
+	}
+	for {
+		_, err := sr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if raw := sr.Raw(); raw.Num == num {
+			dump(raw)
+		}
+	}
+
+	if i == 0 {
+		fmt.Printf("No %s messages found\n", name)
+	}
+	return nil
+}
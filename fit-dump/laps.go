@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// triggerName returns the name of a lap or session trigger, such as
+// Distance or position_start with -snake, or "none" if it's invalid
+func triggerName(trigger fmt.Stringer) string {
+	if fitdump.IsInvalidEnum(trigger) {
+		return "none"
+	}
+	name := trigger.String()
+	if *snake {
+		return fitdump.SnakeCase(name)
+	}
+	return name
+}
+
+func formatLap(start time.Time, timerTime, distance float64) string {
+	d := time.Duration(timerTime * float64(time.Second))
+	return fmt.Sprintf("%v, %s, %.2f km", start, formatSplitTime(d), distance/1000)
+}
+
+// inSession returns whether lap started during session
+func inSession(lap *fit.LapMsg, session *fit.SessionMsg) bool {
+	return !lap.StartTime.Before(session.StartTime) && !lap.StartTime.After(session.Timestamp)
+}
+
+// dumpLaps prints each session and the laps in it, with their start, timer
+// time and distance, and what triggered them, so that auto laps can be told
+// apart from presses of the lap button. It ends with a count of the laps
+// by trigger.
+func dumpLaps(activity *fit.ActivityFile) {
+	if len(activity.Laps) == 0 && len(activity.Sessions) == 0 {
+		fmt.Println("No laps or sessions found")
+		return
+	}
+
+	counts := make(map[string]int)
+	printed := make([]bool, len(activity.Laps))
+	printLap := func(level, i int) {
+		lap := activity.Laps[i]
+		trigger := triggerName(lap.LapTrigger)
+		counts[trigger]++
+		printed[i] = true
+		printIndent(level, "Lap %d: %s, trigger %s\n", i+1,
+			formatLap(lap.StartTime, lap.GetTotalTimerTimeScaled(), lap.GetTotalDistanceScaled()), trigger)
+	}
+
+	printIndent(0, "Laps:\n")
+	for i, s := range activity.Sessions {
+		printIndent(1, "Session %d: %v, %s, trigger %s\n", i+1, s.Sport,
+			formatLap(s.StartTime, s.GetTotalTimerTimeScaled(), s.GetTotalDistanceScaled()), triggerName(s.Trigger))
+		for j, lap := range activity.Laps {
+			if !printed[j] && inSession(lap, s) {
+				printLap(2, j)
+			}
+		}
+	}
+	// Laps outside all of the sessions, or all of them if there are none
+	for j := range activity.Laps {
+		if !printed[j] {
+			printLap(1, j)
+		}
+	}
+
+	var triggers []string
+	for trigger := range counts {
+		triggers = append(triggers, trigger)
+	}
+	sort.Strings(triggers)
+	var parts []string
+	for _, trigger := range triggers {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[trigger], trigger))
+	}
+	if len(parts) > 0 {
+		printIndent(1, "Lap triggers: %s\n", strings.Join(parts, ", "))
+	}
+	printIndent(0, "---\n")
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+// lintFile runs the lint rules over the file's header messages and its body
+func lintFile(fitf *fit.File) ([]fitdump.LintFinding, error) {
+	now := time.Now()
+	findings := fitdump.Lint(reflect.ValueOf(*fitf), now)
+
+	body, err := getFileValue(fitf)
+	if err != nil {
+		return nil, err
+	}
+	if body.IsValid() {
+		findings = append(findings, fitdump.Lint(body, now)...)
+	}
+	return findings, nil
+}
+
+// reportLint warns about each finding and prints the count to stderr, so
+// it doesn't mix with the dump. With -lint-errors, any findings are an
+// error.
+func reportLint(findings []fitdump.LintFinding) error {
+	for _, f := range findings {
+		cli.Warnf("%v", f)
+	}
+	fmt.Fprintf(os.Stderr, "Lint: %d suspicious values found\n", len(findings))
+
+	if *lintErrors && len(findings) > 0 {
+		return fmt.Errorf("lint found %d suspicious values", len(findings))
+	}
+	return nil
+}
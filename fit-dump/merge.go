@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+// decodeFile decodes the file at path with cli.DecodeForWrite, returning
+// the messages it can't write back, to append to the merged file
+func decodeFile(path string) (*fit.File, []*fitstream.RawMessage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	fitf, raw, err := cli.DecodeForWrite(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return fitf, raw, nil
+}
+
+func msgTimestamp(v reflect.Value) (time.Time, bool) {
+	ts := reflect.Indirect(v).FieldByName("Timestamp")
+	if !ts.IsValid() {
+		return time.Time{}, false
+	}
+	return ts.Interface().(time.Time), true
+}
+
+// mergeSlice sorts the messages in the slice by timestamp, if they have one,
+// and drops messages which are exact duplicates of the previous one, which
+// is what happens where the recordings overlap at the join.
+func mergeSlice(slice reflect.Value) (reflect.Value, int) {
+	if slice.Len() == 0 {
+		return slice, 0
+	}
+	if _, ok := msgTimestamp(slice.Index(0)); !ok {
+		return slice, 0
+	}
+
+	sort.SliceStable(slice.Interface(), func(i, j int) bool {
+		ti, _ := msgTimestamp(slice.Index(i))
+		tj, _ := msgTimestamp(slice.Index(j))
+		return ti.Before(tj)
+	})
+
+	merged := reflect.MakeSlice(slice.Type(), 0, slice.Len())
+	dropped := 0
+	for i := 0; i < slice.Len(); i++ {
+		v := slice.Index(i)
+		if merged.Len() > 0 {
+			prev := merged.Index(merged.Len() - 1)
+			if reflect.DeepEqual(v.Interface(), prev.Interface()) {
+				dropped++
+				continue
+			}
+		}
+		merged = reflect.Append(merged, v)
+	}
+
+	return merged, dropped
+}
+
+// mergeRecords drops records which duplicate the timestamp of the previous
+// one. The two devices may have recorded slightly different values for the
+// same instant, so the record from the earlier file is kept.
+func mergeRecords(records []*fit.RecordMsg) ([]*fit.RecordMsg, int) {
+	merged := make([]*fit.RecordMsg, 0, len(records))
+	dropped := 0
+	for _, r := range records {
+		if len(merged) > 0 && !r.Timestamp.After(merged[len(merged)-1].Timestamp) {
+			dropped++
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged, dropped
+}
+
+// mergeActivities merges the activity files in paths into the first, and
+// writes the result to out.
+func mergeActivities(out string, paths []string, privacy fitdump.Privacy, start time.Time) error {
+	if len(paths) < 2 {
+		return cli.Usagef("-merge needs at least two input files")
+	}
+
+	report, err := cli.NewReport(*reportPath)
+	if err != nil {
+		return err
+	}
+
+	// Decode every file even after a failure, so that the report says
+	// which of them are bad
+	var base *fit.File
+	var merged reflect.Value
+	var raw []*fitstream.RawMessage
+	// The raw messages already kept, to drop exact duplicates as
+	// mergeSlice does, such as the same developer's ID in every file
+	seen := make(map[string]bool)
+	failed := 0
+	for _, path := range paths {
+		fitf, fileRaw, err := decodeFile(path)
+		var activity *fit.ActivityFile
+		if err == nil {
+			activity, err = fitf.Activity()
+			if err != nil {
+				err = fmt.Errorf("%s: %v", path, err)
+			}
+		}
+
+		messages := 0
+		if err == nil {
+			messages = cli.Messages(fitf, activity)
+		}
+		if rerr := report.Add(path, messages, err); rerr != nil {
+			return rerr
+		}
+		if err != nil {
+			if report == nil {
+				return err
+			}
+			cli.Error(err)
+			failed++
+			continue
+		}
+		if failed > 0 {
+			continue
+		}
+		for _, m := range fileRaw {
+			// Without the record header, whose local message
+			// type can differ between files
+			key := fmt.Sprint(m.Num, m.Fields(), m.DevFields()) + string(m.Bytes()[1:])
+			if !seen[key] {
+				seen[key] = true
+				raw = append(raw, m)
+			}
+		}
+
+		if base == nil {
+			base = fitf
+			merged = reflect.ValueOf(activity).Elem()
+			continue
+		}
+
+		v := reflect.ValueOf(*activity)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).Kind() != reflect.Slice {
+				continue
+			}
+			field := merged.Field(i)
+			field.Set(reflect.AppendSlice(field, v.Field(i)))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed, so nothing was merged", failed, len(paths))
+	}
+
+	dropped := 0
+	for i := 0; i < merged.NumField(); i++ {
+		field := merged.Field(i)
+		if field.Kind() != reflect.Slice {
+			continue
+		}
+		v, n := mergeSlice(field)
+		field.Set(v)
+		dropped += n
+	}
+
+	// Records are already sorted by mergeSlice
+	activity := merged.Addr().Interface().(*fit.ActivityFile)
+	var n int
+	activity.Records, n = mergeRecords(activity.Records)
+	if n > 0 {
+		cli.Warnf("dropped %d records with overlapping timestamps", n)
+	}
+	dropped += n
+
+	if *redact {
+		redactFile(base)
+	}
+	applyPrivacy(base, privacy)
+	normalizeTimes(base, start)
+
+	if err := cli.WriteFIT(out, base, raw...); err != nil {
+		return err
+	}
+
+	fmt.Printf("Merged %d records from %d files into %s (%d duplicates dropped)\n",
+		len(activity.Records), len(paths), out, dropped)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// tempCopy copies r into a temporary file, which is removed when it's closed
+// with the returned function. The reports re-read the file, so it needs to
+// be seekable.
+func tempCopy(r io.Reader) (*os.File, func(), error) {
+	tmp, err := os.CreateTemp("", "fit-dump-*.fit")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	return tmp, cleanup, nil
+}
+
+// download fetches url into a temporary file
+func download(url string) (*os.File, func(), error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	if resp.ContentLength == 0 {
+		return nil, nil, fmt.Errorf("%s: empty response", url)
+	}
+
+	f, cleanup, err := tempCopy(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", url, err)
+	}
+
+	// Without a Content-Length the body can still turn out to be empty
+	if fi, err := f.Stat(); err == nil && fi.Size() == 0 {
+		cleanup()
+		return nil, nil, fmt.Errorf("%s: empty response", url)
+	}
+
+	return f, cleanup, nil
+}
+
+// gunzip returns f decompressed into a temporary file if it's gzipped, going
+// by its magic number rather than its name. Otherwise it returns f as-is.
+func gunzip(f *os.File, cleanup func()) (*os.File, func(), error) {
+	magic := make([]byte, len(gzipMagic))
+	n, _ := io.ReadFull(f, magic)
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if n < len(gzipMagic) || !bytes.Equal(magic, gzipMagic) {
+		return f, cleanup, nil
+	}
+
+	defer cleanup()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", f.Name(), err)
+	}
+	defer zr.Close()
+
+	return tempCopy(zr)
+}
+
+// openInput opens the FILE argument, which may be a local path or an http
+// or https URL, and may be gzipped. The returned function closes it.
+func openInput(path string) (*os.File, func(), error) {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		f, cleanup, err := download(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		return gunzip(f, cleanup)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return gunzip(f, func() { f.Close() })
+}
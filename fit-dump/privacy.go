@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"flag"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var fuzzPosition = flag.Float64("fuzz-position", 0, "Snap positions to a grid this many metres across, recomputing record distances to match, in the dump and in files written by -merge")
+var privacyZone = flag.String("privacy-zone", "", "Drop records within a circle given as lat,lng,radius (such as 51.5,-0.1,500m), and remove other positions in it, in the dump and in files written by -merge")
+
+var normalizeTime = flag.String("normalize-time", "", "Move every timestamp by the same amount so that the earliest is at this date, such as 2020-01-01 or 2020-01-01T08:00:00Z, or epoch for the FIT epoch, in the dump and in files written by -merge")
+
+// fitEpoch is where -normalize-time epoch puts the earliest timestamp: one
+// second after the FIT epoch, which is the invalid time
+var fitEpoch = time.Date(1989, time.December, 31, 0, 0, 1, 0, time.UTC)
+
+// parseNormalizeTime parses -normalize-time, returning the zero time if
+// it's not set
+func parseNormalizeTime() (time.Time, error) {
+	switch *normalizeTime {
+	case "":
+		return time.Time{}, nil
+	case "epoch":
+		return fitEpoch, nil
+	}
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, *normalizeTime); err == nil {
+			if t.Before(fitEpoch) {
+				return t, cli.Usagef("-normalize-time can't be before the FIT epoch, 1989-12-31")
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, cli.Usagef("-normalize-time must be epoch, a date such as 2020-01-01, or a time such as 2020-01-01T08:00:00Z")
+}
+
+// normalizeTimes moves every timestamp in fitf so that the earliest is at
+// start, unless start is the zero time
+func normalizeTimes(fitf *fit.File, start time.Time) {
+	if start.IsZero() {
+		return
+	}
+
+	body, err := getFileValue(fitf)
+	if err != nil || !body.IsValid() {
+		body = reflect.ValueOf(nil)
+	}
+	earliest, ok := fitdump.EarliestTime(reflect.ValueOf(fitf))
+	if t, bodyOk := fitdump.EarliestTime(body); bodyOk && (!ok || t.Before(earliest)) {
+		earliest, ok = t, true
+	}
+	if !ok {
+		return
+	}
+
+	d := start.Sub(earliest)
+	n := fitdump.ShiftTimes(reflect.ValueOf(fitf), d) + fitdump.ShiftTimes(body, d)
+	cli.Debugf("moved %d timestamps by %v", n, d)
+}
+
+// parsePrivacy parses the privacy flags
+func parsePrivacy() (fitdump.Privacy, error) {
+	p := fitdump.Privacy{FuzzPosition: *fuzzPosition}
+	if *fuzzPosition < 0 {
+		return p, cli.Usagef("-fuzz-position must be positive")
+	}
+
+	if *privacyZone != "" {
+		z, err := fitdump.ParsePrivacyZone(*privacyZone)
+		if err != nil {
+			return p, cli.Usagef("-privacy-zone: %v", err)
+		}
+		p.Zones = append(p.Zones, z)
+	}
+
+	return p, nil
+}
+
+// applyPrivacy hides where the activity or course in fitf happened
+func applyPrivacy(fitf *fit.File, p fitdump.Privacy) {
+	if p.FuzzPosition == 0 && len(p.Zones) == 0 {
+		return
+	}
+
+	var records *[]*fit.RecordMsg
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, _ := fitf.Activity()
+		records = &activity.Records
+	case fit.FileTypeCourse:
+		course, _ := fitf.Course()
+		records = &course.Records
+	}
+
+	if records != nil {
+		before := len(*records)
+		*records = p.DropZones(*records)
+		cli.Debugf("dropped %d records in the privacy zone", before-len(*records))
+	}
+
+	n := 0
+	if body, err := getFileValue(fitf); err == nil && body.IsValid() {
+		n = p.Positions(body)
+	}
+	cli.Debugf("moved or removed %d positions", n)
+
+	if records != nil && p.FuzzPosition > 0 {
+		fitdump.RecomputeDistance(*records)
+	}
+}
+
+// privacyEnabled is used to reject the privacy flags where they can't apply
+func privacyEnabled() bool {
+	return *fuzzPosition != 0 || *privacyZone != ""
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/tormoder/fit"
+)
+
+func formatProfileVersion(v uint16) string {
+	return fmt.Sprintf("%d.%02d", v/100, v%100)
+}
+
+// describeMesgNum names a message number, noting the ones reserved for
+// manufacturer-specific messages, which no profile version will know.
+func describeMesgNum(num fit.MesgNum) (string, bool) {
+	if num >= fit.MesgNumMfgRangeMin && num <= fit.MesgNumMfgRangeMax {
+		return fmt.Sprintf("%d (manufacturer-specific)", num), true
+	}
+	return fmt.Sprintf("%d", num), false
+}
+
+// dumpProfileComparison prints the profile version of the file alongside
+// the one the fit package was generated from, and the messages and fields
+// in the file which the fit package didn't know.
+func dumpProfileComparison(fitf *fit.File) {
+	fileVer := fitf.Header.ProfileVersion
+
+	printIndent(0, "Profile:\n")
+	printIndent(1, "File: %s\n", formatProfileVersion(fileVer))
+	printIndent(1, "Library: %s\n", formatProfileVersion(fit.ProfileVersion))
+	printIndent(0, "---\n")
+
+	unknown := 0
+	unknownMsgs := make(map[fit.MesgNum]bool)
+
+	if len(fitf.UnknownMessages) > 0 {
+		printIndent(0, "Unknown messages:\n")
+		for _, m := range fitf.UnknownMessages {
+			unknownMsgs[m.MesgNum] = true
+			desc, mfg := describeMesgNum(m.MesgNum)
+			if !mfg {
+				unknown++
+			}
+			printIndent(1, "Message %s: %d times\n", desc, m.Count)
+		}
+		printIndent(0, "---\n")
+	}
+
+	// Every field of an unknown message is unknown too, so only list
+	// the unknown fields of known messages
+	var fields []fit.UnknownField
+	for _, f := range fitf.UnknownFields {
+		if !unknownMsgs[f.MesgNum] {
+			fields = append(fields, f)
+		}
+	}
+
+	if len(fields) > 0 {
+		printIndent(0, "Unknown fields:\n")
+		for _, f := range fields {
+			unknown++
+			printIndent(1, "%v field %d: %d times\n", f.MesgNum, f.FieldNum, f.Count)
+		}
+		printIndent(0, "---\n")
+	}
+
+	switch {
+	case unknown == 0:
+		fmt.Println("Everything in the file is known to the fit library")
+	case fileVer > fit.ProfileVersion:
+		fmt.Println("The file's profile is newer than the fit library's; upgrading it may decode the unknown data")
+	default:
+		fmt.Println("The file's profile isn't newer than the fit library's; the unknown data is probably undocumented")
+	}
+}
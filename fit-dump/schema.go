@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+func readSchema(path string) (fitdump.Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	schema, err := fitdump.ParseSchema(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return schema, nil
+}
+
+// dumpSchemaCheck prints whether the file matches schema, listing each
+// difference. A file which doesn't match is an error, so that scripts can
+// check the exit code.
+func dumpSchemaCheck(fitf *fit.File, schema fitdump.Schema) error {
+	present := fitdump.FieldsPresent(reflect.ValueOf(*fitf))
+
+	body, err := getFileValue(fitf)
+	if err != nil {
+		return err
+	}
+	if body.IsValid() {
+		present = append(present, fitdump.FieldsPresent(body)...)
+	}
+
+	problems := schema.Check(present)
+
+	printIndent(0, "Schema:\n")
+	for _, p := range problems {
+		printIndent(1, "%v\n", p)
+	}
+	if len(problems) == 0 {
+		printIndent(1, "PASS\n")
+	} else {
+		printIndent(1, "FAIL: %d differences\n", len(problems))
+	}
+	printIndent(0, "---\n")
+
+	if len(problems) > 0 {
+		return fmt.Errorf("the file doesn't match the schema")
+	}
+	return nil
+}
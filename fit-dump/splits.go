@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+type split struct {
+	// Distance in metres, and the timer time taken to cover it
+	distance float64
+	time     time.Duration
+}
+
+// lapSplits returns the laps as splits, if they were triggered by distance.
+// The last lap is usually shorter, ended by the session.
+func lapSplits(laps []*fit.LapMsg) ([]split, bool) {
+	if len(laps) < 2 {
+		return nil, false
+	}
+
+	var splits []split
+	for i, lap := range laps {
+		if i < len(laps)-1 && lap.LapTrigger != fit.LapTriggerDistance {
+			return nil, false
+		}
+		splits = append(splits, split{
+			distance: lap.GetTotalDistanceScaled(),
+			time:     time.Duration(lap.GetTotalTimerTimeScaled() * float64(time.Second)),
+		})
+	}
+
+	return splits, true
+}
+
+// evenSplits divides the records into splits of length metres, finding the
+// time each one ends by interpolating between the records either side.
+// Gaps between records longer than fitbuild.DefaultPauseGap are taken to be
+// the timer being stopped, and don't count.
+func evenSplits(records []*fit.RecordMsg, length float64) ([]split, error) {
+	var splits []split
+	var timer, splitStart time.Duration
+	var prev *fit.RecordMsg
+	prevDist, next := 0.0, length
+
+	for _, r := range records {
+		dist := r.GetDistanceScaled()
+		if math.IsNaN(dist) {
+			continue
+		}
+
+		if prev != nil {
+			dt := r.Timestamp.Sub(prev.Timestamp)
+			if dt > fitbuild.DefaultPauseGap {
+				dt = 0
+			}
+
+			for dist >= next && dist > prevDist {
+				// Where between prev and r the split ends
+				frac := (next - prevDist) / (dist - prevDist)
+				at := timer + time.Duration(frac*float64(dt))
+				splits = append(splits, split{distance: length, time: at - splitStart})
+				splitStart = at
+				next += length
+			}
+
+			timer += dt
+		}
+
+		prev, prevDist = r, dist
+	}
+
+	if prev == nil {
+		return nil, fmt.Errorf("the records have no distance")
+	}
+
+	if rest := prevDist - (next - length); rest > 0 && timer > splitStart {
+		splits = append(splits, split{distance: rest, time: timer - splitStart})
+	}
+
+	return splits, nil
+}
+
+func formatSplitTime(d time.Duration) string {
+	secs := int(math.Round(d.Seconds()))
+	if secs >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+	}
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
+// dumpSplits prints the split times and paces of an activity, in unit "km"
+// or "mi". Laps are used if they were split by distance, otherwise the
+// splits are at every whole unit. The paces follow opts.Missing.
+func dumpSplits(activity *fit.ActivityFile, unit string, opts fitdump.Options) error {
+	length, pace := 1000.0, fitdump.SpeedMinutesPerKilometer
+	if unit == "mi" {
+		length, pace = 1609.344, fitdump.SpeedMinutesPerMile
+	}
+	opts.SpeedUnit = pace
+
+	splits, ok := lapSplits(activity.Laps)
+	source := "laps"
+	if !ok {
+		var err error
+		splits, err = evenSplits(activity.Records, length)
+		if err != nil {
+			return err
+		}
+		source = unit
+	}
+
+	printIndent(0, "Splits (%s):\n", source)
+	var total time.Duration
+	for i, s := range splits {
+		total += s.time
+		mps := 0.0
+		if s.time > 0 {
+			mps = s.distance / s.time.Seconds()
+		}
+		printIndent(1, "%d: %.2f %s in %s, %s, total %s\n", i+1, s.distance/length, unit,
+			formatSplitTime(s.time), opts.FormatSpeed(mps), formatSplitTime(total))
+	}
+	printIndent(0, "---\n")
+
+	return nil
+}
+
+// distanceUnits are the units -mark takes, in metres, longest suffix first
+var distanceUnits = []struct {
+	name   string
+	metres float64
+}{{"km", 1000}, {"mi", 1609.344}, {"m", 1}}
+
+// parseMark parses a -mark distance such as 1km, 0.5mi or 400m, returning it
+// in metres, and the unit and its length in metres to print distances in
+func parseMark(s string) (float64, string, float64, error) {
+	for _, u := range distanceUnits {
+		if !strings.HasSuffix(s, u.name) {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSuffix(s, u.name), 64)
+		if err != nil || v <= 0 || math.IsInf(v, 0) {
+			return 0, "", 0, fmt.Errorf("invalid distance '%s'", s)
+		}
+		return v * u.metres, u.name, u.metres, nil
+	}
+	return 0, "", 0, fmt.Errorf("distance '%s' needs a unit: km, mi or m", s)
+}
+
+type mark struct {
+	// Distance in metres
+	distance  float64
+	timestamp time.Time
+	// Time since the first record, including pauses
+	elapsed time.Duration
+}
+
+// distanceMarks returns where the records' distance crosses each multiple of
+// length metres, interpolating the time between the records either side
+func distanceMarks(records []*fit.RecordMsg, length float64) ([]mark, error) {
+	var marks []mark
+	var start time.Time
+	var prev *fit.RecordMsg
+	prevDist, next := 0.0, length
+
+	for _, r := range records {
+		dist := r.GetDistanceScaled()
+		if math.IsNaN(dist) {
+			continue
+		}
+
+		if prev == nil {
+			start = r.Timestamp
+		} else {
+			dt := r.Timestamp.Sub(prev.Timestamp)
+			for dist >= next && dist > prevDist {
+				frac := (next - prevDist) / (dist - prevDist)
+				at := prev.Timestamp.Add(time.Duration(frac * float64(dt)))
+				marks = append(marks, mark{distance: next, timestamp: at, elapsed: at.Sub(start)})
+				next += length
+			}
+		}
+
+		prev, prevDist = r, dist
+	}
+
+	if prev == nil {
+		return nil, fmt.Errorf("the records have no distance")
+	}
+
+	return marks, nil
+}
+
+// dumpMarks prints the time at each multiple of length metres along the
+// records, whatever laps the device recorded. Distances are printed in unit,
+// which is scale metres long.
+func dumpMarks(records []*fit.RecordMsg, length float64, unit string, scale float64) error {
+	marks, err := distanceMarks(records, length)
+	if err != nil {
+		return err
+	}
+
+	printIndent(0, "Marks (every %g %s):\n", length/scale, unit)
+	for _, m := range marks {
+		printIndent(1, "%.2f %s: %v, elapsed %s\n", m.distance/scale, unit,
+			m.timestamp.Round(time.Second), formatSplitTime(m.elapsed))
+	}
+	if len(marks) == 0 {
+		printIndent(1, "The records don't reach %g %s\n", length/scale, unit)
+	}
+	printIndent(0, "---\n")
+
+	return nil
+}
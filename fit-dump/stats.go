@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// printDecodeStats prints how long decoding f took to stderr, with the
+// number of messages, the file size and the throughput, for benchmarking the
+// fit package.
+func printDecodeStats(f *os.File, messages int, elapsed time.Duration) {
+	var size int64
+	if fi, err := f.Stat(); err == nil {
+		size = fi.Size()
+	}
+
+	rate := float64(size) / 1e6 / elapsed.Seconds()
+	fmt.Fprintf(os.Stderr, "Decoded %d messages from %d bytes in %v (%.1f MB/s)\n",
+		messages, size, elapsed.Round(time.Microsecond), rate)
+}
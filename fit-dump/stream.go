@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// forEachRecord calls fn with each record in r, decoding them one at a time.
+// If extras isn't nil, every message is added to it too.
+func forEachRecord(r io.Reader, extras *fitdump.Extras, fn func(*fit.RecordMsg) error) error {
+	sr, err := fitstream.NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := sr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if extras != nil {
+			extras.Add(sr.Raw())
+		}
+		if rec, ok := msg.(*fit.RecordMsg); ok {
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// streamRecords writes the records of f as delimited text without holding
+// them all in memory. The file is read twice: to find which columns have any
+// data while collecting the respiration rate, SpO2 and developer field
+// samples, and then to write them. Only the samples are kept, not the
+// records.
+func streamRecords(f *os.File, comma rune, opts fitdump.Options) error {
+	var columns fitdump.RecordColumns
+	var dropped int
+	extras := fitdump.NewExtras()
+	err := forEachRecord(f, extras, positionFilter(func(r *fit.RecordMsg) error {
+		columns.Add(r)
+		return nil
+	}, new(int)))
+	if err != nil {
+		return err
+	}
+
+	for _, col := range extras.Columns() {
+		columns.AddExtra(col)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	rw := fitdump.NewRecordWriter(os.Stdout, comma, &columns, opts)
+	err = forEachRecord(f, nil, positionFilter(rw.Write, &dropped))
+	if err != nil {
+		return err
+	}
+	reportDropped(dropped)
+
+	return rw.Flush()
+}
+
+// streamInflux writes the records of f as Influx line protocol without
+// holding them all in memory. The sport tag comes from the session or
+// course message, which is usually after the records, so the file is read
+// twice.
+func streamInflux(f *os.File) error {
+	sr, err := fitstream.NewReader(f)
+	if err != nil {
+		return err
+	}
+
+	sport := fit.SportInvalid
+	for sport == fit.SportInvalid {
+		msg, err := sr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		switch m := msg.(type) {
+		case *fit.SessionMsg:
+			sport = m.Sport
+		case *fit.CourseMsg:
+			sport = m.Sport
+		}
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	fileId := sr.FileId()
+	if *redact {
+		fitdump.Redact(reflect.ValueOf(&fileId))
+	}
+
+	iw := fitdump.NewInfluxWriter(os.Stdout, fitdump.InfluxTags(fileId, sport))
+	var dropped int
+	err = forEachRecord(f, nil, positionFilter(iw.Write, &dropped))
+	if err != nil {
+		return err
+	}
+	reportDropped(dropped)
+
+	return iw.Flush()
+}
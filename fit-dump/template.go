@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"text/template"
+
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// parseTemplate parses the template given with -template or -template-file.
+// The template is named after where it came from, so that errors read like
+// "template: route.tmpl:3:12: ...". Parse errors only have the line, errors
+// while executing have the column too.
+func parseTemplate(text, path string, opts fitdump.Options) (*template.Template, error) {
+	name := "-template"
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		text, name = string(b), path
+	}
+
+	return template.New(name).Funcs(fitdump.TemplateFuncs(opts)).Parse(text)
+}
+
+// executeTemplate runs tmpl against body, the fit.XXXFile of the file
+func executeTemplate(tmpl *template.Template, body reflect.Value) error {
+	if !body.IsValid() {
+		return fmt.Errorf("file has no body for the template")
+	}
+
+	// Pass a pointer, so that methods on the body can be used too
+	ptr := reflect.New(body.Type())
+	ptr.Elem().Set(body)
+
+	return tmpl.Execute(os.Stdout, ptr.Interface())
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// dumpTOC prints where each section of the dump starts. The lines and
+// offsets count from the start of the dump, after the table of contents.
+func dumpTOC(sections []fitdump.Section) {
+	printIndent(0, "Contents:\n")
+	for _, s := range sections {
+		if s.Count == 0 {
+			printIndent(1, "%s: line %d, byte %d\n", s.Name, s.Line, s.Offset)
+		} else {
+			printIndent(1, "%s (%d elems): line %d, byte %d\n", s.Name, s.Count, s.Line, s.Offset)
+		}
+	}
+	printIndent(0, "---\n")
+}
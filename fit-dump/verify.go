@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/tormoder/fit/dyncrc16"
+)
+
+// verifyCRC checks the file CRC, which covers the header and the data
+// section. fit.Decode does check it, but doesn't tell us the values, so it's
+// calculated directly here.
+func verifyCRC(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	if len(data) < 12 {
+		return fmt.Errorf("file too short for a FIT header (%d bytes)", len(data))
+	}
+
+	size := int(data[0]) + int(binary.LittleEndian.Uint32(data[4:8]))
+	if len(data) < size+2 {
+		return fmt.Errorf("file truncated: expected %d bytes, got %d", size+2, len(data))
+	}
+
+	stored := binary.LittleEndian.Uint16(data[size : size+2])
+	computed := dyncrc16.Checksum(data[:size])
+	if stored != computed {
+		fmt.Printf("CRC: MISMATCH (stored=0x%04x, computed=0x%04x)\n", stored, computed)
+		return fmt.Errorf("CRC check failed")
+	}
+
+	fmt.Println("CRC: OK")
+
+	return nil
+}
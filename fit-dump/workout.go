@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// splitWorkoutHr returns the value and unit of a workout_hr, which is either
+// a percentage of max HR or, offset by 100, beats per minute.
+func splitWorkoutHr(hr fit.WorkoutHr) (uint32, string) {
+	if hr >= fit.WorkoutHrBpmOffset {
+		return uint32(hr - fit.WorkoutHrBpmOffset), " bpm"
+	}
+	return uint32(hr), "% max HR"
+}
+
+// splitWorkoutPower returns the value and unit of a workout_power, which is
+// either a percentage of FTP or, offset by 1000, watts.
+func splitWorkoutPower(power fit.WorkoutPower) (uint32, string) {
+	if power >= fit.WorkoutPowerWattsOffset {
+		return uint32(power - fit.WorkoutPowerWattsOffset), " W"
+	}
+	return uint32(power), "% FTP"
+}
+
+func formatWorkoutHr(hr fit.WorkoutHr) string {
+	v, unit := splitWorkoutHr(hr)
+	return fmt.Sprintf("%d%s", v, unit)
+}
+
+func formatWorkoutPower(power fit.WorkoutPower) string {
+	v, unit := splitWorkoutPower(power)
+	return fmt.Sprintf("%d%s", v, unit)
+}
+
+// formatRange formats low to high, with the unit only once if they share it
+func formatRange(low uint32, lowUnit string, high uint32, highUnit string) string {
+	switch {
+	case low == high && lowUnit == highUnit:
+		return fmt.Sprintf("%d%s", low, lowUnit)
+	case lowUnit == highUnit:
+		return fmt.Sprintf("%d-%d%s", low, high, lowUnit)
+	}
+	return fmt.Sprintf("%d%s - %d%s", low, lowUnit, high, highUnit)
+}
+
+func formatStepTime(ms uint32) string {
+	d := time.Duration(ms) * time.Millisecond
+	return fmt.Sprintf("%d:%02d", int(d.Minutes()), int(d.Seconds())%60)
+}
+
+func formatStepDistance(cm uint32) string {
+	m := float64(cm) / 100
+	if m >= 1000 {
+		return fmt.Sprintf("%.2f km", m/1000)
+	}
+	return fmt.Sprintf("%.0f m", m)
+}
+
+func describeDuration(step *fit.WorkoutStepMsg) string {
+	v := step.DurationValue
+
+	switch step.DurationType {
+	case fit.WktStepDurationTime, fit.WktStepDurationRepetitionTime, fit.WktStepDurationTimeOnly:
+		return formatStepTime(v)
+	case fit.WktStepDurationDistance:
+		return formatStepDistance(v)
+	case fit.WktStepDurationHrLessThan:
+		return "until HR < " + formatWorkoutHr(fit.WorkoutHr(v))
+	case fit.WktStepDurationHrGreaterThan:
+		return "until HR > " + formatWorkoutHr(fit.WorkoutHr(v))
+	case fit.WktStepDurationPowerLessThan:
+		return "until power < " + formatWorkoutPower(fit.WorkoutPower(v))
+	case fit.WktStepDurationPowerGreaterThan:
+		return "until power > " + formatWorkoutPower(fit.WorkoutPower(v))
+	case fit.WktStepDurationCalories:
+		return fmt.Sprintf("%d kcal", v)
+	case fit.WktStepDurationReps:
+		return fmt.Sprintf("%d reps", v)
+	case fit.WktStepDurationOpen:
+		return "open"
+	}
+
+	return fmt.Sprintf("%v %d", step.DurationType, v)
+}
+
+// describeTarget returns the step's target, or "" if it doesn't have one.
+// A target value of 0 means the custom range applies, otherwise it's a zone.
+func describeTarget(step *fit.WorkoutStepMsg) string {
+	zone := step.TargetValue
+	low, high := step.CustomTargetValueLow, step.CustomTargetValueHigh
+
+	switch step.TargetType {
+	case fit.WktStepTargetOpen, fit.WktStepTargetInvalid:
+		return ""
+	case fit.WktStepTargetHeartRate:
+		if zone != 0 {
+			return fmt.Sprintf("HR Zone %d", zone)
+		}
+		l, lu := splitWorkoutHr(fit.WorkoutHr(low))
+		h, hu := splitWorkoutHr(fit.WorkoutHr(high))
+		return "HR " + formatRange(l, lu, h, hu)
+	case fit.WktStepTargetPower:
+		if zone != 0 {
+			return fmt.Sprintf("Power Zone %d", zone)
+		}
+		l, lu := splitWorkoutPower(fit.WorkoutPower(low))
+		h, hu := splitWorkoutPower(fit.WorkoutPower(high))
+		return "Power " + formatRange(l, lu, h, hu)
+	case fit.WktStepTargetSpeed:
+		if zone != 0 {
+			return fmt.Sprintf("Speed Zone %d", zone)
+		}
+		return fmt.Sprintf("Speed %.1f-%.1f km/h", float64(low)*3.6/1000, float64(high)*3.6/1000)
+	case fit.WktStepTargetCadence:
+		if zone != 0 {
+			return fmt.Sprintf("Cadence Zone %d", zone)
+		}
+		return "Cadence " + formatRange(low, " rpm", high, " rpm")
+	case fit.WktStepTargetSwimStroke:
+		return fmt.Sprintf("%v", fit.SwimStroke(zone))
+	}
+
+	return fmt.Sprintf("%v %d", step.TargetType, zone)
+}
+
+// describeRepeat describes a repeat step, which jumps back to the step in
+// DurationValue until the condition in TargetValue is met. ok is false if
+// step isn't a repeat.
+func describeRepeat(step *fit.WorkoutStepMsg) (desc string, ok bool) {
+	from := step.DurationValue + 1
+	to := uint32(step.MessageIndex)
+	steps := fmt.Sprintf("steps %d-%d", from, to)
+	if from == to {
+		steps = fmt.Sprintf("step %d", from)
+	}
+
+	v := step.TargetValue
+	switch step.DurationType {
+	case fit.WktStepDurationRepeatUntilStepsCmplt:
+		return fmt.Sprintf("Repeat %s, %d times in total", steps, v), true
+	case fit.WktStepDurationRepeatUntilTime:
+		return fmt.Sprintf("Repeat %s until %s has passed", steps, formatStepTime(v)), true
+	case fit.WktStepDurationRepeatUntilDistance:
+		return fmt.Sprintf("Repeat %s until %s has been covered", steps, formatStepDistance(v)), true
+	case fit.WktStepDurationRepeatUntilCalories:
+		return fmt.Sprintf("Repeat %s until %d kcal", steps, v), true
+	case fit.WktStepDurationRepeatUntilHrLessThan:
+		return fmt.Sprintf("Repeat %s until HR < %s", steps, formatWorkoutHr(fit.WorkoutHr(v))), true
+	case fit.WktStepDurationRepeatUntilHrGreaterThan:
+		return fmt.Sprintf("Repeat %s until HR > %s", steps, formatWorkoutHr(fit.WorkoutHr(v))), true
+	case fit.WktStepDurationRepeatUntilPowerLessThan:
+		return fmt.Sprintf("Repeat %s until power < %s", steps, formatWorkoutPower(fit.WorkoutPower(v))), true
+	case fit.WktStepDurationRepeatUntilPowerGreaterThan:
+		return fmt.Sprintf("Repeat %s until power > %s", steps, formatWorkoutPower(fit.WorkoutPower(v))), true
+	}
+
+	return "", false
+}
+
+func dumpWorkout(workout *fit.WorkoutFile) {
+	sport := ""
+	if workout.Workout != nil {
+		name := workout.Workout.WktName
+		if name == "" {
+			name = "(unnamed)"
+		}
+		sport = workout.Workout.Sport.String()
+		if workout.Workout.Sport == fit.SportInvalid {
+			sport = ""
+		}
+		printIndent(0, "Workout: %s", name)
+		if sport != "" {
+			fmt.Printf(" (%s)", sport)
+		}
+		fmt.Println()
+	} else {
+		printIndent(0, "Workout:\n")
+	}
+
+	for _, step := range workout.WorkoutSteps {
+		n := int(step.MessageIndex) + 1
+
+		if desc, ok := describeRepeat(step); ok {
+			printIndent(1, "Step %d: %s\n", n, desc)
+			continue
+		}
+
+		parts := []string{}
+		if step.WktStepName != "" {
+			parts = append(parts, step.WktStepName)
+		} else if sport != "" {
+			parts = append(parts, sport)
+		}
+		parts = append(parts, describeDuration(step))
+		if target := describeTarget(step); target != "" {
+			parts = append(parts, "@", target)
+		}
+		if step.Intensity != fit.IntensityInvalid {
+			parts = append(parts, fmt.Sprintf("(%v)", step.Intensity))
+		}
+
+		printIndent(1, "Step %d: %s\n", n, strings.Join(parts, " "))
+		if step.Notes != "" {
+			printIndent(2, "%s\n", step.Notes)
+		}
+	}
+	printIndent(0, "---\n")
+}
@@ -0,0 +1,376 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-edit sets the name of a FIT file, which some platforms show as its
+// title, or adds a note to an activity, and re-encodes it, printing which
+// message and field it wrote so that it's clear what a platform can pick
+// up. Courses take the name in the course message, and workouts in the
+// workout message. Activities take it in a sport message, which the fit
+// package doesn't keep, so the activity's sport message is replaced, or one
+// added, as a raw message. No message has a field for notes, so an
+// activity's note goes in a developer field "notes" on that sport message.
+// Editing a file again replaces its name or note, keeping the one which
+// isn't given. Other file types, and notes for anything but an
+// activity, are an error rather than being written somewhere a platform
+// won't look.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitstream"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var name = flag.String("name", "", "Name to give the file, such as \"Sunrise loop\"")
+var note = flag.String("note", "", "Note to add to an activity, as the developer field \"notes\" of a sport message. Other file types have nowhere for one")
+var out = flag.String("o", "", "Path to write the edited file to, gzipped if it ends in .gz")
+
+// FIT strings are written with a terminating NUL in a field of at most 255
+// bytes
+const maxString = 254
+
+// Field numbers and base types of the messages written for activities
+const (
+	sportSport    = 0
+	sportSubSport = 1
+	sportName     = 3
+
+	developerDataIdDevDataIndex = 3
+
+	fieldDescriptionDevDataIndex = 0
+	fieldDescriptionFieldNum     = 1
+	fieldDescriptionBaseType     = 2
+	fieldDescriptionName         = 3
+
+	baseTypeEnum   = 0x00
+	baseTypeUint8  = 0x02
+	baseTypeString = 0x07
+
+	// The developer field number of the note
+	noteFieldNum = 0
+)
+
+func fitString(s string) []byte {
+	return append([]byte(s), 0)
+}
+
+// cString returns the text of a FIT string field, up to its terminating NUL
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+// noteField is the developer field holding an activity's note
+type noteField struct {
+	devIndex, num byte
+}
+
+// findNoteField returns the developer field which a field description in
+// raw names "notes", such as the one added by an earlier edit
+func findNoteField(raw []*fitstream.RawMessage) (noteField, bool) {
+	for _, m := range raw {
+		if m.Num != fit.MesgNumFieldDescription {
+			continue
+		}
+		name, ok := m.Field(fieldDescriptionName)
+		if !ok || cString(name) != "notes" {
+			continue
+		}
+		index, ok1 := m.Uint(fieldDescriptionDevDataIndex)
+		num, ok2 := m.Uint(fieldDescriptionFieldNum)
+		if ok1 && ok2 {
+			return noteField{byte(index), byte(num)}, true
+		}
+	}
+	return noteField{}, false
+}
+
+// freeDevIndex returns a developer data index which none of the raw
+// messages use, for their developer fields or in a developer data ID or
+// field description
+func freeDevIndex(raw []*fitstream.RawMessage) (byte, error) {
+	used := make(map[byte]bool)
+	for _, m := range raw {
+		for _, f := range m.DevFields() {
+			used[f.DevIndex] = true
+		}
+		var index uint64
+		var ok bool
+		switch m.Num {
+		case fit.MesgNumDeveloperDataId:
+			index, ok = m.Uint(developerDataIdDevDataIndex)
+		case fit.MesgNumFieldDescription:
+			index, ok = m.Uint(fieldDescriptionDevDataIndex)
+		}
+		if ok {
+			used[byte(index)] = true
+		}
+	}
+	for i := 0; i < 0xff; i++ {
+		if !used[byte(i)] {
+			return byte(i), nil
+		}
+	}
+	return 0, fmt.Errorf("no free developer data index for the note")
+}
+
+// editActivity returns raw, the messages of an activity which the fit
+// package doesn't keep, with its name and note set. They're in a sport
+// message: the activity's first one replaced, or a new one with the sport of
+// its first session. The name, if there is one, is the sport's name field,
+// and the note, if there is one, a developer field. A name or note which
+// isn't given is kept from the sport message being replaced. The
+// developer's ID and the description of the note's field are added before
+// the sport message, unless an earlier edit added them. It returns the
+// developer field of the note, and whether the sport message is new.
+func editActivity(activity *fit.ActivityFile, raw []*fitstream.RawMessage, name, note string) ([]*fitstream.RawMessage, noteField, bool, error) {
+	sport, subSport := fit.SportInvalid, fit.SubSportInvalid
+	if len(activity.Sessions) > 0 {
+		sport, subSport = activity.Sessions[0].Sport, activity.Sessions[0].SubSport
+	}
+
+	pos := -1
+	for i, m := range raw {
+		if m.Num == fit.MesgNumSport {
+			pos = i
+			break
+		}
+	}
+
+	nf, described := findNoteField(raw)
+	var fields []fitstream.FieldDef
+	var data []byte
+	var devFields []fitstream.DevFieldDef
+	var devData []byte
+	if pos >= 0 {
+		old := raw[pos]
+		if v, ok := old.Uint(sportSport); ok {
+			sport = fit.Sport(v)
+		}
+		if v, ok := old.Uint(sportSubSport); ok {
+			subSport = fit.SubSport(v)
+		}
+		if v, ok := old.Field(sportName); ok && name == "" {
+			name = cString(v)
+		}
+		if v, ok := old.DevField(nf.devIndex, nf.num); ok && described && note == "" {
+			note = cString(v)
+		}
+
+		// The fields which aren't being set are kept as they are,
+		// which is only possible if they're in the same byte order
+		if old.ByteOrder() == binary.LittleEndian {
+			fields, data = otherFields(old.Fields(), old.Bytes()[1:], sportSport, sportSubSport, sportName)
+			devFields, devData = otherDevFields(old, nf)
+		} else {
+			cli.Warnf("the sport message is big-endian, so only its sport, sub-sport, name and note are kept")
+		}
+	}
+
+	var added []*fitstream.RawMessage
+	if note != "" && !described {
+		devIndex, err := freeDevIndex(raw)
+		if err != nil {
+			return nil, noteField{}, false, err
+		}
+		nf = noteField{devIndex, noteFieldNum}
+		added = append(added,
+			fitstream.NewRawMessage(fit.MesgNumDeveloperDataId,
+				[]fitstream.FieldDef{{Num: developerDataIdDevDataIndex, Size: 1, BaseType: baseTypeUint8}},
+				[]byte{nf.devIndex}, nil, nil),
+			fitstream.NewRawMessage(fit.MesgNumFieldDescription,
+				[]fitstream.FieldDef{
+					{Num: fieldDescriptionDevDataIndex, Size: 1, BaseType: baseTypeUint8},
+					{Num: fieldDescriptionFieldNum, Size: 1, BaseType: baseTypeUint8},
+					{Num: fieldDescriptionBaseType, Size: 1, BaseType: baseTypeUint8},
+					{Num: fieldDescriptionName, Size: byte(len("notes") + 1), BaseType: baseTypeString},
+				},
+				append([]byte{nf.devIndex, nf.num, baseTypeString}, fitString("notes")...), nil, nil),
+		)
+	}
+
+	fields = append([]fitstream.FieldDef{
+		{Num: sportSport, Size: 1, BaseType: baseTypeEnum},
+		{Num: sportSubSport, Size: 1, BaseType: baseTypeEnum},
+	}, fields...)
+	data = append([]byte{byte(sport), byte(subSport)}, data...)
+	if name != "" {
+		fields = append(fields, fitstream.FieldDef{Num: sportName, Size: byte(len(name) + 1), BaseType: baseTypeString})
+		data = append(data, fitString(name)...)
+	}
+	if note != "" {
+		devFields = append(devFields, fitstream.DevFieldDef{Num: nf.num, Size: byte(len(note) + 1), DevIndex: nf.devIndex})
+		devData = append(devData, fitString(note)...)
+	}
+	added = append(added, fitstream.NewRawMessage(fit.MesgNumSport, fields, data, devFields, devData))
+
+	if pos < 0 {
+		return append(raw, added...), nf, true, nil
+	}
+	edited := append(append(append([]*fitstream.RawMessage(nil), raw[:pos]...), added...), raw[pos+1:]...)
+	return edited, nf, false, nil
+}
+
+// otherFields returns the field definitions, and the values from data, of
+// the fields other than those numbered skip
+func otherFields(defs []fitstream.FieldDef, data []byte, skip ...byte) ([]fitstream.FieldDef, []byte) {
+	var fields []fitstream.FieldDef
+	var values []byte
+	pos := 0
+	for _, f := range defs {
+		v := data[pos : pos+int(f.Size)]
+		pos += int(f.Size)
+		if bytes.IndexByte(skip, f.Num) >= 0 {
+			continue
+		}
+		fields = append(fields, f)
+		values = append(values, v...)
+	}
+	return fields, values
+}
+
+// otherDevFields returns the developer field definitions, and the values,
+// of m's developer fields other than the note
+func otherDevFields(m *fitstream.RawMessage, nf noteField) ([]fitstream.DevFieldDef, []byte) {
+	var fields []fitstream.DevFieldDef
+	var values []byte
+	for _, f := range m.DevFields() {
+		if f.DevIndex == nf.devIndex && f.Num == nf.num {
+			continue
+		}
+		v, _ := m.DevField(f.DevIndex, f.Num)
+		fields = append(fields, f)
+		values = append(values, v...)
+	}
+	return fields, values
+}
+
+// nameField returns the field holding the name of fitf, adding the message
+// it's in if it's missing, with the message and field in the profile's
+// snake_case form
+func nameField(fitf *fit.File) (*string, string, error) {
+	switch fitf.Type() {
+	case fit.FileTypeCourse:
+		course, err := fitf.Course()
+		if err != nil {
+			return nil, "", err
+		}
+		if course.Course == nil {
+			course.Course = fit.NewCourseMsg()
+		}
+		return &course.Course.Name, "course.name", nil
+	case fit.FileTypeWorkout:
+		workout, err := fitf.Workout()
+		if err != nil {
+			return nil, "", err
+		}
+		if workout.Workout == nil {
+			workout.Workout = fit.NewWorkoutMsg()
+		}
+		return &workout.Workout.WktName, "workout.wkt_name", nil
+	}
+
+	return nil, "", fmt.Errorf("%v files have no name field which can be written, only activity, course and workout files do", fitf.Type())
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE")
+	}
+
+	if *out == "" {
+		return cli.Usagef("-o is required")
+	}
+
+	if *name == "" && *note == "" {
+		return cli.Usagef("Nothing to edit, use -name or -note")
+	}
+	if len(*name) > maxString {
+		return cli.Usagef("-name can be at most %d bytes", maxString)
+	}
+	if len(*note) > maxString {
+		return cli.Usagef("-note can be at most %d bytes", maxString)
+	}
+
+	path := args[0]
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fitf, unknown, err := cli.DecodeForWrite(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	var wrote []string
+	if fitf.Type() == fit.FileTypeActivity {
+		activity, err := fitf.Activity()
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		var nf noteField
+		var added bool
+		unknown, nf, added, err = editActivity(activity, unknown, *name, *note)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+
+		where := "the sport message"
+		if added {
+			where = "a sport message added for it"
+		}
+		if *name != "" {
+			wrote = append(wrote, fmt.Sprintf("sport.name: %q, in %s", *name, where))
+		}
+		if *note != "" {
+			wrote = append(wrote, fmt.Sprintf("developer field notes (developer %d, field %d) of %s: %q",
+				nf.devIndex, nf.num, where, *note))
+		}
+	} else {
+		if *note != "" {
+			return fmt.Errorf("%s: %v files have no field for notes, only activities get one", path, fitf.Type())
+		}
+
+		field, fieldName, err := nameField(fitf)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		old := *field
+		*field = *name
+		wrote = append(wrote, fmt.Sprintf("%s: %q (was %q)", fieldName, *name, old))
+	}
+
+	if err := cli.WriteFIT(*out, fitf, unknown...); err != nil {
+		return err
+	}
+
+	for _, w := range wrote {
+		fmt.Printf("Wrote %s\n", w)
+	}
+
+	return nil
+}
+
+func main() {
+	cli.RegisterUnknown()
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
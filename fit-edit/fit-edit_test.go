@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// edit runs fit-edit on in, writing path
+func edit(t *testing.T, in, path, newName, newNote string) {
+	*name, *note, *out = newName, newNote, path
+	if err := run([]string{in}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// droppedMessages returns the messages of the file at path which the fit
+// package doesn't keep
+func droppedMessages(t *testing.T, path string) []*fitstream.RawMessage {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	sr, err := fitstream.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _, err := sr.Dropped()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestEditTwice(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.fit")
+	second := filepath.Join(dir, "second.fit")
+
+	edit(t, "../fitdump/testdata/DevFields.fit", first, "Sunrise loop", "Windy")
+	edit(t, first, second, "Sunset loop", "")
+
+	counts := make(map[fit.MesgNum]int)
+	var sport *fitstream.RawMessage
+	raw := droppedMessages(t, second)
+	for _, m := range raw {
+		counts[m.Num]++
+		if m.Num == fit.MesgNumSport {
+			sport = m
+		}
+	}
+	if counts[fit.MesgNumSport] != 1 {
+		t.Fatalf("got %d sport messages, want 1", counts[fit.MesgNumSport])
+	}
+	// The file's own developer, and the one for the note
+	if counts[fit.MesgNumDeveloperDataId] != 2 || counts[fit.MesgNumFieldDescription] != 2 {
+		t.Errorf("got %d developer data IDs and %d field descriptions, want 2 of each",
+			counts[fit.MesgNumDeveloperDataId], counts[fit.MesgNumFieldDescription])
+	}
+
+	if v, _ := sport.Field(sportName); cString(v) != "Sunset loop" {
+		t.Errorf("name %q, want \"Sunset loop\"", cString(v))
+	}
+	nf, ok := findNoteField(raw)
+	if !ok {
+		t.Fatal("no field description for the note")
+	}
+	if v, _ := sport.DevField(nf.devIndex, nf.num); cString(v) != "Windy" {
+		t.Errorf("note %q, want \"Windy\"", cString(v))
+	}
+
+	// The note's developer data index isn't the file's own
+	indexes := make(map[uint64]int)
+	for _, m := range raw {
+		if m.Num == fit.MesgNumDeveloperDataId {
+			index, _ := m.Uint(developerDataIdDevDataIndex)
+			indexes[index]++
+		}
+	}
+	if len(indexes) != 2 {
+		t.Errorf("developer data indexes %v, want two different ones", indexes)
+	}
+}
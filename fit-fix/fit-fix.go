@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-fix repairs broken data in an activity file which breaks other
+// tools, logging each change it makes. With -fix-monotonic, records whose
+// timestamp goes backwards are dropped or re-timed, and distances which go
+// backwards are clamped to the one before. If any records are changed, the
+// totals of the laps, sessions and activity are recomputed from them,
+// keeping the rest of their fields. Their times are only recomputed if
+// records were dropped or re-timed.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var fixMonotonic = flag.Bool("fix-monotonic", false, "Fix records whose timestamp or distance is less than the record's before it")
+var backwards = flag.String("backwards", "drop", "With -fix-monotonic, what to do with records whose timestamp goes backwards: drop, or retime to between their neighbours")
+var out = flag.String("o", "", "Path to write the fixed file to, gzipped if it ends in .gz")
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE")
+	}
+
+	if *out == "" {
+		return cli.Usagef("-o is required")
+	}
+
+	if !*fixMonotonic {
+		return cli.Usagef("Nothing to fix, use -fix-monotonic")
+	}
+
+	var fix fitbuild.BackwardsFix
+	switch *backwards {
+	case "drop":
+		fix = fitbuild.BackwardsDrop
+	case "retime":
+		fix = fitbuild.BackwardsRetime
+	default:
+		return cli.Usagef("-backwards must be 'drop' or 'retime'")
+	}
+
+	path := args[0]
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fitf, unknown, err := cli.DecodeForWrite(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	activity, err := fitf.Activity()
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	fixes := fitbuild.FixMonotonicActivity(activity, fix)
+	for _, f := range fixes {
+		fmt.Println(f)
+	}
+
+	if err := cli.WriteFIT(*out, fitf, unknown...); err != nil {
+		return err
+	}
+
+	fmt.Printf("Made %d fixes\n", len(fixes))
+
+	return nil
+}
+
+func main() {
+	cli.RegisterUnknown()
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-heatmap bins the record positions of many activities into a grid, and
+// counts how many activities visited each cell, for drawing a personal heat
+// map without uploading the activities anywhere. The files are read one at
+// a time, a record at a time, so only the counts are kept in memory.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitstream"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var cellSize = flag.Float64("cell", 10, "Size of the grid cells in metres")
+var format = flag.String("format", "geojson", "Output format: geojson, for a point at the centre of each cell, or csv of lat,lon,count")
+var bboxFlag = flag.String("bbox", "", "Only count positions inside this area, given as min_lng,min_lat,max_lng,max_lat in degrees")
+var out = flag.String("o", "", "Path to write the heat map to, instead of stdout")
+var reportPath = flag.String("report", "", "Write the outcome for each FILE to this path as a JSON array, updated as each one finishes")
+
+// addFile adds the positions of the records in the file at path to h. It
+// returns the number of messages it read.
+func addFile(h *heatmap, path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	sr, err := fitstream.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", path, err)
+	}
+
+	messages := 0
+	for {
+		msg, err := sr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			h.discardActivity()
+			return messages, fmt.Errorf("%s: %v", path, err)
+		}
+		messages++
+
+		r, ok := msg.(*fit.RecordMsg)
+		if !ok || r.PositionLat.Invalid() || r.PositionLong.Invalid() {
+			continue
+		}
+		h.add(r.PositionLat.Degrees(), r.PositionLong.Degrees())
+	}
+
+	h.endActivity()
+	return messages, nil
+}
+
+type geoJSONFeature struct {
+	Type     string `json:"type"`
+	Geometry struct {
+		Type        string     `json:"type"`
+		Coordinates [2]float64 `json:"coordinates"`
+	} `json:"geometry"`
+	Properties struct {
+		Count int `json:"count"`
+	} `json:"properties"`
+}
+
+func writeGeoJSON(w io.Writer, h *heatmap) error {
+	features := []geoJSONFeature{}
+	for _, c := range h.cells() {
+		lat, lng := h.grid.centre(c)
+
+		var f geoJSONFeature
+		f.Type = "Feature"
+		f.Geometry.Type = "Point"
+		// GeoJSON has longitude first
+		f.Geometry.Coordinates = [2]float64{round7(lng), round7(lat)}
+		f.Properties.Count = h.counts[c]
+		features = append(features, f)
+	}
+
+	return json.NewEncoder(w).Encode(struct {
+		Type     string           `json:"type"`
+		Features []geoJSONFeature `json:"features"`
+	}{"FeatureCollection", features})
+}
+
+func writeCSV(w io.Writer, h *heatmap) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "lat,lon,count")
+	for _, c := range h.cells() {
+		lat, lng := h.grid.centre(c)
+		fmt.Fprintf(bw, "%.7f,%.7f,%d\n", lat, lng, h.counts[c])
+	}
+	return bw.Flush()
+}
+
+// round7 rounds to 7 decimal places, about a centimetre, so that the
+// output isn't full of meaningless digits
+func round7(deg float64) float64 {
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(deg, 'f', 7, 64), 64)
+	return v
+}
+
+func run(files []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(files) == 0 {
+		return cli.Usagef("Expected one or more arguments: FILE...")
+	}
+
+	if *cellSize < 1 {
+		return cli.Usagef("-cell must be at least 1 metre")
+	}
+
+	write := writeGeoJSON
+	switch *format {
+	case "geojson":
+	case "csv":
+		write = writeCSV
+	default:
+		return cli.Usagef("unknown format '%s'", *format)
+	}
+
+	var area *bbox
+	if *bboxFlag != "" {
+		var err error
+		if area, err = parseBBox(*bboxFlag); err != nil {
+			return cli.Usagef("-bbox: %v", err)
+		}
+	}
+
+	report, err := cli.NewReport(*reportPath)
+	if err != nil {
+		return err
+	}
+
+	h := newHeatmap(newGrid(*cellSize), area)
+	failed := 0
+	for _, path := range files {
+		messages, err := addFile(h, path)
+		if rerr := report.Add(path, messages, err); rerr != nil {
+			return rerr
+		}
+		if err != nil {
+			cli.Error(err)
+			failed++
+		}
+	}
+	cli.Debugf("%d cells from %d files", len(h.counts), len(files)-failed)
+
+	if *out == "" {
+		if err := write(os.Stdout, h); err != nil {
+			return err
+		}
+	} else {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if err := write(f, h); err != nil {
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed", failed, len(files))
+	}
+	return nil
+}
+
+func main() {
+
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
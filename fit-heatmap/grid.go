@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Metres per degree of latitude, near enough for sizing the cells
+const metresPerDegree = 111320
+
+// cell is a grid cell, by its row of latitude and its column of longitude
+// within that row
+type cell struct {
+	row, col int
+}
+
+// grid bins positions into cells of roughly equal size. Rows are a fixed
+// height in degrees of latitude. Each row's columns are as wide in degrees
+// as it takes to be the same width in metres at the row's centre, so cells
+// don't get narrower away from the equator.
+type grid struct {
+	step float64
+}
+
+func newGrid(size float64) grid {
+	return grid{step: size / metresPerDegree}
+}
+
+// colStep returns the width of the columns of row, in degrees of longitude
+func (g grid) colStep(row int) float64 {
+	centre := (float64(row) + 0.5) * g.step
+	// Near enough to the poles that one column will do
+	scale := math.Max(math.Cos(centre*math.Pi/180), g.step/360)
+	return math.Min(g.step/scale, 360)
+}
+
+func (g grid) cell(lat, lng float64) cell {
+	row := int(math.Floor(lat / g.step))
+	return cell{row, int(math.Floor(lng / g.colStep(row)))}
+}
+
+// centre returns the position of the middle of c, in degrees
+func (g grid) centre(c cell) (float64, float64) {
+	return (float64(c.row) + 0.5) * g.step, (float64(c.col) + 0.5) * g.colStep(c.row)
+}
+
+// bbox is an area to keep the positions inside of, in degrees
+type bbox struct {
+	minLng, minLat, maxLng, maxLat float64
+}
+
+// parseBBox parses "min_lng,min_lat,max_lng,max_lat", the order GeoJSON
+// uses
+func parseBBox(s string) (*bbox, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("expected min_lng,min_lat,max_lng,max_lat, got '%s'", s)
+	}
+
+	var v [4]float64
+	for i, p := range parts {
+		var err error
+		if v[i], err = strconv.ParseFloat(strings.TrimSpace(p), 64); err != nil {
+			return nil, fmt.Errorf("invalid number '%s'", p)
+		}
+	}
+
+	b := &bbox{v[0], v[1], v[2], v[3]}
+	if b.minLat < -90 || b.maxLat > 90 || b.minLat >= b.maxLat || b.minLng < -180 || b.maxLng > 180 || b.minLng >= b.maxLng {
+		return nil, fmt.Errorf("'%s' isn't a valid area", s)
+	}
+	return b, nil
+}
+
+func (b *bbox) contains(lat, lng float64) bool {
+	return b == nil || (lat >= b.minLat && lat <= b.maxLat && lng >= b.minLng && lng <= b.maxLng)
+}
+
+// heatmap counts the activities which visited each cell
+type heatmap struct {
+	grid   grid
+	area   *bbox
+	counts map[cell]int
+
+	// The cells visited by the current activity, so that each is only
+	// counted once per activity
+	visited map[cell]bool
+}
+
+func newHeatmap(g grid, area *bbox) *heatmap {
+	return &heatmap{
+		grid:    g,
+		area:    area,
+		counts:  make(map[cell]int),
+		visited: make(map[cell]bool),
+	}
+}
+
+func (h *heatmap) add(lat, lng float64) {
+	if h.area.contains(lat, lng) {
+		h.visited[h.grid.cell(lat, lng)] = true
+	}
+}
+
+// endActivity counts the cells the activity visited
+func (h *heatmap) endActivity() {
+	for c := range h.visited {
+		h.counts[c]++
+		delete(h.visited, c)
+	}
+}
+
+// discardActivity forgets the cells of an activity which failed part way
+func (h *heatmap) discardActivity() {
+	for c := range h.visited {
+		delete(h.visited, c)
+	}
+}
+
+// cells returns the cells with any visits, sorted so that the output is
+// stable
+func (h *heatmap) cells() []cell {
+	cells := make([]cell, 0, len(h.counts))
+	for c := range h.counts {
+		cells = append(cells, c)
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].row != cells[j].row {
+			return cells[i].row < cells[j].row
+		}
+		return cells[i].col < cells[j].col
+	})
+	return cells
+}
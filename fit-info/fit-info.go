@@ -0,0 +1,374 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-info prints the header and FileId message of each FIT file, without
+// decoding the rest of it. It uses its own minimal parser, so that it can
+// still say something useful about files which are too broken for
+// fit.Decode, including truncated ones.
+//
+// Given several files it carries on past failures, so it suits checking a
+// whole directory. -report records which files failed and why, and the exit
+// status is 0 if they were all fine, 1 if any failed and 2 for a usage
+// error.
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/dyncrc16"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var reportPath = flag.String("report", "", "Write the outcome for each FILE to this path as a JSON array, updated as each one finishes")
+
+const (
+	mesgNumFileId = 0
+
+	headerDefinition = 0x40
+	headerDevData    = 0x20
+	headerCompressed = 0x80
+)
+
+// FIT times are seconds since 1989-12-31 00:00:00 UTC
+var fitEpoch = time.Date(1989, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+type fieldDef struct {
+	num  byte
+	size byte
+	base byte
+}
+
+type definition struct {
+	globalNum uint16
+	order     binary.ByteOrder
+	fields    []fieldDef
+	devSize   int
+}
+
+type reader struct {
+	r *bufio.Reader
+	n int
+	// Messages, including definitions, started so far
+	messages int
+}
+
+func (r *reader) read(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	got, err := io.ReadFull(r.r, buf)
+	r.n += got
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, fmt.Errorf("truncated after %d bytes", r.n)
+	}
+	return buf, err
+}
+
+func printField(name string, format string, args ...interface{}) {
+	fmt.Printf("%s: ", name)
+	fmt.Printf(format, args...)
+	fmt.Println()
+}
+
+func readHeader(r *reader) (dataSize int, err error) {
+	// Read the header piece by piece, so that as much as possible is
+	// printed if it's truncated
+	hdr, err := r.read(1)
+	if err != nil {
+		return 0, err
+	}
+	size := int(hdr[0])
+	printField("HeaderSize", "%d", size)
+	if size != 12 && size != 14 {
+		return 0, fmt.Errorf("illegal header size %d", size)
+	}
+
+	b, err := r.read(1)
+	if err != nil {
+		return 0, err
+	}
+	hdr = append(hdr, b...)
+	printField("ProtocolVersion", "%v", fit.ProtocolVersion(b[0]))
+
+	if b, err = r.read(2); err != nil {
+		return 0, err
+	}
+	hdr = append(hdr, b...)
+	profile := binary.LittleEndian.Uint16(b)
+	printField("ProfileVersion", "%d.%02d", profile/100, profile%100)
+
+	if b, err = r.read(4); err != nil {
+		return 0, err
+	}
+	hdr = append(hdr, b...)
+	dataSize = int(binary.LittleEndian.Uint32(b))
+	printField("DataSize", "%d", dataSize)
+
+	if b, err = r.read(4); err != nil {
+		return 0, err
+	}
+	hdr = append(hdr, b...)
+	dataType := string(b)
+	printField("DataType", "%q", dataType)
+
+	if size == 14 {
+		if b, err = r.read(2); err != nil {
+			return 0, err
+		}
+		crc := binary.LittleEndian.Uint16(b)
+		computed := dyncrc16.Checksum(hdr)
+		switch {
+		case crc == 0:
+			printField("HeaderCRC", "not set")
+		case crc == computed:
+			printField("HeaderCRC", "0x%04x OK", crc)
+		default:
+			printField("HeaderCRC", "0x%04x MISMATCH (computed 0x%04x)", crc, computed)
+		}
+	}
+
+	if dataType != ".FIT" {
+		return 0, fmt.Errorf("data type is not '.FIT'")
+	}
+
+	return dataSize, nil
+}
+
+func readDefinition(r *reader, devData bool) (*definition, error) {
+	b, err := r.read(5)
+	if err != nil {
+		return nil, err
+	}
+
+	def := &definition{order: binary.LittleEndian}
+	if b[1] == 1 {
+		def.order = binary.BigEndian
+	}
+	def.globalNum = def.order.Uint16(b[2:4])
+
+	fields, err := r.read(int(b[4]) * 3)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(fields); i += 3 {
+		def.fields = append(def.fields, fieldDef{fields[i], fields[i+1], fields[i+2]})
+	}
+
+	if devData {
+		n, err := r.read(1)
+		if err != nil {
+			return nil, err
+		}
+		devFields, err := r.read(int(n[0]) * 3)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(devFields); i += 3 {
+			def.devSize += int(devFields[i+1])
+		}
+	}
+
+	return def, nil
+}
+
+// fileIdFieldInvalid reports whether value, as read from the file, is the
+// invalid value of the FileId field name. TimeCreated is checked as the raw
+// uint32 it's stored as.
+func fileIdFieldInvalid(name string, value interface{}) bool {
+	v := reflect.ValueOf(value)
+	if sf, ok := reflect.TypeOf(fit.FileIdMsg{}).FieldByName(name); ok && v.Type().ConvertibleTo(sf.Type) && sf.Type.Kind() != reflect.Struct {
+		v = v.Convert(sf.Type)
+	}
+	info, _ := fitdump.FieldInfo("FileId", name)
+	return info.IsInvalid(v)
+}
+
+// printFileIdField prints field f of the FileId message, read as v. Fields
+// holding the invalid value are left out.
+func printFileIdField(f fieldDef, order binary.ByteOrder, v []byte) {
+	value := func() uint64 {
+		switch len(v) {
+		case 1:
+			return uint64(v[0])
+		case 2:
+			return uint64(order.Uint16(v))
+		case 4:
+			return uint64(order.Uint32(v))
+		}
+		return 0
+	}
+
+	print := func(name, format string, raw, arg interface{}) {
+		if fileIdFieldInvalid(name, raw) {
+			cli.Debugf("FileId field %s is invalid", name)
+			return
+		}
+		printField(name, format, arg)
+	}
+
+	switch f.num {
+	case 0:
+		print("Type", "%v", uint8(value()), fit.FileType(value()))
+	case 1:
+		print("Manufacturer", "%v", uint16(value()), fit.Manufacturer(value()))
+	case 2:
+		print("Product", "%d", uint16(value()), value())
+	case 3:
+		print("SerialNumber", "%d", uint32(value()), value())
+	case 4:
+		t := fitEpoch.Add(time.Duration(value()) * time.Second)
+		print("TimeCreated", "%v", uint32(value()), t)
+	case 5:
+		print("Number", "%d", uint16(value()), value())
+	case 8:
+		for i, c := range v {
+			if c == 0 {
+				v = v[:i]
+				break
+			}
+		}
+		print("ProductName", "%q", string(v), string(v))
+	}
+}
+
+func readFileId(r *reader, dataSize int) error {
+	var defs [16]*definition
+
+	for r.n < dataSize {
+		b, err := r.read(1)
+		if err != nil {
+			return err
+		}
+		hdr := b[0]
+		r.messages++
+
+		var local byte
+		switch {
+		case hdr&headerCompressed != 0:
+			local = (hdr >> 5) & 0x3
+		case hdr&headerDefinition != 0:
+			def, err := readDefinition(r, hdr&headerDevData != 0)
+			if err != nil {
+				return err
+			}
+			defs[hdr&0xf] = def
+			continue
+		default:
+			local = hdr & 0xf
+		}
+
+		def := defs[local]
+		if def == nil {
+			return fmt.Errorf("data message for undefined local type %d at offset %d", local, r.n-1)
+		}
+
+		if def.globalNum != mesgNumFileId {
+			cli.Debugf("skipping message %d (local type %d) at offset %d", def.globalNum, local, r.n-1)
+			size := def.devSize
+			for _, f := range def.fields {
+				size += int(f.size)
+			}
+			if _, err := r.read(size); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Print each field as soon as it's read, so that a truncated
+		// file shows everything up to the truncation point
+		for _, f := range def.fields {
+			v, err := r.read(int(f.size))
+			if err != nil {
+				return err
+			}
+			printFileIdField(f, def.order, v)
+		}
+
+		return nil
+	}
+
+	return errors.New("no FileId message found")
+}
+
+// info prints the header and FileId of the file at path. It returns the
+// number of messages it read to get to the FileId.
+func info(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	r := &reader{r: bufio.NewReader(f)}
+
+	dataSize, err := readHeader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	// The data size is relative to the end of the header
+	err = readFileId(r, r.n+dataSize)
+	return r.messages, err
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) < 1 {
+		return cli.Usagef("Expected at least one argument: FILE...")
+	}
+
+	report, err := cli.NewReport(*reportPath)
+	if err != nil {
+		return err
+	}
+
+	// With several files, carry on past failures and only report at the
+	// end how many there were
+	failed := 0
+	for i, path := range args {
+		if len(args) > 1 {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("%s:\n", path)
+		}
+
+		messages, err := info(path)
+		if rerr := report.Add(path, messages, err); rerr != nil {
+			return rerr
+		}
+		if err != nil {
+			if len(args) == 1 {
+				return err
+			}
+			cli.Error(fmt.Errorf("%s: %v", path, err))
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed", failed, len(args))
+	}
+
+	return nil
+}
+
+func main() {
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-join copies one Record field from a secondary activity file into the
+// Records of a primary one, matching records by the nearest timestamp. It's
+// for sensors, like a Tempe, which end up recorded on a different device to
+// the ride.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var field = flag.String("field", "", "Record field to copy, e.g. Temperature")
+var from = flag.String("from", "", "File to copy the field from")
+var out = flag.String("o", "", "Path to write the joined file to, gzipped if it ends in .gz")
+var tolerance = flag.Duration("tolerance", 5*time.Second, "Furthest a sample can be from a record and still be used")
+
+// decodeActivity decodes the activity file at path. With forWrite, it's
+// decoded with cli.DecodeForWrite, returning the messages it can't write
+// back, to append.
+func decodeActivity(path string, forWrite bool) (*fit.File, *fit.ActivityFile, []*fitstream.RawMessage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer f.Close()
+
+	var fitf *fit.File
+	var unknown []*fitstream.RawMessage
+	if forWrite {
+		fitf, unknown, err = cli.DecodeForWrite(f)
+	} else {
+		fitf, err = cli.Decode(f)
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	activity, err := fitf.Activity()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return fitf, activity, unknown, nil
+}
+
+type sample struct {
+	t time.Time
+	v reflect.Value
+}
+
+// validSamples returns the records where field is valid, in time order
+func validSamples(records []*fit.RecordMsg, field string, info fitdump.Info) []sample {
+	var samples []sample
+	for _, r := range records {
+		v := reflect.ValueOf(r).Elem().FieldByName(field)
+		if info.IsInvalid(v) {
+			continue
+		}
+		samples = append(samples, sample{r.Timestamp, v})
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].t.Before(samples[j].t)
+	})
+
+	return samples
+}
+
+// nearest returns the sample closest in time to t, or false if there isn't
+// one within tolerance.
+func nearest(samples []sample, t time.Time, tolerance time.Duration) (sample, bool) {
+	i := sort.Search(len(samples), func(i int) bool {
+		return !samples[i].t.Before(t)
+	})
+
+	best, bestDiff := -1, tolerance
+	for _, j := range []int{i - 1, i} {
+		if j < 0 || j >= len(samples) {
+			continue
+		}
+		diff := samples[j].t.Sub(t)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff <= bestDiff {
+			best, bestDiff = j, diff
+		}
+	}
+
+	if best < 0 {
+		return sample{}, false
+	}
+	return samples[best], true
+}
+
+// join sets field in each record from the nearest sample, or to the invalid
+// value if there isn't one. It returns the number of records filled.
+func join(records []*fit.RecordMsg, samples []sample, field string, tolerance time.Duration) int {
+	invalid := reflect.ValueOf(fit.NewRecordMsg()).Elem().FieldByName(field)
+
+	filled := 0
+	for _, r := range records {
+		dst := reflect.ValueOf(r).Elem().FieldByName(field)
+		if s, ok := nearest(samples, r.Timestamp, tolerance); ok {
+			dst.Set(s.v)
+			filled++
+		} else {
+			dst.Set(invalid)
+		}
+	}
+
+	return filled
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE")
+	}
+
+	if *field == "" || *from == "" || *out == "" {
+		return cli.Usagef("-field, -from and -o are all required")
+	}
+
+	info, ok := fitdump.FieldInfo("Record", *field)
+	if !ok {
+		return cli.Usagef("unknown Record field '%s'", *field)
+	}
+
+	primary, activity, unknown, err := decodeActivity(args[0], true)
+	if err != nil {
+		return err
+	}
+
+	_, secondary, _, err := decodeActivity(*from, false)
+	if err != nil {
+		return err
+	}
+
+	samples := validSamples(secondary.Records, *field, info)
+	if len(samples) == 0 {
+		cli.Warnf("%s has no valid %s samples", *from, *field)
+	}
+
+	filled := join(activity.Records, samples, *field, *tolerance)
+
+	if err := cli.WriteFIT(*out, primary, unknown...); err != nil {
+		return err
+	}
+
+	fmt.Printf("Filled %s in %d of %d records from %s\n",
+		*field, filled, len(activity.Records), *from)
+
+	return nil
+}
+
+func main() {
+	cli.RegisterUnknown()
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-resample fills the gaps between the records of an activity file, so
+// that there's one at a fixed interval, for analysis tools which don't cope
+// with the irregular intervals of smart recording. The existing records are
+// kept. Positions, altitude, distance and speed are interpolated across
+// each gap, and the other fields, such as heart rate and cadence, are
+// carried forward. The laps and sessions are left as they are.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var interval = flag.Duration("interval", time.Second, "Interval between records, a whole number of seconds")
+var pauses = flag.String("pauses", "skip", "How to fill the time the timer was stopped: skip to leave it without records, or zero for records at a standstill")
+var out = flag.String("o", "", "Path to write the resampled file to, gzipped if it ends in .gz")
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE")
+	}
+
+	if *out == "" {
+		return cli.Usagef("-o is required")
+	}
+
+	if *interval < time.Second || *interval%time.Second != 0 {
+		return cli.Usagef("-interval must be a whole number of seconds")
+	}
+
+	opts := fitbuild.ResampleOptions{Interval: *interval}
+	switch *pauses {
+	case "skip":
+		opts.PauseFill = fitbuild.PauseSkip
+	case "zero":
+		opts.PauseFill = fitbuild.PauseZero
+	default:
+		return cli.Usagef("-pauses must be 'skip' or 'zero'")
+	}
+
+	path := args[0]
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fitf, unknown, err := cli.DecodeForWrite(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	activity, err := fitf.Activity()
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if len(activity.Records) == 0 {
+		return fmt.Errorf("%s: no records to resample", path)
+	}
+
+	opts.Pauses = fitdump.Pauses(activity.Events)
+	before := len(activity.Records)
+	activity.Records = fitbuild.Resample(activity.Records, opts)
+
+	if err := cli.WriteFIT(*out, fitf, unknown...); err != nil {
+		return err
+	}
+
+	fmt.Printf("Resampled %d records to %d, every %v\n", before, len(activity.Records), *interval)
+
+	return nil
+}
+
+func main() {
+	cli.RegisterUnknown()
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+// seriesFields are the record fields sent to the page, by their JSON names.
+// The enhanced fields are preferred where they're valid.
+var seriesFields = []struct {
+	name   string
+	fields []string
+}{
+	{"distance", []string{"Distance"}},
+	{"altitude", []string{"EnhancedAltitude", "Altitude"}},
+	{"heart_rate", []string{"HeartRate"}},
+	{"power", []string{"Power"}},
+	{"speed", []string{"EnhancedSpeed", "Speed"}},
+	{"cadence", []string{"Cadence"}},
+}
+
+// lap is a row of the laps table
+type lap struct {
+	Start     time.Time     `json:"start"`
+	TimerTime fitdump.Float `json:"timer_time_s"`
+	Distance  fitdump.Float `json:"distance_m"`
+	AvgHR     fitdump.Float `json:"avg_heart_rate"`
+	AvgPower  fitdump.Float `json:"avg_power"`
+	Trigger   string        `json:"trigger"`
+}
+
+// activity is what the page shows of a file. The series are in the same
+// order as Time, with null where a record has no value.
+type activity struct {
+	Name   string                     `json:"name"`
+	Sport  string                     `json:"sport"`
+	Time   []int64                    `json:"time"`
+	Lat    []fitdump.Float            `json:"lat"`
+	Lng    []fitdump.Float            `json:"lng"`
+	Series map[string][]fitdump.Float `json:"series"`
+	Laps   []lap                      `json:"laps"`
+}
+
+// fieldValue returns the first of the fields of msg, a message such as
+// fit.RecordMsg, which is valid, with its scale applied, or NaN if none are
+func fieldValue(msg reflect.Value, fields ...string) float64 {
+	for _, field := range fields {
+		info, ok := fitdump.FieldInfo(msg.Type().Name(), field)
+		v := msg.FieldByName(field)
+		if !ok || !v.IsValid() || info.IsInvalid(v) {
+			continue
+		}
+		if f, ok := info.Value(v); ok {
+			return f
+		}
+	}
+	return math.NaN()
+}
+
+// readActivity decodes the activity file at path into what the page shows
+func readActivity(path string) (*activity, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fitf, err := cli.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+	file, err := fitf.Activity()
+	if err != nil {
+		return nil, fmt.Errorf("only activity files can be shown: %v", err)
+	}
+
+	a := &activity{Series: make(map[string][]fitdump.Float)}
+	if len(file.Sessions) > 0 {
+		a.Sport = fitdump.SnakeCase(file.Sessions[0].Sport.String())
+	}
+
+	for _, r := range file.Records {
+		a.Time = append(a.Time, r.Timestamp.Unix())
+		a.Lat = append(a.Lat, fitdump.Float(r.PositionLat.Degrees()))
+		a.Lng = append(a.Lng, fitdump.Float(r.PositionLong.Degrees()))
+
+		msg := reflect.ValueOf(r).Elem()
+		for _, s := range seriesFields {
+			a.Series[s.name] = append(a.Series[s.name], fitdump.Float(fieldValue(msg, s.fields...)))
+		}
+	}
+
+	for _, l := range file.Laps {
+		msg := reflect.ValueOf(l).Elem()
+		trigger := fitdump.SnakeCase(l.LapTrigger.String())
+		if fitdump.IsInvalidEnum(l.LapTrigger) {
+			trigger = ""
+		}
+		a.Laps = append(a.Laps, lap{
+			Start:     l.StartTime,
+			TimerTime: fitdump.Float(fieldValue(msg, "TotalTimerTime")),
+			Distance:  fitdump.Float(fieldValue(msg, "TotalDistance")),
+			AvgHR:     fitdump.Float(fieldValue(msg, "AvgHeartRate")),
+			AvgPower:  fitdump.Float(fieldValue(msg, "AvgPower")),
+			Trigger:   trigger,
+		})
+	}
+
+	return a, nil
+}
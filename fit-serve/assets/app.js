@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// The page for fit-serve. It only talks to the server it came from.
+"use strict";
+
+const charts = [
+	{ series: "altitude", title: "Altitude", unit: "m", colour: "#6a6" },
+	{ series: "heart_rate", title: "Heart rate", unit: "bpm", colour: "#c33" },
+	{ series: "power", title: "Power", unit: "W", colour: "#36c" },
+];
+
+function isNum(v) {
+	return typeof v === "number" && isFinite(v);
+}
+
+function formatDuration(secs) {
+	if (!isNum(secs)) {
+		return "-";
+	}
+	secs = Math.round(secs);
+	const h = Math.floor(secs / 3600), m = Math.floor(secs / 60) % 60, s = secs % 60;
+	const pad = (n) => String(n).padStart(2, "0");
+	return h > 0 ? `${h}:${pad(m)}:${pad(s)}` : `${m}:${pad(s)}`;
+}
+
+function formatNum(v, digits, unit) {
+	return isNum(v) ? `${v.toFixed(digits)} ${unit}` : "-";
+}
+
+// drawTrack plots the positions, scaling longitude by the cosine of the
+// latitude so that the shape isn't stretched. There's no map underneath, so
+// that nothing is fetched from elsewhere.
+function drawTrack(canvas, lat, lng) {
+	const ctx = canvas.getContext("2d");
+	ctx.clearRect(0, 0, canvas.width, canvas.height);
+
+	const pts = [];
+	for (let i = 0; i < lat.length; i++) {
+		if (isNum(lat[i]) && isNum(lng[i])) {
+			pts.push([lng[i], lat[i]]);
+		}
+	}
+	if (pts.length === 0) {
+		ctx.fillText("No positions", 10, 20);
+		return;
+	}
+
+	const midLat = pts.reduce((sum, p) => sum + p[1], 0) / pts.length;
+	const xScale = Math.cos(midLat * Math.PI / 180);
+	const xs = pts.map((p) => p[0] * xScale), ys = pts.map((p) => p[1]);
+	const minX = Math.min(...xs), maxX = Math.max(...xs);
+	const minY = Math.min(...ys), maxY = Math.max(...ys);
+	const margin = 20;
+	const scale = Math.min((canvas.width - 2 * margin) / (maxX - minX || 1),
+		(canvas.height - 2 * margin) / (maxY - minY || 1));
+	const x = (i) => margin + (xs[i] - minX) * scale;
+	const y = (i) => canvas.height - margin - (ys[i] - minY) * scale;
+
+	ctx.strokeStyle = "#c60";
+	ctx.lineWidth = 2;
+	ctx.beginPath();
+	ctx.moveTo(x(0), y(0));
+	for (let i = 1; i < pts.length; i++) {
+		ctx.lineTo(x(i), y(i));
+	}
+	ctx.stroke();
+
+	ctx.fillStyle = "#080";
+	ctx.fillRect(x(0) - 4, y(0) - 4, 8, 8);
+	ctx.fillStyle = "#800";
+	ctx.fillRect(x(pts.length - 1) - 4, y(pts.length - 1) - 4, 8, 8);
+}
+
+// drawChart plots values against elapsed time, leaving gaps where they're
+// null
+function drawChart(canvas, time, values, chart) {
+	const ctx = canvas.getContext("2d");
+	ctx.clearRect(0, 0, canvas.width, canvas.height);
+
+	const valid = values.filter(isNum);
+	if (valid.length === 0 || time.length < 2) {
+		ctx.fillText(`No ${chart.title.toLowerCase()}`, 10, 20);
+		return false;
+	}
+
+	const min = Math.min(...valid), max = Math.max(...valid);
+	const t0 = time[0], span = (time[time.length - 1] - t0) || 1;
+	const left = 50, margin = 10;
+	const x = (i) => left + (time[i] - t0) / span * (canvas.width - left - margin);
+	const y = (v) => canvas.height - margin - (v - min) / ((max - min) || 1) * (canvas.height - 2 * margin);
+
+	ctx.fillStyle = "#666";
+	ctx.fillText(`${max.toFixed(0)} ${chart.unit}`, 2, margin + 5);
+	ctx.fillText(`${min.toFixed(0)} ${chart.unit}`, 2, canvas.height - margin);
+
+	ctx.strokeStyle = chart.colour;
+	ctx.beginPath();
+	let drawing = false;
+	for (let i = 0; i < values.length; i++) {
+		if (!isNum(values[i])) {
+			drawing = false;
+			continue;
+		}
+		if (drawing) {
+			ctx.lineTo(x(i), y(values[i]));
+		} else {
+			ctx.moveTo(x(i), y(values[i]));
+			drawing = true;
+		}
+	}
+	ctx.stroke();
+	return true;
+}
+
+function showLaps(tbody, laps) {
+	tbody.replaceChildren();
+	(laps || []).forEach((lap, i) => {
+		const row = tbody.insertRow();
+		[
+			String(i + 1),
+			new Date(lap.start).toLocaleTimeString(),
+			formatDuration(lap.timer_time_s),
+			isNum(lap.distance_m) ? formatNum(lap.distance_m / 1000, 2, "km") : "-",
+			formatNum(lap.avg_heart_rate, 0, "bpm"),
+			formatNum(lap.avg_power, 0, "W"),
+			lap.trigger || "-",
+		].forEach((text) => {
+			row.insertCell().textContent = text;
+		});
+	});
+}
+
+async function fetchJSON(url) {
+	const resp = await fetch(url);
+	if (!resp.ok) {
+		throw new Error(await resp.text());
+	}
+	return resp.json();
+}
+
+async function show(name) {
+	const summary = document.getElementById("summary");
+	summary.className = "";
+	summary.textContent = "Loading...";
+
+	let a;
+	try {
+		a = await fetchJSON("api/activity?file=" + encodeURIComponent(name));
+	} catch (err) {
+		summary.className = "error";
+		summary.textContent = err.message;
+		return;
+	}
+
+	const elapsed = a.time.length > 1 ? a.time[a.time.length - 1] - a.time[0] : NaN;
+	summary.textContent = `${a.sport || "activity"}, ${a.time.length} records, ${formatDuration(elapsed)}`;
+
+	drawTrack(document.getElementById("track"), a.lat, a.lng);
+
+	const section = document.getElementById("charts");
+	section.replaceChildren();
+	for (const chart of charts) {
+		const h = document.createElement("h2");
+		h.textContent = chart.title;
+		const canvas = document.createElement("canvas");
+		canvas.width = 600;
+		canvas.height = 150;
+		section.append(h, canvas);
+		drawChart(canvas, a.time, a.series[chart.series] || [], chart);
+	}
+
+	showLaps(document.querySelector("#laps tbody"), a.laps);
+}
+
+async function main() {
+	const select = document.getElementById("files");
+	const files = await fetchJSON("api/files");
+	for (const name of files) {
+		select.add(new Option(name, name));
+	}
+	select.addEventListener("change", () => show(select.value));
+	if (files.length > 0) {
+		show(files[0]);
+	}
+}
+
+main();
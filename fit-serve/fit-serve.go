@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-serve starts a local web server showing activity files: the track,
+// charts of the altitude, heart rate and power, and a table of the laps.
+// Everything it serves is built in, so it works offline, and it only
+// listens on localhost unless -addr says otherwise.
+//
+// Given a directory, it shows the FIT files in it, not descending into
+// subdirectories.
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var addr = flag.String("addr", "localhost:8080", "Address to listen on. Other hosts can only connect if it's not localhost")
+
+//go:embed assets
+var assets embed.FS
+
+// listFiles returns the FIT files to serve, by their base names, from path,
+// which is a file or a directory
+func listFiles(path string) (map[string]string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return map[string]string{filepath.Base(path): path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]string)
+	for _, e := range entries {
+		if !e.IsDir() && strings.EqualFold(filepath.Ext(e.Name()), ".fit") {
+			files[e.Name()] = filepath.Join(path, e.Name())
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s: no .fit files", path)
+	}
+	return files, nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		cli.Warnf("writing response: %v", err)
+	}
+}
+
+// newHandler returns the handler serving the assets and the files. Files
+// are only looked up by name in files, so nothing else can be read.
+func newHandler(files map[string]string) (http.Handler, error) {
+	static, err := fs.Sub(assets, "assets")
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mux := http.NewServeMux()
+	mux.Handle("/", http.FileServer(http.FS(static)))
+	mux.HandleFunc("/api/files", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, names)
+	})
+	mux.HandleFunc("/api/activity", func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("file")
+		path, ok := files[name]
+		if !ok {
+			http.Error(w, fmt.Sprintf("no file '%s'", name), http.StatusNotFound)
+			return
+		}
+
+		a, err := readActivity(path)
+		if err != nil {
+			cli.Warnf("%s: %v", path, err)
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		a.Name = name
+		writeJSON(w, a)
+	})
+
+	return mux, nil
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE or DIRECTORY")
+	}
+
+	files, err := listFiles(args[0])
+	if err != nil {
+		return err
+	}
+
+	handler, err := newHandler(files)
+	if err != nil {
+		return err
+	}
+
+	l, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Serving %d files on http://%s/\n", len(files), l.Addr())
+
+	return http.Serve(l, handler)
+}
+
+func main() {
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	// Embedded, so that -tz works on systems without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var aggregate = flag.String("aggregate", "", "Group the activity FILEs by the local day, ISO week or month they started in, and print the totals of each group: day, week or month")
+var tz = flag.String("tz", "", "IANA time zone for -aggregate to group files without a local time in, e.g. Europe/Berlin (default the system's)")
+
+// activityTotals are the totals of one activity file, for -aggregate
+type activityTotals struct {
+	path string
+	// start is in the activity's local time
+	start time.Time
+	// timer time in seconds, distance in metres, ascent in metres
+	duration, distance, ascent float64
+	sports                     []string
+	load                       load
+}
+
+// localZone returns the zone the activity was recorded in, from its local
+// timestamp, or def if it doesn't have one
+func localZone(activity *fit.ActivityFile, def *time.Location) *time.Location {
+	if activity.Activity != nil && !fit.IsBaseTime(activity.Activity.LocalTimestamp) {
+		return activity.Activity.LocalTimestamp.Location()
+	}
+	return def
+}
+
+// readTotals returns the totals of fitf, read from path, or nil if it isn't
+// an activity. Files without sessions have them computed from the records.
+func readTotals(path string, fitf *fit.File, loc *time.Location, maxHR, restHR int) (*activityTotals, error) {
+	if fitf.Type() != fit.FileTypeActivity {
+		cli.Warnf("%s: skipped, it's a %v file, not an activity", path, fitf.Type())
+		return nil, nil
+	}
+	activity, err := fitf.Activity()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	sessions := activity.Sessions
+	if len(sessions) == 0 {
+		if len(activity.Records) == 0 {
+			cli.Warnf("%s: skipped, it has no sessions or records", path)
+			return nil, nil
+		}
+		_, session, _ := fitbuild.Summarize(activity.Records, fitbuild.Options{})
+		sessions = []*fit.SessionMsg{session}
+	}
+
+	start := sessions[0].StartTime
+	if fit.IsBaseTime(start) && len(activity.Records) > 0 {
+		start = activity.Records[0].Timestamp
+	}
+	t := &activityTotals{
+		path:  path,
+		start: start.In(localZone(activity, loc)),
+	}
+	for _, s := range sessions {
+		if v := s.GetTotalTimerTimeScaled(); !math.IsNaN(v) {
+			t.duration += v
+		}
+		if v := s.GetTotalDistanceScaled(); !math.IsNaN(v) {
+			t.distance += v
+		}
+		if fitdump.FieldValid(s, "TotalAscent") {
+			t.ascent += float64(s.TotalAscent)
+		}
+		t.sports = append(t.sports, fitdump.SnakeCase(s.Sport.String()))
+	}
+
+	p, err := fileHRProfile(path, activity, maxHR, restHR)
+	if err != nil {
+		cli.Debugf("%s: no training load: %v", path, err)
+	} else {
+		t.load = trainingLoad(activity.Records, p)
+	}
+
+	return t, nil
+}
+
+// periodKey returns the name of the day, ISO week or month t is in, such
+// that they sort in time order
+func periodKey(t time.Time, period string) string {
+	switch period {
+	case "day":
+		return t.Format("2006-01-02")
+	case "week":
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week)
+	}
+	return t.Format("2006-01")
+}
+
+// periodTotals are the totals of the activities in one day, week or month
+type periodTotals struct {
+	Period     string         `json:"period"`
+	Activities int            `json:"activities"`
+	Duration   fitdump.Float  `json:"duration_s"`
+	Distance   fitdump.Float  `json:"distance_km"`
+	Ascent     fitdump.Float  `json:"ascent_m"`
+	TRIMP      fitdump.Float  `json:"trimp"`
+	Sports     map[string]int `json:"sports"`
+	Longest    string         `json:"longest"`
+	LongestDur fitdump.Float  `json:"longest_s"`
+}
+
+func (p *periodTotals) add(t *activityTotals) {
+	p.Activities++
+	p.Duration += fitdump.Float(t.duration)
+	p.Distance += fitdump.Float(t.distance / 1000)
+	p.Ascent += fitdump.Float(t.ascent)
+	p.TRIMP += fitdump.Float(t.load.trimp)
+	for _, sport := range t.sports {
+		p.Sports[sport]++
+	}
+	if fitdump.Float(t.duration) > p.LongestDur || p.Longest == "" {
+		p.Longest, p.LongestDur = t.path, fitdump.Float(t.duration)
+	}
+}
+
+// sportCounts formats the number of sessions of each sport, like
+// "cycling 3, running 1"
+func (p *periodTotals) sportCounts() string {
+	var sports []string
+	for sport := range p.Sports {
+		sports = append(sports, sport)
+	}
+	sort.Strings(sports)
+
+	var parts []string
+	for _, sport := range sports {
+		parts = append(parts, fmt.Sprintf("%s %d", sport, p.Sports[sport]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func writeAggregate(w io.Writer, format string, periods []*periodTotals) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(periods)
+	case "csv":
+		opts := cli.Options()
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"period", "activities", "duration_s", "distance_km", "ascent_m", "trimp", "sports", "longest", "longest_s"})
+		for _, p := range periods {
+			cw.Write([]string{
+				p.Period,
+				strconv.Itoa(p.Activities),
+				opts.FormatFloat(float64(p.Duration), 0, ""),
+				opts.FormatFloat(float64(p.Distance), 2, ""),
+				opts.FormatFloat(float64(p.Ascent), 0, ""),
+				opts.FormatFloat(float64(p.TRIMP), 1, ""),
+				p.sportCounts(),
+				p.Longest,
+				opts.FormatFloat(float64(p.LongestDur), 0, ""),
+			})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	return fmt.Errorf("unknown format '%s'", format)
+}
+
+// printAggregate groups the activity files by the day, week or month they
+// started in, in their local time, and prints the totals of each group.
+// Like the default summary, it carries on past files which fail, and prints
+// the totals of the rest. Files without heart rate load are left out of the
+// TRIMP, and counted in a warning.
+func printAggregate(paths []string, period, format string, loc *time.Location, maxHR, restHR int) error {
+	groups := make(map[string]*periodTotals)
+	noLoad := 0
+	failed := eachFile(paths, func(path string, fitf *fit.File) error {
+		t, err := readTotals(path, fitf, loc, maxHR, restHR)
+		if err != nil || t == nil {
+			return err
+		}
+		if !t.load.hasHR {
+			noLoad++
+		}
+
+		key := periodKey(t.start, period)
+		if groups[key] == nil {
+			groups[key] = &periodTotals{Period: key, Sports: make(map[string]int)}
+		}
+		groups[key].add(t)
+		return nil
+	})
+
+	periods := make([]*periodTotals, 0, len(groups))
+	for _, p := range groups {
+		periods = append(periods, p)
+	}
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].Period < periods[j].Period
+	})
+
+	if err := writeAggregate(os.Stdout, format, periods); err != nil {
+		return err
+	}
+
+	if noLoad > 0 {
+		cli.Warnf("%d activities have no heart rate load, and aren't included in the TRIMP", noLoad)
+	}
+	return failed
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// Record fields holding altitude. Devices with a barometer usually fill
+// both from it, but some put GPS altitude in one of them, so comparing the
+// two shows how much the source matters.
+var altitudeFields = []string{
+	"Altitude",
+	"EnhancedAltitude",
+}
+
+// altitudeSeries returns the valid values of an altitude field, in metres.
+// Records where it's invalid are left out.
+func altitudeSeries(records []*fit.RecordMsg, field string) []float64 {
+	info, ok := fitdump.FieldInfo("Record", field)
+	if !ok {
+		return nil
+	}
+
+	var series []float64
+	for _, r := range records {
+		v := reflect.ValueOf(r).Elem().FieldByName(field)
+		if info.IsInvalid(v) {
+			continue
+		}
+		if f, ok := info.Value(v); ok {
+			series = append(series, f)
+		}
+	}
+
+	return series
+}
+
+// smooth applies a centred moving average over window points. A window of 1
+// or less leaves the series unchanged.
+func smooth(series []float64, window int) []float64 {
+	if window <= 1 {
+		return series
+	}
+
+	prefix := make([]float64, len(series)+1)
+	for i, v := range series {
+		prefix[i+1] = prefix[i] + v
+	}
+
+	smoothed := make([]float64, len(series))
+	for i := range series {
+		lo := i - window/2
+		hi := lo + window
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > len(series) {
+			hi = len(series)
+		}
+		smoothed[i] = (prefix[hi] - prefix[lo]) / float64(hi-lo)
+	}
+
+	return smoothed
+}
+
+// ascentDescent totals the climbing and descending in an altitude series
+func ascentDescent(series []float64) (ascent, descent float64) {
+	for i := 1; i < len(series); i++ {
+		if d := series[i] - series[i-1]; d > 0 {
+			ascent += d
+		} else {
+			descent -= d
+		}
+	}
+
+	return ascent, descent
+}
+
+// A recomputed total more than this fraction, and this many metres, away
+// from the session's is flagged
+const (
+	ascentMismatchFraction = 0.1
+	ascentMismatchMetres   = 10
+)
+
+// sessionRecords returns the records within the session's time range
+func sessionRecords(records []*fit.RecordMsg, s *fit.SessionMsg) []*fit.RecordMsg {
+	var in []*fit.RecordMsg
+	for _, r := range records {
+		if !r.Timestamp.Before(s.StartTime) && !r.Timestamp.After(s.Timestamp) {
+			in = append(in, r)
+		}
+	}
+	return in
+}
+
+// compareTotal formats a recomputed total against the one stored in field
+// of the session
+func compareTotal(computed float64, session *fit.SessionMsg, field string) string {
+	if !fitdump.FieldValid(session, field) {
+		return fmt.Sprintf("%.1f m", computed)
+	}
+	stored := float64(reflect.ValueOf(session).Elem().FieldByName(field).Uint())
+
+	diff := computed - stored
+	s := fmt.Sprintf("%.1f m (%s m", computed, signed(diff, 1))
+	if stored > 0 {
+		s += fmt.Sprintf(", %s%%", signed(100*diff/stored, 1))
+	}
+	s += ")"
+
+	if math.Abs(diff) > ascentMismatchMetres && math.Abs(diff) > ascentMismatchFraction*stored {
+		s += " MISMATCH"
+	}
+	return s
+}
+
+func formatStored(session *fit.SessionMsg, field string) string {
+	if !fitdump.FieldValid(session, field) {
+		return "not recorded"
+	}
+	return fmt.Sprintf("%d m", reflect.ValueOf(session).Elem().FieldByName(field).Uint())
+}
+
+// printSessionAscent prints each session's stored ascent and descent next
+// to the totals recomputed from its records, to show up devices which
+// report elevation badly.
+func printSessionAscent(records []*fit.RecordMsg, sessions []*fit.SessionMsg, window int) {
+	for i, s := range sessions {
+		fmt.Printf("Session %d: stored ascent %s, descent %s\n", i+1,
+			formatStored(s, "TotalAscent"), formatStored(s, "TotalDescent"))
+
+		in := sessionRecords(records, s)
+		for _, field := range altitudeFields {
+			series := altitudeSeries(in, field)
+			if len(series) == 0 {
+				continue
+			}
+
+			ascent, descent := ascentDescent(smooth(series, window))
+			fmt.Printf("\t%s: ascent %s, descent %s\n", field,
+				compareTotal(ascent, s, "TotalAscent"), compareTotal(descent, s, "TotalDescent"))
+		}
+	}
+}
+
+func printAscent(activity *fit.ActivityFile, window int) {
+	found := false
+	for _, field := range altitudeFields {
+		series := altitudeSeries(activity.Records, field)
+		if len(series) == 0 {
+			continue
+		}
+		found = true
+
+		ascent, descent := ascentDescent(smooth(series, window))
+		fmt.Printf("%s: ascent %.1f m, descent %.1f m (%d points)\n",
+			field, ascent, descent, len(series))
+	}
+
+	if !found {
+		fmt.Println("No altitude data")
+		return
+	}
+
+	printSessionAscent(activity.Records, activity.Sessions, window)
+}
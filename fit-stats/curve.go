@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+type curvePoint struct {
+	Duration int           `json:"duration_s"`
+	Watts    fitdump.Float `json:"watts"`
+}
+
+func standardDurations(length int) []int {
+	var durations []int
+	for _, d := range fitbuild.CurveDurations {
+		if d > length {
+			break
+		}
+		durations = append(durations, d)
+	}
+
+	// Always finish with the whole activity
+	if length > 0 && (len(durations) == 0 || durations[len(durations)-1] != length) {
+		durations = append(durations, length)
+	}
+
+	return durations
+}
+
+func everySecond(length int) []int {
+	durations := make([]int, length)
+	for i := range durations {
+		durations[i] = i + 1
+	}
+	return durations
+}
+
+// parseGaps parses the -gaps flag
+func parseGaps(s string) (fitbuild.CurveGaps, bool) {
+	switch s {
+	case "zero":
+		return fitbuild.GapsZero, true
+	case "skip":
+		return fitbuild.GapsSkip, true
+	case "split":
+		return fitbuild.GapsSplit, true
+	}
+	return 0, false
+}
+
+// meanMaxPower returns the mean-maximal power of the records, over the
+// standard durations up to the longest stretch, or every second of it if
+// all is set. See MeanMax for the cost of all: a fraction of a second
+// even for a long ride.
+func meanMaxPower(records []*fit.RecordMsg, gaps fitbuild.CurveGaps, all bool) []curvePoint {
+	runs := fitbuild.CurveRuns(records, "Power", 0, gaps)
+	length := 0
+	for _, run := range runs {
+		if len(run) > length {
+			length = len(run)
+		}
+	}
+
+	durations := standardDurations(length)
+	if all {
+		durations = everySecond(length)
+	}
+
+	var curve []curvePoint
+	for _, p := range fitbuild.MeanMax(runs, durations) {
+		curve = append(curve, curvePoint{int(p.Duration / time.Second), fitdump.Float(p.Average)})
+	}
+	return curve
+}
+
+func writeCurve(w io.Writer, format string, curve []curvePoint) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(curve)
+	case "csv":
+		opts := cli.Options()
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"duration_s", "watts"})
+		for _, p := range curve {
+			cw.Write([]string{
+				strconv.Itoa(p.Duration),
+				opts.FormatFloat(float64(p.Watts), 1, ""),
+			})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	return fmt.Errorf("unknown format '%s'", format)
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+var cycles = flag.Bool("cycles", false, "Estimate the steps or strokes of each session from the cadence, and compare them with the total the device stored")
+
+// cycleUnit says what a sport's cadence counts. FIT running cadence is in
+// strides per minute, a stride being two steps, and total_cycles holds
+// strides too, but steps are what people count.
+type cycleUnit struct {
+	name string
+	// perCycle is how many of name there are in each cycle of the cadence
+	perCycle float64
+}
+
+func sportCycles(sport fit.Sport) cycleUnit {
+	switch sport {
+	case fit.SportRunning, fit.SportWalking, fit.SportHiking:
+		return cycleUnit{"steps", 2}
+	case fit.SportSwimming, fit.SportRowing, fit.SportStandUpPaddleboarding, fit.SportPaddling, fit.SportKayaking:
+		return cycleUnit{"strokes", 1}
+	case fit.SportCycling:
+		return cycleUnit{"pedal strokes", 1}
+	}
+	return cycleUnit{"cycles", 1}
+}
+
+// doubledRatio is how close to 2 the estimated strides must be to the
+// stored ones for the cadence to be taken as steps per minute
+const doubledRatio = 0.1
+
+// recordCadence returns the cadence of r in cycles per minute, with its
+// fractional part. Cadence256 is preferred, as it's the most precise.
+func recordCadence(r *fit.RecordMsg) (float64, bool) {
+	if v := r.GetCadence256Scaled(); !math.IsNaN(v) {
+		return v, true
+	}
+	if !fitdump.FieldValid(r, "Cadence") {
+		return 0, false
+	}
+	v := float64(r.Cadence)
+	if frac := r.GetFractionalCadenceScaled(); !math.IsNaN(frac) {
+		v += frac
+	}
+	return v, true
+}
+
+// cadenceCycles integrates the cadence over the time the timer was running,
+// with each record's cadence counting until the next. It returns false if
+// there's no cadence.
+func cadenceCycles(records []*fit.RecordMsg, pauses []fitdump.Pause) (float64, bool) {
+	var total float64
+	hasCadence := false
+	moving(records, pauses, func(a, b *fit.RecordMsg, dt time.Duration) {
+		cad, ok := recordCadence(a)
+		if !ok {
+			return
+		}
+		hasCadence = true
+		total += cad * dt.Minutes()
+	})
+	return total, hasCadence
+}
+
+// storedCycles returns the session's total cycles, with the fractional
+// part, or false if it wasn't stored
+func storedCycles(s *fit.SessionMsg) (float64, bool) {
+	if !fitdump.FieldValid(s, "TotalCycles") {
+		return 0, false
+	}
+	v := float64(s.TotalCycles)
+	if frac := s.GetTotalFractionalCyclesScaled(); !math.IsNaN(frac) {
+		v += frac
+	}
+	return v, true
+}
+
+// printCycles prints the steps or strokes of each session in fitf, read
+// from path, estimated from the cadence, next to the total stored in the
+// session. Running cadence which looks to be in steps per minute, twice
+// what's stored, is halved.
+func printCycles(path string, fitf *fit.File) error {
+	activity, err := fitf.Activity()
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	pauses := fitdump.Pauses(activity.Events)
+
+	fmt.Printf("%s:\n", path)
+	for i, s := range activity.Sessions {
+		unit := sportCycles(s.Sport)
+		fmt.Printf("\tSession %d (%s): ", i+1, fitdump.SnakeCase(s.Sport.String()))
+
+		estimate, ok := cadenceCycles(recordsBetween(activity.Records, s.StartTime, s.Timestamp), pauses)
+		if !ok {
+			fmt.Printf("no cadence\n")
+			continue
+		}
+
+		stored, hasStored := storedCycles(s)
+		doubled := unit.perCycle == 2 && hasStored && stored > 0 && math.Abs(estimate/stored-2) < 2*doubledRatio
+		if doubled {
+			estimate /= 2
+		}
+
+		fmt.Printf("%.0f %s from the cadence", estimate*unit.perCycle, unit.name)
+		if hasStored {
+			diff := (estimate - stored) * unit.perCycle
+			fmt.Printf(", stored %.0f (%s", stored*unit.perCycle, signed(diff, 0))
+			if stored > 0 {
+				fmt.Printf(", %s%%", signed(diff/(stored*unit.perCycle)*100, 1))
+			}
+			fmt.Printf(")")
+		} else {
+			fmt.Printf(", none stored")
+		}
+		fmt.Println()
+
+		if doubled {
+			fmt.Printf("\t\tThe cadence looks to be in steps per minute rather than strides, so it was halved\n")
+		}
+	}
+	if len(activity.Sessions) == 0 {
+		fmt.Printf("\tNo sessions\n")
+	}
+	fmt.Println("---")
+
+	return nil
+}
+
+func printCyclesFiles(paths []string) error {
+	return eachFile(paths, printCycles)
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+var energy = flag.Bool("energy", false, "Compare the energy of each activity: the work from the power, the calories the device reported and an estimate from the heart rate")
+var weight = flag.Float64("weight", 0, "Body weight in kg for the -energy heart rate estimate (default from the file's user profile)")
+var age = flag.Int("age", 0, "Age in years for the -energy heart rate estimate (default from the file's user profile)")
+var sex = flag.String("sex", "", "male or female, for the -energy heart rate estimate (default from the file's user profile)")
+
+// bodyProfile is what the Keytel heart rate to energy formula needs
+type bodyProfile struct {
+	weight float64
+	age    int
+	// "male" or "female", or "" if unknown
+	sex string
+}
+
+func (p bodyProfile) complete() bool {
+	return p.weight > 0 && p.age > 0 && p.sex != ""
+}
+
+// readBodyProfile fills in the parts of p which weren't given on the
+// command line from the user_profile of the file at path, if it has one
+func readBodyProfile(path string, p *bodyProfile) error {
+	return userProfiles(path, func(raw *fitstream.RawMessage) {
+		if v, ok := raw.Uint(userProfileWeight); ok && p.weight == 0 && userProfileValid(userProfileWeight, v) {
+			p.weight = float64(v) / 10
+		}
+		if v, ok := raw.Uint(userProfileAge); ok && p.age == 0 && userProfileValid(userProfileAge, v) {
+			p.age = int(v)
+		}
+		if v, ok := raw.Uint(userProfileGender); ok && p.sex == "" {
+			switch v {
+			case userProfileGenderFemale:
+				p.sex = "female"
+			case userProfileGenderMale:
+				p.sex = "male"
+			}
+		}
+	})
+}
+
+// keytel returns the energy expenditure in kcal/min at a heart rate, from
+// Keytel et al. (2005). It's only meant for moderate to hard exercise, so
+// is clamped at 0 for low heart rates.
+func keytel(hr float64, p bodyProfile) float64 {
+	// Keytel gives kJ/min
+	var perMin float64
+	if p.sex == "female" {
+		perMin = -20.4022 + 0.4472*hr - 0.1263*p.weight + 0.074*float64(p.age)
+	} else {
+		perMin = -55.0969 + 0.6309*hr + 0.1988*p.weight + 0.2017*float64(p.age)
+	}
+	if perMin < 0 {
+		return 0
+	}
+	return perMin / 4.184
+}
+
+// moving calls fn for each pair of consecutive records which the timer was
+// running between, in time order, skipping the pauses and any gaps longer
+// than a pause.
+func moving(records []*fit.RecordMsg, pauses []fitdump.Pause, fn func(a, b *fit.RecordMsg, dt time.Duration)) {
+	for i := 1; i < len(records); i++ {
+		a, b := records[i-1], records[i]
+		dt := b.Timestamp.Sub(a.Timestamp)
+		if dt <= 0 || dt > fitbuild.DefaultPauseGap {
+			continue
+		}
+
+		paused := false
+		for _, p := range pauses {
+			end := p.Start.Add(p.Duration)
+			if a.Timestamp.Before(end) && b.Timestamp.After(p.Start) {
+				paused = true
+				break
+			}
+		}
+		if !paused {
+			fn(a, b, dt)
+		}
+	}
+}
+
+// work returns the work done in kJ, integrating the power with the
+// trapezoidal rule. It returns false if there's no power.
+func work(records []*fit.RecordMsg, pauses []fitdump.Pause) (float64, bool) {
+	var joules float64
+	hasPower := false
+	moving(records, pauses, func(a, b *fit.RecordMsg, dt time.Duration) {
+		pa, okA := recordValue(a, "Power")
+		pb, okB := recordValue(b, "Power")
+		if !okA || !okB {
+			return
+		}
+		hasPower = true
+		joules += (pa + pb) / 2 * dt.Seconds()
+	})
+	return joules / 1000, hasPower
+}
+
+// hrEnergy returns the Keytel estimate of the energy used in kcal, with
+// each heart rate counting until the next record. It returns false if
+// there's no heart rate.
+func hrEnergy(records []*fit.RecordMsg, pauses []fitdump.Pause, p bodyProfile) (float64, bool) {
+	var kcal float64
+	hasHR := false
+	moving(records, pauses, func(a, b *fit.RecordMsg, dt time.Duration) {
+		if !fitdump.FieldValid(a, "HeartRate") {
+			return
+		}
+		hasHR = true
+		kcal += keytel(float64(a.HeartRate), p) * dt.Minutes()
+	})
+	return kcal, hasHR
+}
+
+// percentDiff formats how far v is from the device's figure
+func percentDiff(v, device float64) string {
+	return signed((v-device)/device*100, 1) + "%"
+}
+
+// printEnergy prints the energy figures of fitf, read from path
+func printEnergy(path string, fitf *fit.File, flags bodyProfile) error {
+	activity, err := fitf.Activity()
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	records := activity.Records
+	pauses := fitdump.Pauses(activity.Events)
+
+	fmt.Printf("%s:\n", path)
+
+	device, hasDevice := 0.0, false
+	for _, s := range activity.Sessions {
+		if fitdump.FieldValid(s, "TotalCalories") {
+			device += float64(s.TotalCalories)
+			hasDevice = true
+		}
+	}
+	if hasDevice {
+		fmt.Printf("\tDevice: %.0f kcal\n", device)
+	} else {
+		fmt.Printf("\tDevice: no calories\n")
+	}
+
+	// Muscles are about 24% efficient, which happens to cancel out the
+	// 4.184 kJ in a kcal
+	if kJ, ok := work(records, pauses); ok {
+		fmt.Printf("\tWork: %.0f kJ, about %.0f kcal", kJ, kJ)
+		if hasDevice && device > 0 {
+			fmt.Printf(" (%s)", percentDiff(kJ, device))
+		}
+		fmt.Println()
+	} else {
+		fmt.Printf("\tWork: no power\n")
+	}
+
+	p := flags
+	if !p.complete() {
+		if err := readBodyProfile(path, &p); err != nil {
+			return err
+		}
+	}
+	if !p.complete() {
+		fmt.Printf("\tHeart rate: no weight, age or sex in the file, use -weight, -age and -sex\n")
+	} else if kcal, ok := hrEnergy(records, pauses, p); ok {
+		fmt.Printf("\tHeart rate: %.0f kcal", kcal)
+		if hasDevice && device > 0 {
+			fmt.Printf(" (%s)", percentDiff(kcal, device))
+		}
+		fmt.Printf(", for %.1f kg, %d years, %s\n", p.weight, p.age, p.sex)
+	} else {
+		fmt.Printf("\tHeart rate: no HR\n")
+	}
+	fmt.Println("---")
+
+	return nil
+}
+
+// printEnergyFiles prints the energy of each of the files
+func printEnergyFiles(paths []string) error {
+	flags := bodyProfile{weight: *weight, age: *age, sex: *sex}
+	return eachFile(paths, func(path string, fitf *fit.File) error {
+		return printEnergy(path, fitf, flags)
+	})
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var env = flag.Bool("env", false, "Print the min, average and max temperature and respiration rate of each FILE, from the records. Temperatures follow -units")
+
+// printEnv prints the temperature and respiration rate of fitf, read from
+// path, and extras, saying which weren't recorded rather than printing zeros
+func printEnv(path string, fitf *fit.File, extras *fitdump.Extras) error {
+	records, err := fileRecords(fitf)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	opts := cli.Options()
+	var temp summary
+	unit := ""
+	for _, r := range records {
+		if !fitdump.FieldValid(r, "Temperature") {
+			continue
+		}
+		var v float64
+		v, unit = opts.ConvertTemperature(float64(r.Temperature))
+		temp.add(v)
+	}
+
+	// Respiration rate isn't in the fit package's profile, so it comes
+	// from the raw records, collected while decoding
+	if extras == nil {
+		return fmt.Errorf("%s: can't read the respiration rate", path)
+	}
+	var resp summary
+	for _, v := range extras.Physio.Respiration {
+		resp.add(v)
+	}
+
+	fmt.Printf("%s:\n", path)
+	for _, s := range []struct {
+		name, unit string
+		summary    summary
+	}{
+		{"Temperature", unit, temp},
+		{"RespirationRate", "breaths/min", resp},
+	} {
+		if s.summary.n == 0 {
+			fmt.Printf("\t%s: not recorded\n", s.name)
+			continue
+		}
+		fmt.Printf("\t%s: %s (%d samples)\n", s.name, s.summary.format(s.unit), s.summary.n)
+	}
+	fmt.Println("---")
+
+	return nil
+}
+
+func printEnvFiles(paths []string) error {
+	return eachDecoded(paths, true, printEnv)
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var sportFilter = flag.String("sport", "", "Only include activities with a session of this sport, e.g. cycling")
+var subSportFilter = flag.String("sub-sport", "", "Only include activities with a session of this sub-sport, e.g. indoor_cycling")
+var includeNonActivity = flag.Bool("include-non-activity", false, "Include files without sessions, such as courses, which -sport and -sub-sport would otherwise leave out")
+
+// enumMatches reports whether name is the enum's name, ignoring case and
+// underscores, so that both "indoor_cycling" and "IndoorCycling" match
+func enumMatches(name string, enum fmt.Stringer) bool {
+	norm := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+	}
+	return norm(name) == norm(enum.String())
+}
+
+func filtering() bool {
+	return *sportFilter != "" || *subSportFilter != ""
+}
+
+// wanted reports whether the file passes -sport and -sub-sport. An activity
+// passes if any of its sessions match, for multisport files.
+func wanted(fitf *fit.File) bool {
+	if !filtering() {
+		return true
+	}
+
+	var sessions []*fit.SessionMsg
+	if activity, err := fitf.Activity(); err == nil {
+		sessions = activity.Sessions
+	}
+	if len(sessions) == 0 {
+		return *includeNonActivity
+	}
+
+	for _, s := range sessions {
+		if *sportFilter != "" && !enumMatches(*sportFilter, s.Sport) {
+			continue
+		}
+		if *subSportFilter != "" && !enumMatches(*subSportFilter, s.SubSport) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// decodeFile decodes the file at path, returning a nil *fit.File if it
+// doesn't pass the filters. With withExtras, it also returns the extras
+// collected while decoding it, which are nil if they can't be read.
+func decodeFile(path string, withExtras bool) (*fit.File, *fitdump.Extras, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var fitf *fit.File
+	var extras *fitdump.Extras
+	if withExtras {
+		fitf, extras, err = cli.DecodeExtras(f)
+	} else {
+		fitf, err = cli.Decode(f)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	if !wanted(fitf) {
+		cli.Debugf("%s: skipped, it doesn't match -sport or -sub-sport", path)
+		return nil, nil, nil
+	}
+
+	return fitf, extras, nil
+}
+
+// validEnum reports whether name is one of the values of an enum, which has
+// at most 256 values
+func validEnum(name string, value func(i int) fmt.Stringer) bool {
+	for i := 0; i < 256; i++ {
+		if enumMatches(name, value(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkFilters() error {
+	if *sportFilter != "" && !validEnum(*sportFilter, func(i int) fmt.Stringer { return fit.Sport(i) }) {
+		return fmt.Errorf("unknown sport '%s'", *sportFilter)
+	}
+	if *subSportFilter != "" && !validEnum(*subSportFilter, func(i int) fmt.Stringer { return fit.SubSport(i) }) {
+		return fmt.Errorf("unknown sub-sport '%s'", *subSportFilter)
+	}
+	return nil
+}
@@ -0,0 +1,514 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-stats prints statistics computed from the records of an activity file
+//
+// The modes which take several files carry on past failures, so they suit a
+// whole archive. -report records which files failed and why, and the exit
+// status is 0 if they were all fine, 1 if any failed and 2 for a usage
+// error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var powerCurve = flag.Bool("power-curve", false, "Print the mean-maximal power curve")
+var allSeconds = flag.Bool("all-seconds", false, "Include every duration in the power curve, not just the standard ones")
+var gaps = flag.String("gaps", "zero", "How to treat seconds with no power data in the power curve: zero, skip to join the power either side, or split so that no average spans them")
+var ascent = flag.Bool("ascent", false, "Print the total ascent and descent from each altitude field, compared with the totals stored in each session")
+var smoothing = flag.Int("smooth", 5, "Number of records to average altitude over for -ascent (1 for none)")
+var intervals = flag.Bool("intervals", false, "Detect work/rest intervals from the power, or the speed if there's no power, and summarise the repeats")
+var threshold = flag.Float64("threshold", 1.1, "For -intervals, the fraction of the average power or speed counted as work. Lower finds more intervals")
+var trimp = flag.Bool("trimp", false, "Print the heart rate training load (Banister TRIMP and relative effort) of each session and lap. With several FILEs, also print their total")
+var maxHR = flag.Int("max-hr", 0, "Max heart rate for -trimp, in bpm (default from the file's user profile)")
+var restHR = flag.Int("rest-hr", 0, "Resting heart rate for -trimp, in bpm (default from the file's user profile)")
+var stream = flag.Bool("stream", false, "Decode the records one at a time, for files too big to hold in memory. Only for the default summary")
+var format = flag.String("format", "csv", "Output format for the power curve and -aggregate: csv or json")
+var reportPath = flag.String("report", "", "Write the outcome for each FILE to this path as a JSON array, updated as each one finishes. Only for the modes which take several FILEs")
+
+// Record fields summarised by default
+var summaryFields = []string{
+	"HeartRate",
+	"Cadence",
+	"Power",
+	"Speed",
+	"Altitude",
+}
+
+type summary struct {
+	min, max, sum float64
+	n             int
+}
+
+func (s *summary) add(v float64) {
+	if s.n == 0 || v < s.min {
+		s.min = v
+	}
+	if s.n == 0 || v > s.max {
+		s.max = v
+	}
+	s.sum += v
+	s.n++
+}
+
+func (s *summary) avg() float64 {
+	if s.n == 0 {
+		return math.NaN()
+	}
+	return s.sum / float64(s.n)
+}
+
+// format formats the min, average and max, followed by unit if it's not
+// "". Without any values, they're all the -missing text.
+func (s *summary) format(unit string) string {
+	min, max := s.min, s.max
+	if s.n == 0 {
+		min, max = math.NaN(), math.NaN()
+	}
+	opts := cli.Options()
+	return fmt.Sprintf("min %s, avg %s, max %s", opts.FormatFloat(min, 1, ""),
+		opts.FormatFloat(s.avg(), 1, ""), opts.FormatFloat(max, 1, unit))
+}
+
+// signed formats v with its sign and prec decimal places. Values which round
+// to zero are printed as +0 rather than -0.
+func signed(v float64, prec int) string {
+	scale := math.Pow(10, float64(prec))
+	return fmt.Sprintf("%+.*f", prec, math.Round(v*scale)/scale+0)
+}
+
+// recordValue returns the physical value of field of r, or false if it's
+// invalid
+func recordValue(r *fit.RecordMsg, field string) (float64, bool) {
+	info, ok := fitdump.FieldInfo("Record", field)
+	if !ok {
+		return 0, false
+	}
+	v := reflect.ValueOf(r).Elem().FieldByName(field)
+	if info.IsInvalid(v) {
+		return 0, false
+	}
+	return info.Value(v)
+}
+
+// recordSummary accumulates the summary of the records one at a time, so
+// that it works the same whether or not the whole file is in memory.
+type recordSummary struct {
+	start, end time.Time
+	n          int
+	fields     []summary
+	infos      []fitdump.Info
+}
+
+func newRecordSummary() *recordSummary {
+	rs := &recordSummary{
+		fields: make([]summary, len(summaryFields)),
+		infos:  make([]fitdump.Info, len(summaryFields)),
+	}
+	for i, field := range summaryFields {
+		rs.infos[i], _ = fitdump.FieldInfo("Record", field)
+	}
+	return rs
+}
+
+// add includes r in the summary. Fields where it's invalid are ignored.
+func (rs *recordSummary) add(r *fit.RecordMsg) {
+	if rs.n == 0 {
+		rs.start = r.Timestamp
+	}
+	rs.end = r.Timestamp
+	rs.n++
+
+	v := reflect.ValueOf(r).Elem()
+	for i, field := range summaryFields {
+		fv := v.FieldByName(field)
+		if rs.infos[i].IsInvalid(fv) {
+			continue
+		}
+		if f, ok := rs.infos[i].Value(fv); ok {
+			rs.fields[i].add(f)
+		}
+	}
+}
+
+func (rs *recordSummary) print() {
+	if rs.n == 0 {
+		fmt.Println("No records")
+		return
+	}
+
+	fmt.Printf("Start: %v\n", rs.start)
+	fmt.Printf("Elapsed: %v\n", rs.end.Sub(rs.start).Round(time.Second))
+	fmt.Printf("Records: %d\n", rs.n)
+
+	for i, field := range summaryFields {
+		s := rs.fields[i]
+		if s.n == 0 {
+			continue
+		}
+
+		fmt.Printf("%s: %s\n", field, s.format(rs.infos[i].Unit))
+	}
+}
+
+func printSummary(records []*fit.RecordMsg) {
+	rs := newRecordSummary()
+	for _, r := range records {
+		rs.add(r)
+	}
+	rs.print()
+}
+
+// streamSummary prints the summary of the records in r, without holding them
+// all in memory, and adds every message to extras.
+func streamSummary(r io.Reader, extras *fitdump.Extras) error {
+	sr, err := fitstream.NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	rs := newRecordSummary()
+	for {
+		msg, err := sr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		extras.Add(sr.Raw())
+		if rec, ok := msg.(*fit.RecordMsg); ok {
+			rs.add(rec)
+		}
+	}
+	rs.print()
+
+	return nil
+}
+
+// printPhysio prints the respiration rate and SpO2 of extras, which the fit
+// package doesn't decode, if there are any samples. It prints nothing if
+// extras is nil, as they couldn't be read.
+func printPhysio(extras *fitdump.Extras) {
+	if extras == nil {
+		return
+	}
+
+	p := extras.Physio
+	for _, field := range []struct {
+		name, unit string
+		samples    map[int64]float64
+	}{
+		{"RespirationRate", "breaths/min", p.Respiration},
+		{"SpO2", "%", p.Spo2},
+	} {
+		var s summary
+		for _, v := range field.samples {
+			s.add(v)
+		}
+		if s.n > 0 {
+			fmt.Printf("%s: %s\n", field.name, s.format(field.unit))
+		}
+	}
+}
+
+// printDevFields prints the numeric developer fields of the records in
+// extras, such as the air speed from an aero sensor, with their declared
+// units. It prints nothing if extras is nil, as they couldn't be read.
+func printDevFields(extras *fitdump.Extras) {
+	if extras == nil {
+		return
+	}
+
+	d := extras.DevFields
+	for _, field := range d.Fields {
+		var s summary
+		for _, v := range d.Samples[field] {
+			s.add(v)
+		}
+		fmt.Printf("%s: %s\n", field.Name, s.format(field.Units))
+	}
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if *maxHR < 0 || *maxHR > 255 || *restHR < 0 || *restHR > 255 {
+		return cli.Usagef("-max-hr and -rest-hr must be between 0 and 255")
+	}
+
+	if *weight < 0 || *age < 0 {
+		return cli.Usagef("-weight and -age can't be negative")
+	}
+	if *sex != "" && *sex != "male" && *sex != "female" {
+		return cli.Usagef("-sex must be 'male' or 'female'")
+	}
+
+	if err := checkFilters(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if *aggregate != "" {
+		if *aggregate != "day" && *aggregate != "week" && *aggregate != "month" {
+			return cli.Usagef("-aggregate must be 'day', 'week' or 'month'")
+		}
+		if *format != "csv" && *format != "json" {
+			return cli.Usagef("unknown format '%s'", *format)
+		}
+		loc := time.Local
+		if *tz != "" {
+			var err error
+			if loc, err = time.LoadLocation(*tz); err != nil {
+				return cli.Usagef("-tz: %v", err)
+			}
+		}
+		if len(args) < 1 {
+			return cli.Usagef("Expected at least one argument: FILE...")
+		}
+		return printAggregate(args, *aggregate, *format, loc, *maxHR, *restHR)
+	}
+
+	if *trimp {
+		if len(args) < 1 {
+			return cli.Usagef("Expected at least one argument: FILE...")
+		}
+		return printTrainingLoad(args, *maxHR, *restHR)
+	}
+
+	if *energy {
+		if len(args) < 1 {
+			return cli.Usagef("Expected at least one argument: FILE...")
+		}
+		return printEnergyFiles(args)
+	}
+
+	if *cycles {
+		if len(args) < 1 {
+			return cli.Usagef("Expected at least one argument: FILE...")
+		}
+		return printCyclesFiles(args)
+	}
+
+	if *env {
+		if len(args) < 1 {
+			return cli.Usagef("Expected at least one argument: FILE...")
+		}
+		return printEnvFiles(args)
+	}
+
+	if *pedal {
+		if len(args) < 1 {
+			return cli.Usagef("Expected at least one argument: FILE...")
+		}
+		return printPedalFiles(args)
+	}
+
+	if len(args) < 1 {
+		return cli.Usagef("Expected at least one argument: FILE...")
+	}
+
+	curveGaps, ok := parseGaps(*gaps)
+	if !ok {
+		return cli.Usagef("-gaps must be 'zero', 'skip' or 'split'")
+	}
+
+	if *threshold <= 0 {
+		return cli.Usagef("-threshold must be positive")
+	}
+
+	single := *powerCurve || *ascent || *intervals || *stream
+	if single && len(args) != 1 {
+		return cli.Usagef("Only the default summary, -aggregate, -trimp, -energy, -cycles, -env and -pedal take several FILEs")
+	}
+	if single && *reportPath != "" {
+		return cli.Usagef("-report only works with the modes which take several FILEs")
+	}
+
+	if *stream {
+		if *powerCurve || *ascent || *intervals {
+			return cli.Usagef("-stream only works with the default summary")
+		}
+		if filtering() {
+			return cli.Usagef("-sport and -sub-sport don't work with -stream")
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		extras := fitdump.NewExtras()
+		if err := streamSummary(f, extras); err != nil {
+			return err
+		}
+		printPhysio(extras)
+		printDevFields(extras)
+		return nil
+	}
+
+	if !single {
+		return summarizeFiles(args)
+	}
+
+	fitf, _, err := decodeFile(args[0], false)
+	if err != nil || fitf == nil {
+		return err
+	}
+
+	activity, err := fitf.Activity()
+	if err != nil {
+		return err
+	}
+
+	if *powerCurve {
+		return writeCurve(os.Stdout, *format, meanMaxPower(activity.Records, curveGaps, *allSeconds))
+	}
+
+	if *ascent {
+		printAscent(activity, *smoothing)
+		return nil
+	}
+
+	printIntervals(activity.Records, *threshold)
+	return nil
+}
+
+// fileRecords returns the records of an activity or course file. Other
+// files have none.
+func fileRecords(fitf *fit.File) ([]*fit.RecordMsg, error) {
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		activity, err := fitf.Activity()
+		if err != nil {
+			return nil, err
+		}
+		return activity.Records, nil
+	case fit.FileTypeCourse:
+		course, err := fitf.Course()
+		if err != nil {
+			return nil, err
+		}
+		return course.Records, nil
+	}
+
+	return nil, nil
+}
+
+// summarize prints the summary of fitf, read from path, and of the extras
+// collected while decoding it, after header
+func summarize(path string, fitf *fit.File, extras *fitdump.Extras, header string) error {
+	records, err := fileRecords(fitf)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	fmt.Print(header)
+	printSummary(records)
+	printPhysio(extras)
+	printDevFields(extras)
+	return nil
+}
+
+// summarizeFiles prints the summary of each file which passes the filters
+func summarizeFiles(paths []string) error {
+	printed := 0
+	return eachDecoded(paths, true, func(path string, fitf *fit.File, extras *fitdump.Extras) error {
+		header := ""
+		if len(paths) > 1 {
+			header = path + ":\n"
+			if printed > 0 {
+				header = "\n" + header
+			}
+		}
+		printed++
+
+		return summarize(path, fitf, extras, header)
+	})
+}
+
+// decodedMessages counts the messages in a decoded activity or course file,
+// including the FileId. Other files only count their FileId and
+// FileCreator.
+func decodedMessages(fitf *fit.File) int {
+	var body interface{}
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		body, _ = fitf.Activity()
+	case fit.FileTypeCourse:
+		body, _ = fitf.Course()
+	}
+	return cli.Messages(fitf, body)
+}
+
+// eachFile decodes each of paths and calls fn with the ones which pass the
+// filters, recording the outcome of each in the -report. With several files
+// it carries on past failures, so that it can search a whole archive, and
+// returns how many there were at the end.
+func eachFile(paths []string, fn func(path string, fitf *fit.File) error) error {
+	return eachDecoded(paths, false, func(path string, fitf *fit.File, _ *fitdump.Extras) error {
+		return fn(path, fitf)
+	})
+}
+
+// eachDecoded is eachFile, also passing fn the extras collected while
+// decoding each file if withExtras is set
+func eachDecoded(paths []string, withExtras bool, fn func(path string, fitf *fit.File, extras *fitdump.Extras) error) error {
+	report, err := cli.NewReport(*reportPath)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, path := range paths {
+		fitf, extras, err := decodeFile(path, withExtras)
+		messages := 0
+		if fitf != nil {
+			messages = decodedMessages(fitf)
+			err = fn(path, fitf, extras)
+		}
+
+		if rerr := report.Add(path, messages, err); rerr != nil {
+			return rerr
+		}
+		if err != nil {
+			if len(paths) == 1 {
+				return err
+			}
+			cli.Error(err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed", failed, len(paths))
+	}
+	return nil
+}
+
+func main() {
+	err := run(cli.ParseArgs())
+	if err != nil {
+		if *format == "json" {
+			cli.ErrorJSON(err)
+		} else {
+			cli.Error(err)
+		}
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
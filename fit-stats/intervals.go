@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+const (
+	// Seconds to average over before classifying, so that single noisy
+	// samples don't split an interval
+	intervalSmoothing = 10
+	// Dips shorter than this are bridged, and work shorter than this after
+	// bridging is ignored
+	minIntervalGap  = 10
+	minIntervalWork = 30
+	// Repeats are grouped if they're within these fractions of each other
+	groupDuration = 0.25
+	groupValue    = 0.1
+)
+
+// intervalSeries is the per-second stream intervals are detected from
+type intervalSeries struct {
+	name   string
+	values []float64
+	format func(v float64) string
+	round  float64
+}
+
+// intervalStream picks power if the records have any, otherwise speed, and
+// resamples it to one value per second. Gaps in speed hold the last value.
+func intervalStream(records []*fit.RecordMsg) *intervalSeries {
+	// Seconds without power count as zero, such as when coasting
+	if power := perSecond(records, "Power"); power != nil {
+		for i, v := range power {
+			if math.IsNaN(v) {
+				power[i] = 0
+			}
+		}
+		return &intervalSeries{
+			name:   "power",
+			values: power,
+			format: func(v float64) string { return cli.Options().FormatFloat(v, 0, "W") },
+			round:  5,
+		}
+	}
+
+	speed := perSecond(records, "EnhancedSpeed")
+	if speed == nil {
+		speed = perSecond(records, "Speed")
+	}
+	if speed == nil {
+		return nil
+	}
+
+	last := 0.0
+	for i, v := range speed {
+		if math.IsNaN(v) {
+			speed[i] = last
+		}
+		last = speed[i]
+	}
+
+	pace := cli.Options()
+	pace.SpeedUnit = fitdump.SpeedMinutesPerKilometer
+	return &intervalSeries{
+		name:   "pace",
+		values: speed,
+		format: pace.FormatSpeed,
+	}
+}
+
+// perSecond resamples a record field to one value per second, with NaN for
+// the seconds where it isn't valid. It returns nil if it's never valid.
+func perSecond(records []*fit.RecordMsg, field string) []float64 {
+	info, ok := fitdump.FieldInfo("Record", field)
+	if !ok || len(records) == 0 {
+		return nil
+	}
+
+	start := records[0].Timestamp
+	length := int(records[len(records)-1].Timestamp.Sub(start).Seconds()) + 1
+	if length <= 0 {
+		return nil
+	}
+
+	series := make([]float64, length)
+	for i := range series {
+		series[i] = math.NaN()
+	}
+
+	found := false
+	for _, r := range records {
+		i := int(r.Timestamp.Sub(start).Seconds())
+		v := reflect.ValueOf(r).Elem().FieldByName(field)
+		if i < 0 || i >= length || info.IsInvalid(v) {
+			continue
+		}
+		if f, ok := info.Value(v); ok {
+			series[i] = f
+			found = true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return series
+}
+
+// segment is a half-open range of seconds
+type segment struct {
+	start, end int
+}
+
+func (s segment) length() int {
+	return s.end - s.start
+}
+
+// meanOver averages the valid values of series in seg
+func meanOver(series []float64, seg segment) float64 {
+	var s summary
+	for _, v := range series[seg.start:seg.end] {
+		if !math.IsNaN(v) {
+			s.add(v)
+		}
+	}
+	return s.avg()
+}
+
+// workSegments finds the runs where the smoothed series is at or above
+// threshold times its average.
+func workSegments(series []float64, threshold float64) []segment {
+	var avg summary
+	for _, v := range series {
+		if v > 0 {
+			avg.add(v)
+		}
+	}
+	if avg.n == 0 {
+		return nil
+	}
+	level := threshold * avg.avg()
+
+	var segs []segment
+	inWork := false
+	for i, v := range smooth(series, intervalSmoothing) {
+		if v >= level && !inWork {
+			segs = append(segs, segment{start: i})
+			inWork = true
+		} else if v < level && inWork {
+			segs[len(segs)-1].end = i
+			inWork = false
+		}
+	}
+	if inWork {
+		segs[len(segs)-1].end = len(series)
+	}
+
+	var bridged []segment
+	for _, s := range segs {
+		if n := len(bridged); n > 0 && s.start-bridged[n-1].end < minIntervalGap {
+			bridged[n-1].end = s.end
+			continue
+		}
+		bridged = append(bridged, s)
+	}
+
+	var work []segment
+	for _, s := range bridged {
+		if s.length() >= minIntervalWork {
+			work = append(work, s)
+		}
+	}
+
+	return work
+}
+
+type interval struct {
+	work, recovery segment
+	value, hr      float64
+	recoveryValue  float64
+}
+
+// intervalGroup is a run of similar consecutive intervals
+type intervalGroup struct {
+	intervals []interval
+}
+
+func (g *intervalGroup) similar(iv interval) bool {
+	first := g.intervals[0]
+	return math.Abs(float64(iv.work.length()-first.work.length())) <= groupDuration*float64(first.work.length()) &&
+		math.Abs(iv.value-first.value) <= groupValue*first.value
+}
+
+func groupIntervals(intervals []interval) []*intervalGroup {
+	var groups []*intervalGroup
+	for _, iv := range intervals {
+		if n := len(groups); n > 0 && groups[n-1].similar(iv) {
+			groups[n-1].intervals = append(groups[n-1].intervals, iv)
+			continue
+		}
+		groups = append(groups, &intervalGroup{[]interval{iv}})
+	}
+	return groups
+}
+
+// structured reports whether most of the intervals are repeats. Efforts
+// picked out of an unstructured ride are rarely alike.
+func structured(groups []*intervalGroup, n int) bool {
+	repeats := 0
+	for _, g := range groups {
+		if len(g.intervals) > 1 {
+			repeats += len(g.intervals)
+		}
+	}
+	return repeats*2 >= n
+}
+
+func roundTo(v, step float64) float64 {
+	if step == 0 {
+		return v
+	}
+	return math.Round(v/step) * step
+}
+
+func formatClock(secs int) string {
+	if secs >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+	}
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
+// describe summarises the group like "6 x ~3:00 @ ~310 W, ~2:00 recovery"
+func (g *intervalGroup) describe(stream *intervalSeries) string {
+	var dur, val, rec summary
+	for _, iv := range g.intervals {
+		dur.add(float64(iv.work.length()))
+		val.add(iv.value)
+		if iv.recovery.length() > 0 {
+			rec.add(float64(iv.recovery.length()))
+		}
+	}
+
+	approx := ""
+	if len(g.intervals) > 1 {
+		approx = "~"
+	}
+
+	desc := fmt.Sprintf("%d x %s%s @ %s%s", len(g.intervals),
+		approx, formatClock(int(roundTo(dur.avg(), 5))),
+		approx, stream.format(roundTo(val.avg(), stream.round)))
+	if rec.n > 0 {
+		desc += fmt.Sprintf(", %s%s recovery", approx, formatClock(int(roundTo(rec.avg(), 5))))
+	}
+
+	return desc
+}
+
+// printIntervals detects work intervals in the power, or failing that the
+// speed, and prints each one followed by a summary of the repeats.
+func printIntervals(records []*fit.RecordMsg, threshold float64) {
+	stream := intervalStream(records)
+	if stream == nil {
+		fmt.Println("No power or speed data")
+		return
+	}
+
+	work := workSegments(stream.values, threshold)
+	if len(work) < 2 {
+		fmt.Println("No intervals found")
+		return
+	}
+
+	hr := perSecond(records, "HeartRate")
+
+	intervals := make([]interval, len(work))
+	for i, w := range work {
+		iv := interval{
+			work:  w,
+			value: meanOver(stream.values, w),
+			hr:    math.NaN(),
+		}
+		if i < len(work)-1 {
+			iv.recovery = segment{w.end, work[i+1].start}
+			iv.recoveryValue = meanOver(stream.values, iv.recovery)
+		}
+		if hr != nil {
+			iv.hr = meanOver(hr, w)
+		}
+		intervals[i] = iv
+	}
+
+	groups := groupIntervals(intervals)
+	if !structured(groups, len(intervals)) {
+		fmt.Printf("No structured intervals found (%d efforts, too few of them alike)\n", len(intervals))
+		return
+	}
+
+	fmt.Printf("Intervals (%s):\n", stream.name)
+	for i, iv := range intervals {
+		line := fmt.Sprintf("\t%d: at %s, %s @ %s", i+1, formatClock(iv.work.start),
+			formatClock(iv.work.length()), stream.format(iv.value))
+		if !math.IsNaN(iv.hr) {
+			line += fmt.Sprintf(", HR %.0f bpm", iv.hr)
+		}
+		if iv.recovery.length() > 0 {
+			line += fmt.Sprintf(", recovery %s @ %s", formatClock(iv.recovery.length()),
+				stream.format(iv.recoveryValue))
+		}
+		fmt.Println(line)
+	}
+	fmt.Println("---")
+
+	for _, g := range groups {
+		fmt.Println(g.describe(stream))
+	}
+}
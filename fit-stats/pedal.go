@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+var pedal = flag.Bool("pedal", false, "Print the left/right power balance, torque effectiveness and pedal smoothness of each FILE, averaged over the records with power. These come from cycling power meters with pedal sensors")
+
+// pedalFields are the percentages averaged by -pedal, besides the balance
+var pedalFields = []string{
+	"LeftTorqueEffectiveness",
+	"RightTorqueEffectiveness",
+	"LeftPedalSmoothness",
+	"RightPedalSmoothness",
+	"CombinedPedalSmoothness",
+}
+
+// rightBalance returns the right leg's share of the power, in percent. The
+// balance is the share of one leg, which is the right if the top bit is
+// set, otherwise unknown.
+func rightBalance(b fit.LeftRightBalance) (percent float64, known bool) {
+	return float64(b & fit.LeftRightBalanceMask), b&fit.LeftRightBalanceRight != 0
+}
+
+// printPedal prints the pedal metrics of fitf, read from path, saying which
+// weren't recorded. Records without power, such as when coasting, are left
+// out, as the metrics don't mean anything there.
+func printPedal(path string, fitf *fit.File) error {
+	records, err := fileRecords(fitf)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	var right, unknown summary
+	fields := make([]summary, len(pedalFields))
+	for _, r := range records {
+		if power, ok := recordValue(r, "Power"); !ok || power == 0 {
+			continue
+		}
+
+		if r.LeftRightBalance != fit.LeftRightBalanceInvalid {
+			if percent, known := rightBalance(r.LeftRightBalance); known {
+				right.add(percent)
+			} else {
+				unknown.add(percent)
+			}
+		}
+
+		msg := reflect.ValueOf(r).Elem()
+		for i, name := range pedalFields {
+			info, _ := fitdump.FieldInfo("Record", name)
+			v := msg.FieldByName(name)
+			if info.IsInvalid(v) {
+				continue
+			}
+			if f, ok := info.Value(v); ok {
+				fields[i].add(f)
+			}
+		}
+	}
+
+	fmt.Printf("%s:\n", path)
+	switch {
+	case right.n > 0:
+		fmt.Printf("\tBalance: L %.1f%% / R %.1f%% (%d samples)\n", 100-right.avg(), right.avg(), right.n)
+	case unknown.n > 0:
+		// Single-sided meters double one leg, and may not say which
+		fmt.Printf("\tBalance: %.1f%% for one leg, not saying which (%d samples)\n", unknown.avg(), unknown.n)
+	default:
+		fmt.Printf("\tBalance: not recorded\n")
+	}
+	for i, name := range pedalFields {
+		if fields[i].n == 0 {
+			fmt.Printf("\t%s: not recorded\n", name)
+			continue
+		}
+		fmt.Printf("\t%s: %s (%d samples)\n", name, fields[i].format("%"), fields[i].n)
+	}
+	fmt.Println("---")
+
+	return nil
+}
+
+func printPedalFiles(paths []string) error {
+	return eachFile(paths, printPedal)
+}
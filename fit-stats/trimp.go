@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// The fit package doesn't keep user_profile messages in activity files, so
+// they're read from the raw messages.
+const (
+	userProfileGender              = 1  // enum
+	userProfileAge                 = 2  // uint8, years
+	userProfileWeight              = 4  // uint16, 1/10 kg
+	userProfileRestingHeartRate    = 8  // uint8, bpm
+	userProfileDefaultMaxRunningHR = 9  // uint8, bpm
+	userProfileDefaultMaxBikingHR  = 10 // uint8, bpm
+	userProfileDefaultMaxHeartRate = 11 // uint8, bpm
+	userProfileGenderFemale        = 0
+	userProfileGenderMale          = 1
+)
+
+// userProfileValid reports whether v, read from field num of a raw
+// user_profile, is set
+func userProfileValid(num byte, v uint64) bool {
+	name, _ := fitdump.FieldName("UserProfile", int(num))
+	info, _ := fitdump.FieldInfo("UserProfile", name)
+	return !info.IsInvalid(reflect.ValueOf(v))
+}
+
+// hrProfile is what's needed to turn heart rate into training load
+type hrProfile struct {
+	max, rest       uint8
+	maxSrc, restSrc string
+	female          bool
+}
+
+// userProfiles calls fn with each user_profile message in the FIT file at
+// path
+func userProfiles(path string, fn func(raw *fitstream.RawMessage)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sr, err := fitstream.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	for {
+		_, err := sr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+
+		if raw := sr.Raw(); raw.Num == fit.MesgNumUserProfile {
+			fn(raw)
+		}
+	}
+}
+
+// readHRProfile fills in the parts of p which weren't given on the command
+// line from the user_profile of the file at path, if it has one.
+func readHRProfile(path string, sport fit.Sport, p *hrProfile) error {
+	return userProfiles(path, func(raw *fitstream.RawMessage) {
+		if v, ok := raw.Uint(userProfileGender); ok && v == userProfileGenderFemale {
+			p.female = true
+		}
+		if v, ok := raw.Uint(userProfileRestingHeartRate); ok && p.rest == 0 && userProfileValid(userProfileRestingHeartRate, v) {
+			p.rest, p.restSrc = uint8(v), "the file"
+		}
+
+		maxFields := []byte{userProfileDefaultMaxHeartRate}
+		switch sport {
+		case fit.SportRunning:
+			maxFields = append([]byte{userProfileDefaultMaxRunningHR}, maxFields...)
+		case fit.SportCycling:
+			maxFields = append([]byte{userProfileDefaultMaxBikingHR}, maxFields...)
+		}
+		for _, field := range maxFields {
+			if v, ok := raw.Uint(field); ok && p.max == 0 && userProfileValid(field, v) {
+				p.max, p.maxSrc = uint8(v), "the file"
+			}
+		}
+	})
+}
+
+// load is the heart rate training load of some records
+type load struct {
+	// Banister's TRIMP
+	trimp float64
+	// Edwards' summed heart rate zones: minutes in each tenth of max HR
+	// from half of it, weighted 1 to 5
+	effort float64
+	// Whether any of the records had a heart rate
+	hasHR bool
+}
+
+func (l *load) add(o load) {
+	l.trimp += o.trimp
+	l.effort += o.effort
+	l.hasHR = l.hasHR || o.hasHR
+}
+
+func (l load) String() string {
+	if !l.hasHR {
+		return "no HR"
+	}
+	return fmt.Sprintf("TRIMP %.1f, relative effort %.0f", l.trimp, l.effort)
+}
+
+// trainingLoad computes the load of the records, which must be in time
+// order. Each heart rate counts until the next record, and gaps longer than
+// a pause count for nothing.
+func trainingLoad(records []*fit.RecordMsg, p hrProfile) load {
+	a, b := 0.64, 1.92
+	if p.female {
+		a, b = 0.86, 1.67
+	}
+
+	var l load
+	for i, r := range records {
+		if !fitdump.FieldValid(r, "HeartRate") {
+			continue
+		}
+		l.hasHR = true
+		if i+1 == len(records) {
+			break
+		}
+
+		dt := records[i+1].Timestamp.Sub(r.Timestamp)
+		if dt > fitbuild.DefaultPauseGap {
+			continue
+		}
+		minutes := dt.Minutes()
+
+		reserve := (float64(r.HeartRate) - float64(p.rest)) / (float64(p.max) - float64(p.rest))
+		reserve = math.Max(0, math.Min(1, reserve))
+		l.trimp += minutes * reserve * a * math.Exp(b*reserve)
+
+		if zone := math.Floor(float64(r.HeartRate)/float64(p.max)*10) - 4; zone >= 1 {
+			l.effort += minutes * math.Min(zone, 5)
+		}
+	}
+
+	return l
+}
+
+// triggerName returns the name of what triggered a lap or session, such as
+// Distance for an auto lap, or "none" if it's invalid
+func triggerName(trigger fmt.Stringer) string {
+	if fitdump.IsInvalidEnum(trigger) {
+		return "none"
+	}
+	return trigger.String()
+}
+
+// recordsBetween returns the records from start to end, inclusive
+func recordsBetween(records []*fit.RecordMsg, start, end time.Time) []*fit.RecordMsg {
+	var in []*fit.RecordMsg
+	for _, r := range records {
+		if !r.Timestamp.Before(start) && !r.Timestamp.After(end) {
+			in = append(in, r)
+		}
+	}
+	return in
+}
+
+// fileHRProfile returns the heart rate profile for the activity from the
+// file at path, using maxHR and restHR if they're not 0
+func fileHRProfile(path string, activity *fit.ActivityFile, maxHR, restHR int) (hrProfile, error) {
+	p := hrProfile{max: uint8(maxHR), rest: uint8(restHR), maxSrc: "-max-hr", restSrc: "-rest-hr"}
+	if maxHR == 0 || restHR == 0 {
+		sport := fit.SportInvalid
+		if len(activity.Sessions) > 0 {
+			sport = activity.Sessions[0].Sport
+		}
+		if err := readHRProfile(path, sport, &p); err != nil {
+			return p, err
+		}
+	}
+	if p.max == 0 {
+		return p, fmt.Errorf("%s: no max heart rate in the file, use -max-hr", path)
+	}
+	if p.rest == 0 {
+		return p, fmt.Errorf("%s: no resting heart rate in the file, use -rest-hr", path)
+	}
+	if p.rest >= p.max {
+		return p, fmt.Errorf("%s: resting heart rate %d isn't below max %d", path, p.rest, p.max)
+	}
+
+	return p, nil
+}
+
+// fileLoad prints the training load of each session and lap in fitf, read
+// from path, and returns the file's total
+func fileLoad(path string, fitf *fit.File, maxHR, restHR int) (load, error) {
+	activity, err := fitf.Activity()
+	if err != nil {
+		return load{}, fmt.Errorf("%s: %v", path, err)
+	}
+
+	p, err := fileHRProfile(path, activity, maxHR, restHR)
+	if err != nil {
+		return load{}, err
+	}
+
+	fmt.Printf("%s:\n", path)
+	fmt.Printf("\tMax HR: %d bpm (from %s), resting HR: %d bpm (from %s)\n", p.max, p.maxSrc, p.rest, p.restSrc)
+
+	var total load
+	for i, s := range activity.Sessions {
+		l := trainingLoad(recordsBetween(activity.Records, s.StartTime, s.Timestamp), p)
+		fmt.Printf("\tSession %d (%v): %v\n", i+1, triggerName(s.Trigger), l)
+		total.add(l)
+
+		for j, lap := range activity.Laps {
+			if lap.StartTime.Before(s.StartTime) || lap.StartTime.After(s.Timestamp) {
+				continue
+			}
+			fmt.Printf("\t\tLap %d (%v): %v\n", j+1, triggerName(lap.LapTrigger), trainingLoad(recordsBetween(activity.Records, lap.StartTime, lap.Timestamp), p))
+		}
+	}
+
+	// Files without sessions still have records worth counting
+	if len(activity.Sessions) == 0 {
+		total = trainingLoad(activity.Records, p)
+		fmt.Printf("\tRecords: %v\n", total)
+	}
+	fmt.Println("---")
+
+	return total, nil
+}
+
+// printTrainingLoad prints the training load of each file, and the sum over
+// all of them. Files without heart rate are left out of the sum, rather than
+// counting as zero, and the sum says how many there were, as are files which
+// fail.
+func printTrainingLoad(paths []string, maxHR, restHR int) error {
+	var total load
+	noHR, included := 0, 0
+	failed := eachFile(paths, func(path string, fitf *fit.File) error {
+		l, err := fileLoad(path, fitf, maxHR, restHR)
+		if err != nil {
+			return err
+		}
+		included++
+		if !l.hasHR {
+			noHR++
+			return nil
+		}
+		total.add(l)
+		return nil
+	})
+
+	if len(paths) < 2 {
+		return failed
+	}
+
+	fmt.Printf("Total (%d files): %v\n", included-noHR, total)
+	if noHR > 0 {
+		fmt.Printf("\t%d of %d files have no HR, and aren't included\n", noHR, included)
+	}
+
+	return failed
+}
@@ -0,0 +1,460 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-totals sums the sessions of an archive of activity files by sport, as
+// the lifetime totals a device keeps in its Totals file. With -verify, the
+// sums are compared against a Totals file, and with -rebuild a new Totals
+// file is written from them. Otherwise the sums are printed.
+//
+// Devices differ in which time they count for each sport, so -time selects
+// whether the sessions' timer time (without pauses) or elapsed time is
+// summed into the totals' timer time.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var verify = flag.String("verify", "", "Compare the sums against this Totals file, reporting the sports which differ")
+var rebuild = flag.String("rebuild", "", "Write a Totals file of the sums to this path, gzipped if it ends in .gz")
+var timeBasis = flag.String("time", "timer", "Session time summed into the timer time of each sport: timer or elapsed, optionally followed by overrides for some sports, e.g. timer,swimming=elapsed")
+var tolerance = flag.Float64("tolerance", 0.01, "Largest difference allowed by -verify, as a fraction of the Totals file's value, e.g. 0.01 for 1%")
+var reportPath = flag.String("report", "", "Write the outcome for each FILE to this path as a JSON array, updated as each one finishes")
+
+// sportTotals are the sums for one sport, in the Totals message's units
+type sportTotals struct {
+	sessions int
+	// timer, elapsed in seconds, distance in metres, calories in kcal
+	timer, elapsed, distance, calories float64
+	// last is the end of the last session
+	last time.Time
+}
+
+// basis is the session time summed into the totals' timer time
+type basis struct {
+	def    string
+	sports map[fit.Sport]string
+}
+
+func (b basis) of(sport fit.Sport) string {
+	if s, ok := b.sports[sport]; ok {
+		return s
+	}
+	return b.def
+}
+
+// parseSport parses a sport name such as "cycling" or "Cycling", ignoring
+// case and underscores
+func parseSport(name string) (fit.Sport, bool) {
+	norm := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+	}
+	for i := 0; i < 256; i++ {
+		if norm(fit.Sport(i).String()) == norm(name) {
+			return fit.Sport(i), true
+		}
+	}
+	return 0, false
+}
+
+func parseBasis(s string) (basis, error) {
+	b := basis{sports: make(map[fit.Sport]string)}
+	valid := func(v string) bool { return v == "timer" || v == "elapsed" }
+
+	for i, item := range strings.Split(s, ",") {
+		name, value, override := strings.Cut(item, "=")
+		if !override {
+			if i != 0 || !valid(item) {
+				return basis{}, fmt.Errorf("-time must start with timer or elapsed, then SPORT=timer or SPORT=elapsed for each override")
+			}
+			b.def = item
+			continue
+		}
+		sport, ok := parseSport(name)
+		if !ok {
+			return basis{}, fmt.Errorf("-time: unknown sport '%s'", name)
+		}
+		if !valid(value) {
+			return basis{}, fmt.Errorf("-time: %s must be timer or elapsed, not '%s'", name, value)
+		}
+		b.sports[sport] = value
+	}
+	if b.def == "" {
+		return basis{}, fmt.Errorf("-time must start with timer or elapsed")
+	}
+	return b, nil
+}
+
+// listFiles returns the paths of the FIT files in the arguments, searching
+// directories recursively
+func listFiles(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		err := filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if path == arg && !d.IsDir() || !d.IsDir() && strings.EqualFold(filepath.Ext(path), ".fit") {
+				paths = append(paths, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+func decodeFile(path string) (*fit.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fitf, err := cli.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return fitf, nil
+}
+
+// fieldValue returns the physical value of field of msg, a pointer to a
+// message such as *fit.SessionMsg, or false if it's invalid
+func fieldValue(msg interface{}, field string) (float64, bool) {
+	m := reflect.ValueOf(msg).Elem()
+	info, ok := fitdump.FieldInfo(m.Type().Name(), field)
+	if !ok {
+		return 0, false
+	}
+	v := m.FieldByName(field)
+	if info.IsInvalid(v) {
+		return 0, false
+	}
+	return info.Value(v)
+}
+
+// add adds the sessions of the activity file at path to totals. Other
+// files, such as the Totals file itself, are skipped. It returns the number
+// of messages decoded.
+func add(totals map[fit.Sport]*sportTotals, path string, b basis) (int, error) {
+	fitf, err := decodeFile(path)
+	if err != nil {
+		return 0, err
+	}
+	if fitf.Type() != fit.FileTypeActivity {
+		cli.Debugf("%s: skipped, it's a %v file, not an activity", path, fitf.Type())
+		return cli.Messages(fitf, nil), nil
+	}
+	activity, err := fitf.Activity()
+	if err != nil {
+		return cli.Messages(fitf, nil), fmt.Errorf("%s: %v", path, err)
+	}
+	messages := cli.Messages(fitf, activity)
+	if len(activity.Sessions) == 0 {
+		cli.Warnf("%s: skipped, it has no sessions", path)
+		return messages, nil
+	}
+
+	for _, s := range activity.Sessions {
+		t, ok := totals[s.Sport]
+		if !ok {
+			t = &sportTotals{}
+			totals[s.Sport] = t
+		}
+
+		t.sessions++
+		timer, elapsed := s.GetTotalTimerTimeScaled(), s.GetTotalElapsedTimeScaled()
+		if b.of(s.Sport) == "elapsed" {
+			timer = elapsed
+		}
+		if !math.IsNaN(timer) {
+			t.timer += timer
+		}
+		if !math.IsNaN(elapsed) {
+			t.elapsed += elapsed
+		}
+		if d := s.GetTotalDistanceScaled(); !math.IsNaN(d) {
+			t.distance += d
+		}
+		if kcal, ok := fieldValue(s, "TotalCalories"); ok {
+			t.calories += kcal
+		}
+		if s.Timestamp.After(t.last) {
+			t.last = s.Timestamp
+		}
+	}
+	return messages, nil
+}
+
+func sortedSports(totals map[fit.Sport]*sportTotals) []fit.Sport {
+	sports := make([]fit.Sport, 0, len(totals))
+	for sport := range totals {
+		sports = append(sports, sport)
+	}
+	sort.Slice(sports, func(i, j int) bool { return sports[i] < sports[j] })
+	return sports
+}
+
+func sessions(n int) string {
+	if n == 1 {
+		return "1 session"
+	}
+	return fmt.Sprintf("%d sessions", n)
+}
+
+func sportName(sport fit.Sport) string {
+	return strings.ToLower(sport.String())
+}
+
+func formatDuration(secs float64) string {
+	d := time.Duration(math.Round(secs)) * time.Second
+	return fmt.Sprintf("%d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+// quantity is one of the sums compared by -verify
+type quantity struct {
+	name            string
+	archive, totals float64
+	format          func(v float64) string
+}
+
+func (q quantity) differs() bool {
+	// Totals are whole numbers, so allow for the rounding too
+	return math.Abs(q.archive-q.totals) > math.Max(*tolerance*math.Abs(q.totals), 1)
+}
+
+func printSums(totals map[fit.Sport]*sportTotals) {
+	fmt.Println("Sums:")
+	for _, sport := range sortedSports(totals) {
+		t := totals[sport]
+		fmt.Printf("\t%s: %s, %.2f km, time %s, elapsed %s, %.0f kcal\n",
+			sportName(sport), sessions(t.sessions), t.distance/1000, formatDuration(t.timer), formatDuration(t.elapsed), t.calories)
+	}
+	fmt.Println("---")
+}
+
+// verifyTotals compares the sums against the Totals file at path, printing
+// each difference, and returns the number of sports which differ
+func verifyTotals(path string, totals map[fit.Sport]*sportTotals) (int, error) {
+	fitf, err := decodeFile(path)
+	if err != nil {
+		return 0, err
+	}
+	file, err := fitf.Totals()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", path, err)
+	}
+
+	// A device may keep more than one message for a sport
+	recorded := make(map[fit.Sport]*sportTotals)
+	for _, m := range file.Totals {
+		t, ok := recorded[m.Sport]
+		if !ok {
+			t = &sportTotals{}
+			recorded[m.Sport] = t
+		}
+		if v, ok := fieldValue(m, "Sessions"); ok {
+			t.sessions += int(v)
+		}
+		for _, f := range []struct {
+			field string
+			sum   *float64
+		}{
+			{"TimerTime", &t.timer},
+			{"ElapsedTime", &t.elapsed},
+			{"Distance", &t.distance},
+			{"Calories", &t.calories},
+		} {
+			if v, ok := fieldValue(m, f.field); ok {
+				*f.sum += v
+			}
+		}
+	}
+
+	all := make(map[fit.Sport]*sportTotals)
+	for sport := range totals {
+		all[sport] = nil
+	}
+	for sport := range recorded {
+		all[sport] = nil
+	}
+
+	km := func(v float64) string { return fmt.Sprintf("%.2f km", v/1000) }
+	kcal := func(v float64) string { return fmt.Sprintf("%.0f kcal", v) }
+	count := func(v float64) string { return fmt.Sprintf("%.0f", v) }
+
+	fmt.Printf("%s:\n", path)
+	bad := 0
+	for _, sport := range sortedSports(all) {
+		a, r := totals[sport], recorded[sport]
+		switch {
+		case r == nil:
+			fmt.Printf("\t%s: missing from the totals, the archive has %s\n", sportName(sport), sessions(a.sessions))
+			bad++
+			continue
+		case a == nil:
+			fmt.Printf("\t%s: not in the archive, the totals have %s\n", sportName(sport), sessions(r.sessions))
+			bad++
+			continue
+		}
+
+		quantities := []quantity{
+			{"sessions", float64(a.sessions), float64(r.sessions), count},
+			{"distance", a.distance, r.distance, km},
+			{"timer time", a.timer, r.timer, formatDuration},
+			{"elapsed time", a.elapsed, r.elapsed, formatDuration},
+			{"calories", a.calories, r.calories, kcal},
+		}
+		var diffs []string
+		for _, q := range quantities {
+			if q.differs() {
+				diffs = append(diffs, fmt.Sprintf("%s %s, archive %s", q.name, q.format(q.totals), q.format(q.archive)))
+			}
+		}
+		if len(diffs) == 0 {
+			fmt.Printf("\t%s: OK\n", sportName(sport))
+			continue
+		}
+		fmt.Printf("\t%s: %s\n", sportName(sport), strings.Join(diffs, "; "))
+		bad++
+	}
+	fmt.Println("---")
+
+	return bad, nil
+}
+
+// writeTotals writes a Totals file with a message for each sport of totals
+func writeTotals(path string, totals map[fit.Sport]*sportTotals) error {
+	fitf, err := fit.NewFile(fit.FileTypeTotals, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		return err
+	}
+	fitf.FileId.Manufacturer = fit.ManufacturerDevelopment
+
+	file, err := fitf.Totals()
+	if err != nil {
+		return err
+	}
+
+	var created time.Time
+	for i, sport := range sortedSports(totals) {
+		t := totals[sport]
+		m := fit.NewTotalsMsg()
+		m.MessageIndex = fit.MessageIndex(i)
+		m.Timestamp = t.last
+		m.Sport = sport
+		m.Sessions = uint16(t.sessions)
+		m.TimerTime = uint32(math.Round(t.timer))
+		m.ElapsedTime = uint32(math.Round(t.elapsed))
+		m.Distance = uint32(math.Round(t.distance))
+		m.Calories = uint32(math.Round(t.calories))
+		file.Totals = append(file.Totals, m)
+
+		if t.last.After(created) {
+			created = t.last
+		}
+	}
+	// The time of the last session, rather than now, so that rebuilding
+	// from the same archive gives the same file
+	fitf.FileId.TimeCreated = created
+
+	return cli.WriteFIT(path, fitf)
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) < 1 {
+		return cli.Usagef("Expected at least one argument: FILE or DIRECTORY")
+	}
+
+	b, err := parseBasis(*timeBasis)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	paths, err := listFiles(args)
+	if err != nil {
+		return err
+	}
+
+	report, err := cli.NewReport(*reportPath)
+	if err != nil {
+		return err
+	}
+
+	// With a report, decode every file even after a failure, so that it
+	// says which of them are bad
+	totals := make(map[fit.Sport]*sportTotals)
+	failed := 0
+	for _, path := range paths {
+		messages, err := add(totals, path, b)
+		if rerr := report.Add(path, messages, err); rerr != nil {
+			return rerr
+		}
+		if err != nil {
+			if report == nil {
+				return err
+			}
+			cli.Error(err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed, so nothing was summed", failed, len(paths))
+	}
+	if len(totals) == 0 {
+		return fmt.Errorf("no activity sessions found")
+	}
+
+	if *verify == "" && *rebuild == "" {
+		printSums(totals)
+		return nil
+	}
+
+	if *rebuild != "" {
+		if err := writeTotals(*rebuild, totals); err != nil {
+			return err
+		}
+	}
+
+	if *verify != "" {
+		bad, err := verifyTotals(*verify, totals)
+		if err != nil {
+			return err
+		}
+		if bad == 1 {
+			return fmt.Errorf("1 sport doesn't match the totals")
+		} else if bad > 0 {
+			return fmt.Errorf("%d sports don't match the totals", bad)
+		}
+	}
+
+	return nil
+}
+
+func main() {
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// fit-tz sets the local time of an activity file, for files from devices
+// which leave it out or set it to UTC, so that they show at the right time
+// of day. Activity.LocalTimestamp is the only local time field the fit
+// package keeps in activity files. The offset is the zone's at the start of
+// the activity, daylight saving included.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+	// Embedded, so that zones load on systems without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var zone = flag.String("zone", "", "IANA time zone the activity was recorded in, e.g. Europe/Berlin")
+var fromPosition = flag.Bool("from-position", false, "Look up the time zone from the first GPS position instead. The lookup is coarse, so check it near a border")
+var out = flag.String("o", "", "Path to write the corrected file to, gzipped if it ends in .gz")
+
+// startTime returns when the activity started
+func startTime(activity *fit.ActivityFile) time.Time {
+	if len(activity.Sessions) > 0 {
+		return activity.Sessions[0].StartTime
+	}
+	if len(activity.Records) > 0 {
+		return activity.Records[0].Timestamp
+	}
+	return activity.Activity.Timestamp
+}
+
+// firstPosition returns the first valid position of the activity, in degrees
+func firstPosition(activity *fit.ActivityFile) (float64, float64, bool) {
+	for _, r := range activity.Records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			return r.PositionLat.Degrees(), r.PositionLong.Degrees(), true
+		}
+	}
+	for _, s := range activity.Sessions {
+		if !s.StartPositionLat.Invalid() && !s.StartPositionLong.Invalid() {
+			return s.StartPositionLat.Degrees(), s.StartPositionLong.Degrees(), true
+		}
+	}
+	return 0, 0, false
+}
+
+// formatLocal formats a local time field, which the fit package decodes
+// into a zone holding its offset from the UTC timestamp
+func formatLocal(t time.Time) string {
+	if fit.IsBaseTime(t) {
+		return "not set"
+	}
+	return t.Format("2006-01-02 15:04:05 -07:00")
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE")
+	}
+
+	if *out == "" {
+		return cli.Usagef("-o is required")
+	}
+
+	if (*zone == "") == !*fromPosition {
+		return cli.Usagef("Exactly one of -zone and -from-position is required")
+	}
+
+	path := args[0]
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fitf, unknown, err := cli.DecodeForWrite(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	activity, err := fitf.Activity()
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if activity.Activity == nil {
+		return fmt.Errorf("%s: no activity message to set the local time of", path)
+	}
+
+	name := *zone
+	if *fromPosition {
+		lat, lng, ok := firstPosition(activity)
+		if !ok {
+			return fmt.Errorf("%s: no GPS position to find the time zone from, use -zone", path)
+		}
+		name = zoneAt(lat, lng)
+		cli.Debugf("position %.5f, %.5f is in %s", lat, lng, name)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return cli.Usagef("unknown time zone '%s'", name)
+	}
+
+	// Keep the offset from the start for the whole activity, even if
+	// daylight saving changes part way through, as the device would have
+	zoneName, offset := startTime(activity).In(loc).Zone()
+	local := time.FixedZone(zoneName, offset)
+
+	fmt.Printf("Time zone: %s (%s, UTC%s)\n", name, zoneName, time.Unix(0, 0).In(local).Format("-07:00"))
+	fmt.Printf("Activity LocalTimestamp: %s -> ", formatLocal(activity.Activity.LocalTimestamp))
+	activity.Activity.LocalTimestamp = activity.Activity.Timestamp.In(local)
+	fmt.Println(formatLocal(activity.Activity.LocalTimestamp))
+
+	if err := cli.WriteFIT(*out, fitf, unknown...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func main() {
+	cli.RegisterUnknown()
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
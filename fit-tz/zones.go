@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// zoneBox is a rough rectangle covering the populated part of a time zone
+type zoneBox struct {
+	zone                           string
+	minLat, maxLat, minLng, maxLng float64
+}
+
+// zoneBoxes is a coarse offline stand-in for the real time zone boundaries,
+// which would be megabytes of polygons. It's right for the places most
+// activities happen, but can be wrong near a border. The first box which
+// contains a position wins, so smaller zones come before the larger ones
+// they overlap.
+var zoneBoxes = []zoneBox{
+	// Europe
+	{"Europe/Lisbon", 36.9, 42.2, -9.6, -6.2},
+	{"Europe/Dublin", 51.4, 55.4, -10.6, -5.4},
+	{"Europe/London", 49.8, 60.9, -8.7, 1.8},
+	{"Atlantic/Reykjavik", 63.2, 66.6, -24.6, -13.4},
+	{"Europe/Helsinki", 59.7, 70.1, 20.5, 31.6},
+	{"Europe/Riga", 53.9, 59.7, 20.9, 28.2},
+	{"Europe/Athens", 34.8, 41.8, 19.3, 29.7},
+	{"Europe/Bucharest", 43.6, 48.3, 22.0, 29.7},
+	{"Europe/Kiev", 44.3, 52.4, 22.1, 40.2},
+	{"Europe/Istanbul", 35.8, 42.1, 26.0, 44.8},
+	{"Europe/Moscow", 41.2, 70.0, 24.2, 50.0},
+	{"Europe/Berlin", 35.0, 71.2, -9.3, 24.2},
+
+	// North America
+	{"Pacific/Honolulu", 18.9, 22.3, -160.3, -154.8},
+	{"America/Anchorage", 51.0, 71.5, -170.0, -130.0},
+	{"America/St_Johns", 46.6, 51.7, -59.5, -52.6},
+	{"America/Halifax", 43.3, 47.1, -66.5, -59.6},
+	{"America/Phoenix", 31.3, 37.0, -114.8, -109.0},
+	{"America/New_York", 24.5, 60.0, -86.5, -66.9},
+	{"America/Chicago", 25.8, 60.0, -101.5, -86.5},
+	{"America/Denver", 31.3, 60.0, -114.0, -101.5},
+	{"America/Los_Angeles", 32.5, 60.0, -125.0, -114.0},
+	{"America/Mexico_City", 14.5, 32.7, -117.2, -86.7},
+
+	// South America
+	{"America/Bogota", -4.2, 12.5, -79.0, -66.9},
+	{"America/Lima", -18.4, 0.0, -81.4, -68.7},
+	{"America/Santiago", -56.0, -17.5, -76.0, -69.5},
+	{"America/Argentina/Buenos_Aires", -55.1, -21.8, -69.5, -53.6},
+	{"America/Sao_Paulo", -34.0, 5.3, -53.6, -34.7},
+
+	// Africa and the Middle East
+	{"Africa/Casablanca", 27.6, 35.9, -13.2, -1.0},
+	{"Africa/Lagos", 4.2, 13.9, 2.7, 14.7},
+	{"Africa/Cairo", 22.0, 31.7, 24.7, 36.9},
+	{"Africa/Nairobi", -4.7, 5.0, 33.9, 41.9},
+	{"Africa/Johannesburg", -35.0, -22.0, 16.4, 33.0},
+	{"Asia/Jerusalem", 29.5, 33.3, 34.2, 35.9},
+	{"Asia/Dubai", 22.6, 26.1, 51.5, 56.4},
+
+	// Asia
+	{"Asia/Kolkata", 6.5, 30.0, 68.1, 97.4},
+	{"Asia/Singapore", 1.1, 1.5, 103.6, 104.1},
+	{"Asia/Bangkok", 5.6, 20.5, 97.3, 105.7},
+	{"Asia/Tokyo", 24.0, 45.6, 128.5, 146.0},
+	{"Asia/Seoul", 33.0, 38.7, 124.5, 131.0},
+	{"Asia/Shanghai", 18.0, 53.6, 73.5, 134.8},
+
+	// Oceania
+	{"Australia/Perth", -35.2, -13.7, 112.9, 129.0},
+	{"Australia/Darwin", -26.0, -10.9, 129.0, 138.0},
+	{"Australia/Adelaide", -38.1, -26.0, 129.0, 141.0},
+	{"Australia/Brisbane", -29.0, -10.0, 138.0, 153.6},
+	{"Australia/Hobart", -43.7, -39.5, 143.8, 148.5},
+	{"Australia/Melbourne", -39.2, -34.0, 140.9, 150.0},
+	{"Australia/Sydney", -37.6, -28.1, 141.0, 153.7},
+	{"Pacific/Auckland", -47.4, -34.3, 166.3, 178.6},
+}
+
+// zoneAt returns the name of the time zone at a position in degrees. Outside
+// all of the boxes it's the nautical zone for the longitude, which has the
+// right standard offset near enough but no daylight saving.
+func zoneAt(lat, lng float64) string {
+	for _, b := range zoneBoxes {
+		if lat >= b.minLat && lat <= b.maxLat && lng >= b.minLng && lng <= b.maxLng {
+			return b.zone
+		}
+	}
+
+	// The Etc zones' signs are the POSIX way round: Etc/GMT+5 is UTC-5
+	hours := int(math.Round(lng / 15))
+	if hours == 0 {
+		return "Etc/GMT"
+	}
+	return fmt.Sprintf("Etc/GMT%+d", -hours)
+}
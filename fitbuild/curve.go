@@ -0,0 +1,294 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"math"
+	"math/bits"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// CurveDurations are the usual durations of a power curve, in seconds
+var CurveDurations = []int{
+	1, 2, 3, 5, 10, 15, 20, 30, 45,
+	60, 90, 120, 180, 300, 480, 600, 900, 1200, 1800, 2700,
+	3600, 5400, 7200, 10800, 14400, 18000, 21600,
+}
+
+// CurvePoint is the best average of a field sustained for a duration
+type CurvePoint struct {
+	Duration time.Duration
+	Average  float64
+}
+
+// CurveGaps is how a curve treats gaps in the records: a record with the
+// field invalid, or a time between records longer than the pause gap
+type CurveGaps int
+
+const (
+	// GapsSplit starts the window again after each gap, so that no
+	// average spans one
+	GapsSplit CurveGaps = iota
+	// GapsZero counts each second of a gap as a zero
+	GapsZero
+	// GapsSkip leaves the seconds of a gap out, joining the stretches
+	// either side of it
+	GapsSkip
+)
+
+// curveRun is a stretch of a field without gaps, with one value per second
+// from start
+type curveRun struct {
+	start  time.Time
+	values []float64
+}
+
+// splitRuns splits the values of field into runs without gaps, each sample
+// held until the next
+func splitRuns(records []*fit.RecordMsg, field string, maxGap time.Duration) []curveRun {
+	info, ok := fitdump.FieldInfo("Record", field)
+	if !ok {
+		return nil
+	}
+
+	var runs []curveRun
+	var run curveRun
+	var last float64
+	var lastTime time.Time
+	end := func() {
+		if !lastTime.IsZero() {
+			run.values = append(run.values, last)
+			runs = append(runs, run)
+		}
+		run, lastTime = curveRun{}, time.Time{}
+	}
+
+	for _, r := range records {
+		fv := reflect.ValueOf(r).Elem().FieldByName(field)
+		v, ok := info.Value(fv)
+		if !ok || info.IsInvalid(fv) {
+			end()
+			continue
+		}
+
+		if lastTime.IsZero() {
+			run.start = r.Timestamp
+		} else {
+			dt := r.Timestamp.Sub(lastTime)
+			if dt < time.Second {
+				// Several samples in the same second: the first counts
+				continue
+			}
+			if dt > maxGap {
+				end()
+				run.start = r.Timestamp
+			} else {
+				for i := 0; i < int(dt/time.Second); i++ {
+					run.values = append(run.values, last)
+				}
+			}
+		}
+		last, lastTime = v, r.Timestamp
+	}
+	end()
+
+	return runs
+}
+
+// CurveRuns resamples field, such as "Power" or "HeartRate", to one value
+// per second, each sample held until the next, for MeanMax. The records
+// must be in time order. A gap, a record without the field or a time
+// between records longer than maxGap, or 0 for DefaultPauseGap, is treated
+// as gaps says: with GapsSplit the values are split into a run either side
+// of it, and otherwise there's a single run.
+func CurveRuns(records []*fit.RecordMsg, field string, maxGap time.Duration, gaps CurveGaps) [][]float64 {
+	if maxGap == 0 {
+		maxGap = DefaultPauseGap
+	}
+
+	runs := splitRuns(records, field, maxGap)
+	if len(runs) == 0 {
+		return nil
+	}
+
+	if gaps == GapsSplit {
+		values := make([][]float64, len(runs))
+		for i, run := range runs {
+			values[i] = run.values
+		}
+		return values
+	}
+
+	joined := runs[0].values
+	for i, run := range runs[1:] {
+		if gaps == GapsZero {
+			prev := runs[i]
+			end := prev.start.Add(time.Duration(len(prev.values)) * time.Second)
+			for n := int(run.start.Sub(end) / time.Second); n > 0; n-- {
+				joined = append(joined, 0)
+			}
+		}
+		joined = append(joined, run.values...)
+	}
+	return [][]float64{joined}
+}
+
+// prefixRun is a run as its prefix sums, for the total of any window in
+// constant time, with sparse tables of their minimum and maximum over each
+// power-of-two range, to bound the totals of a range of windows in constant
+// time too.
+type prefixRun struct {
+	// sums[i] is the total of the first i values
+	sums []float64
+	// min[k][i] and max[k][i] are over sums[i : i+1<<k]
+	min, max [][]float64
+}
+
+func newPrefixRun(run []float64) *prefixRun {
+	p := &prefixRun{sums: make([]float64, len(run)+1)}
+	for i, v := range run {
+		p.sums[i+1] = p.sums[i] + v
+	}
+
+	p.min, p.max = [][]float64{p.sums}, [][]float64{p.sums}
+	for k := 1; 1<<k <= len(p.sums); k++ {
+		prevMin, prevMax := p.min[k-1], p.max[k-1]
+		n := len(p.sums) - 1<<k + 1
+		min, max := make([]float64, n), make([]float64, n)
+		for i := range min {
+			j := i + 1<<(k-1)
+			min[i], max[i] = math.Min(prevMin[i], prevMin[j]), math.Max(prevMax[i], prevMax[j])
+		}
+		p.min, p.max = append(p.min, min), append(p.max, max)
+	}
+
+	return p
+}
+
+// sumRange returns the smallest and largest of sums[a : b+1]
+func (p *prefixRun) sumRange(a, b int) (float64, float64) {
+	k := bits.Len(uint(b-a+1)) - 1
+	j := b - 1<<k + 1
+	lo, hi := p.min[k][a], p.max[k][a]
+	if v := p.min[k][j]; v < lo {
+		lo = v
+	}
+	if v := p.max[k][j]; v > hi {
+		hi = v
+	}
+	return lo, hi
+}
+
+// bound returns the largest total any window of d values starting from a
+// to b could have: the largest sum where they end less the smallest where
+// they start
+func (p *prefixRun) bound(a, b, d int) float64 {
+	lo, _ := p.sumRange(a, b)
+	_, hi := p.sumRange(a+d, b+d)
+	return hi - lo
+}
+
+// search looks for a window of d values starting from a to b with a larger
+// total than best, given the range's bound. Ranges of starts are halved,
+// the one with the larger bound searched first, until either none of their
+// windows can beat best or they're short enough to try every start.
+func (p *prefixRun) search(a, b, d int, bound float64, best *float64, at *int) {
+	if bound <= *best {
+		return
+	}
+
+	if b-a < 32 {
+		for i := a; i <= b; i++ {
+			if sum := p.sums[i+d] - p.sums[i]; sum > *best {
+				*best, *at = sum, i
+			}
+		}
+		return
+	}
+
+	m := (a + b) / 2
+	left, right := p.bound(a, m, d), p.bound(m+1, b, d)
+	if left >= right {
+		p.search(a, m, d, left, best, at)
+		p.search(m+1, b, d, right, best, at)
+	} else {
+		p.search(m+1, b, d, right, best, at)
+		p.search(a, m, d, left, best, at)
+	}
+}
+
+// best returns the largest total of d values in the run, and where the
+// window starts, or false if the run is shorter than d. hint is a start to
+// try first, such as the best for a similar duration, so that more of the
+// windows can be skipped.
+func (p *prefixRun) best(d, hint int) (float64, int, bool) {
+	n := len(p.sums) - 1
+	if d <= 0 || d > n {
+		return 0, 0, false
+	}
+
+	if hint < 0 || hint > n-d {
+		hint = 0
+	}
+	best, at := p.sums[hint+d]-p.sums[hint], hint
+	p.search(0, n-d, d, p.bound(0, n-d, d), &best, &at)
+
+	return best, at, true
+}
+
+// MeanMax returns the best average of the runs, from CurveRuns, sustained
+// for each of the durations in seconds, or CurveDurations if there are none.
+// No average spans two runs, and durations longer than the longest run are
+// left out.
+//
+// Each run's prefix sums are built once, in O(n log n) with their sparse
+// tables, so each window's total takes constant time, and ranges of windows
+// which can't beat the best average so far are skipped without being looked
+// at. For real data the work for every duration up to n grows about as
+// n·√n rather than n², though data contrived to defeat the bounds can
+// still take n².
+func MeanMax(runs [][]float64, durations []int) []CurvePoint {
+	if durations == nil {
+		durations = CurveDurations
+	}
+
+	prefixes := make([]*prefixRun, len(runs))
+	hints := make([]int, len(runs))
+	for i, run := range runs {
+		prefixes[i] = newPrefixRun(run)
+	}
+
+	var curve []CurvePoint
+	for _, d := range durations {
+		best, found := 0.0, false
+		for i, p := range prefixes {
+			sum, at, ok := p.best(d, hints[i])
+			if !ok {
+				continue
+			}
+			hints[i] = at
+			if !found || sum > best {
+				best, found = sum, true
+			}
+		}
+		if found {
+			curve = append(curve, CurvePoint{time.Duration(d) * time.Second, best / float64(d)})
+		}
+	}
+
+	return curve
+}
+
+// Curve returns the best average of field, such as "Power" or "HeartRate",
+// sustained for each of the durations in seconds, or CurveDurations if there
+// are none. It's MeanMax of CurveRuns with GapsSplit: the averages never
+// span a gap in the records longer than maxGap, or 0 for DefaultPauseGap,
+// or a record without the field.
+func Curve(records []*fit.RecordMsg, field string, durations []int, maxGap time.Duration) []CurvePoint {
+	return MeanMax(CurveRuns(records, field, maxGap, GapsSplit), durations)
+}
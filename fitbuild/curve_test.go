@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func TestCurve(t *testing.T) {
+	// 200 W for a minute and 400 W for 10 s, then after a 30 s gap 350 W
+	// for 20 s, with a dropout half way
+	offsets := append(seconds(0, 69), seconds(100, 120)...)
+	records := makeRecords(offsets, func(i, t int, r *fit.RecordMsg) {
+		switch {
+		case t < 60:
+			r.Power = 200
+		case t < 70:
+			r.Power = 400
+		case t == 110:
+			r.Power = 0xffff
+		default:
+			r.Power = 350
+		}
+	})
+
+	got := Curve(records, "Power", []int{5, 10, 20, 60, 600}, 0)
+	want := []CurvePoint{
+		{5 * time.Second, 400},
+		{10 * time.Second, 400},
+		// Not 350, as the window doesn't span the dropout
+		{20 * time.Second, 300},
+		{60 * time.Second, (50*200 + 10*400) / 60.0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Duration != want[i].Duration || math.Abs(got[i].Average-want[i].Average) > 1e-9 {
+			t.Errorf("point %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCurveRunsGaps(t *testing.T) {
+	// 100 W for 10 s, a dropout, then 300 W for 4 s
+	records := makeRecords(seconds(0, 14), func(i, t int, r *fit.RecordMsg) {
+		switch {
+		case t < 10:
+			r.Power = 100
+		case t == 10:
+			r.Power = 0xffff
+		default:
+			r.Power = 300
+		}
+	})
+
+	for _, tc := range []struct {
+		gaps    CurveGaps
+		lengths []int
+		best5   float64
+	}{
+		{GapsSplit, []int{10, 4}, 100},
+		{GapsZero, []int{15}, 4 * 300 / 5.0},
+		{GapsSkip, []int{14}, (4*300 + 100) / 5.0},
+	} {
+		runs := CurveRuns(records, "Power", 0, tc.gaps)
+		if len(runs) != len(tc.lengths) {
+			t.Errorf("gaps %d: got %d runs, want %d", tc.gaps, len(runs), len(tc.lengths))
+			continue
+		}
+		for i, run := range runs {
+			if len(run) != tc.lengths[i] {
+				t.Errorf("gaps %d: run %d has %d values, want %d", tc.gaps, i, len(run), tc.lengths[i])
+			}
+		}
+
+		curve := MeanMax(runs, []int{5})
+		if len(curve) != 1 || math.Abs(curve[0].Average-tc.best5) > 1e-9 {
+			t.Errorf("gaps %d: got %v, want a 5 s best of %v", tc.gaps, curve, tc.best5)
+		}
+	}
+}
+
+// ridePower is a power series with surges, coasting and dropouts to zero,
+// like a real ride, from a fixed seed
+func ridePower(n int) []float64 {
+	rng := rand.New(rand.NewSource(1))
+	values := make([]float64, n)
+	level := 200.0
+	for i := range values {
+		if rng.Intn(60) == 0 {
+			level = 100 + rng.Float64()*300
+		}
+		values[i] = math.Max(0, level+rng.NormFloat64()*40)
+		if rng.Intn(200) == 0 {
+			values[i] = 0
+		}
+	}
+	return values
+}
+
+func TestMeanMaxMatchesSlidingWindow(t *testing.T) {
+	runs := [][]float64{ridePower(1500), ridePower(700)}
+	durations := make([]int, 1600)
+	for i := range durations {
+		durations[i] = i + 1
+	}
+
+	got := MeanMax(runs, durations)
+	if len(got) != 1500 {
+		t.Fatalf("got %d points, want 1500", len(got))
+	}
+	for _, p := range got {
+		d := int(p.Duration / time.Second)
+		best := math.Inf(-1)
+		for _, run := range runs {
+			for i := 0; i+d <= len(run); i++ {
+				sum := 0.0
+				for _, v := range run[i : i+d] {
+					sum += v
+				}
+				best = math.Max(best, sum/float64(d))
+			}
+		}
+		if math.Abs(p.Average-best) > 1e-6 {
+			t.Errorf("%d s: got %v, want %v", d, p.Average, best)
+		}
+	}
+}
+
+func BenchmarkMeanMaxAllSeconds(b *testing.B) {
+	// Two hours
+	runs := [][]float64{ridePower(7200)}
+	durations := make([]int, 7200)
+	for i := range durations {
+		durations[i] = i + 1
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		MeanMax(runs, durations)
+	}
+}
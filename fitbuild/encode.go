@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/dyncrc16"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+const (
+	headerDefinition = 0x40
+	headerCompressed = 0x80
+	headerLocalMask  = 0x0f
+	// Size of each field in a definition message: number, size and base
+	// type
+	fieldDefSize = 3
+
+	fieldNumTimestamp = 253
+	baseTypeUint32    = 0x86
+	// FIT timestamps count seconds from 1989-12-31 00:00:00 UTC
+	fitEpoch = 631065600
+)
+
+// Encode writes fitf as fit.Encode does, little-endian, but always gives
+// the same bytes for the same file. fit.Encode puts the fields of the
+// messages in slices, such as the records, in a random order each time, so
+// here the fields of every definition are sorted by number, and the data
+// messages which follow rearranged to match.
+//
+// The raw messages, such as those the fit package doesn't know, are
+// written after the others as they were in the file they came from, see
+// appendRaw.
+func Encode(w io.Writer, fitf *fit.File, raw ...*fitstream.RawMessage) error {
+	var buf bytes.Buffer
+	if err := fit.Encode(&buf, fitf, binary.LittleEndian); err != nil {
+		return err
+	}
+
+	data := buf.Bytes()
+	hdrSize := int(data[0])
+	if err := sortFields(data[hdrSize : len(data)-2]); err != nil {
+		return fmt.Errorf("encode failed: %v", err)
+	}
+
+	if len(raw) > 0 {
+		data = append(appendRaw(data[:len(data)-2], raw), 0, 0)
+		binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-2-hdrSize))
+		if hdrSize >= 14 {
+			binary.LittleEndian.PutUint16(data[12:14], dyncrc16.Checksum(data[:12]))
+		}
+	}
+
+	crc := dyncrc16.New()
+	crc.Write(data[:len(data)-2])
+	fitf.CRC = crc.Sum16()
+	binary.LittleEndian.PutUint16(data[len(data)-2:], fitf.CRC)
+
+	_, err := w.Write(data)
+	return err
+}
+
+// sortFields sorts the fields of each definition message in data, the
+// messages written by fit.Encode, in place. fit.Encode only uses local
+// message type 0, without developer fields or compressed timestamps, so
+// each data message uses the definition before it.
+func sortFields(data []byte) error {
+	var (
+		// The offset and size of each field in the data messages, in
+		// the order they're written, and in the sorted order
+		offsets, sizes []int
+		order          []int
+		size           int
+	)
+
+	scratch := make([]byte, 0, 255)
+	for pos := 0; pos < len(data); {
+		hdr := data[pos]
+		if hdr&headerDefinition == 0 {
+			if pos+1+size > len(data) {
+				return fmt.Errorf("data message at %d is truncated", pos)
+			}
+			msg := data[pos+1 : pos+1+size]
+			scratch = scratch[:0]
+			for _, i := range order {
+				scratch = append(scratch, msg[offsets[i]:offsets[i]+sizes[i]]...)
+			}
+			copy(msg, scratch)
+			pos += 1 + size
+			continue
+		}
+
+		// Header, reserved, architecture, global number, field count
+		if pos+6 > len(data) {
+			return fmt.Errorf("definition message at %d is truncated", pos)
+		}
+		n := int(data[pos+5])
+		if pos+6+n*fieldDefSize > len(data) {
+			return fmt.Errorf("definition message at %d is truncated", pos)
+		}
+		fields := data[pos+6 : pos+6+n*fieldDefSize]
+
+		offsets, sizes, order = offsets[:0], sizes[:0], order[:0]
+		size = 0
+		for i := 0; i < n; i++ {
+			offsets = append(offsets, size)
+			sizes = append(sizes, int(fields[i*fieldDefSize+1]))
+			order = append(order, i)
+			size += sizes[i]
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return fields[order[a]*fieldDefSize] < fields[order[b]*fieldDefSize]
+		})
+
+		sorted := make([]byte, 0, len(fields))
+		for _, i := range order {
+			sorted = append(sorted, fields[i*fieldDefSize:(i+1)*fieldDefSize]...)
+		}
+		copy(fields, sorted)
+
+		pos += 6 + n*fieldDefSize
+	}
+
+	return nil
+}
+
+// appendRaw appends the raw messages to data, each after its definition
+// unless it's the same as the one before's. The bytes of each are as they
+// were in the file, except that a message with a compressed timestamp
+// header gets a normal header and its timestamp as a field instead, as the
+// timestamp it followed on from isn't there any more.
+func appendRaw(data []byte, raw []*fitstream.RawMessage) []byte {
+	var last []byte
+	for _, m := range raw {
+		def, msg := m.Definition(), m.Bytes()
+		if msg[0]&headerCompressed != 0 {
+			def, msg = addTimestamp(m)
+		}
+		if !bytes.Equal(def, last) {
+			data = append(data, def...)
+			last = def
+		}
+		data = append(data, msg...)
+	}
+	return data
+}
+
+// addTimestamp returns the definition and data of a message with a
+// compressed timestamp header, changed to have a normal header and a
+// timestamp field after its other fields
+func addTimestamp(m *fitstream.RawMessage) (def, msg []byte) {
+	orig := m.Definition()
+	fixed := 6 + int(orig[5])*fieldDefSize
+	def = append(def, orig[:fixed]...)
+	def[5]++
+	def = append(def, fieldNumTimestamp, 4, baseTypeUint32)
+	def = append(def, orig[fixed:]...)
+
+	size := 0
+	for _, f := range m.Fields() {
+		size += int(f.Size)
+	}
+	b := m.Bytes()
+	var ts [4]byte
+	m.ByteOrder().PutUint32(ts[:], uint32(m.Timestamp.Unix()-fitEpoch))
+	msg = append(msg, def[0]&headerLocalMask)
+	msg = append(msg, b[1:1+size]...)
+	msg = append(msg, ts[:]...)
+	msg = append(msg, b[1+size:]...)
+	return def, msg
+}
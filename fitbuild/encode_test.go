@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// anonymize decodes the file at path, hides its devices and positions as
+// fit-dump -merge -redact -fuzz-position does, and encodes it again
+func anonymize(t *testing.T, path string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fitf, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fitdump.Redact(reflect.ValueOf(fitf))
+	fitdump.Redact(reflect.ValueOf(activity))
+	fitdump.Privacy{FuzzPosition: 100}.Positions(reflect.ValueOf(*activity))
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, fitf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestEncodeDeterministic(t *testing.T) {
+	const path = "../fitdump/testdata/Activity.fit"
+
+	first := anonymize(t, path)
+	// fit.Encode orders the fields of the records differently from run
+	// to run, so try a few times
+	for i := 0; i < 10; i++ {
+		if again := anonymize(t, path); !bytes.Equal(first, again) {
+			t.Fatalf("run %d gave different bytes", i+2)
+		}
+	}
+
+	// Rearranging the fields mustn't change what's decoded
+	decode := func(data []byte) *fit.ActivityFile {
+		fitf, err := fit.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		activity, err := fitf.Activity()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return activity
+	}
+	orig, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, want := decode(first), decode(orig)
+	if len(got.Records) != len(want.Records) {
+		t.Fatalf("got %d records, want %d", len(got.Records), len(want.Records))
+	}
+	for i, r := range got.Records {
+		w := want.Records[i]
+		if !r.Timestamp.Equal(w.Timestamp) || r.Distance != w.Distance || r.Altitude != w.Altitude || r.Speed != w.Speed {
+			t.Errorf("record %d decoded as %v, %d, %d, %d, want %v, %d, %d, %d", i,
+				r.Timestamp, r.Distance, r.Altitude, r.Speed, w.Timestamp, w.Distance, w.Altitude, w.Speed)
+		}
+	}
+}
+
+// unknownMessages returns the messages in data which the fit package
+// doesn't know, as they are in the file
+func unknownMessages(t *testing.T, data []byte) []*fitstream.RawMessage {
+	t.Helper()
+
+	fitf, err := fit.Decode(bytes.NewReader(data), fit.WithUnknownMessages())
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown := make(map[fit.MesgNum]bool)
+	for _, m := range fitf.UnknownMessages {
+		unknown[m.MesgNum] = true
+	}
+
+	sr, err := fitstream.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw []*fitstream.RawMessage
+	for {
+		m, err := sr.NextRaw()
+		if err == io.EOF {
+			return raw
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if unknown[m.Num] {
+			raw = append(raw, m)
+		}
+	}
+}
+
+func TestEncodeKeepsRaw(t *testing.T) {
+	orig, err := os.ReadFile("../fitdump/testdata/Physio.fit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := unknownMessages(t, orig)
+	if len(want) == 0 {
+		t.Fatal("Physio.fit has no unknown messages")
+	}
+
+	fitf, err := fit.Decode(bytes.NewReader(orig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Encode(&buf, fitf, want...); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := fit.Decode(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("decoding the file with the raw messages: %v", err)
+	}
+	got := unknownMessages(t, buf.Bytes())
+	if len(got) != len(want) {
+		t.Fatalf("got %d unknown messages, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if !bytes.Equal(got[i].Bytes(), want[i].Bytes()) || !bytes.Equal(got[i].Definition(), want[i].Definition()) {
+			t.Errorf("message %d changed: % x, want % x", i, got[i].Bytes(), want[i].Bytes())
+		}
+	}
+}
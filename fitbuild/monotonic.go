@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// BackwardsFix says how FixMonotonic repairs a record whose timestamp is
+// before the record's before it
+type BackwardsFix int
+
+const (
+	// BackwardsDrop drops the record
+	BackwardsDrop BackwardsFix = iota
+	// BackwardsRetime moves the record to half way between its neighbours,
+	// or to the same time as the record before if there's no room
+	BackwardsRetime
+)
+
+// MonotonicFix is a change made by FixMonotonic
+type MonotonicFix struct {
+	// Index of the record in the records passed to FixMonotonic
+	Index int
+	// Field is "Timestamp" or "Distance"
+	Field  string
+	Before string
+	// After is "" if the record was dropped
+	After string
+}
+
+func (f MonotonicFix) String() string {
+	if f.After == "" {
+		return fmt.Sprintf("Records[%d].%s: %s, dropped", f.Index, f.Field, f.Before)
+	}
+	return fmt.Sprintf("Records[%d].%s: %s -> %s", f.Index, f.Field, f.Before, f.After)
+}
+
+func formatDistance(raw uint32) string {
+	return fmt.Sprintf("%.2f m", float64(raw)/100)
+}
+
+// FixMonotonic repairs the records so that neither their timestamps nor
+// their distances go backwards. Records earlier than the one before are
+// dropped or re-timed, and distances less than the one before are clamped
+// to it. The records are modified in place, and the returned slice is the
+// records which are left. Records without a timestamp or distance are left
+// alone.
+func FixMonotonic(records []*fit.RecordMsg, backwards BackwardsFix) ([]*fit.RecordMsg, []MonotonicFix) {
+	var fixes []MonotonicFix
+	var kept []*fit.RecordMsg
+	// The index of each kept record in records
+	var keptIndex []int
+
+	var last time.Time
+	for i, r := range records {
+		if fit.IsBaseTime(r.Timestamp) {
+			kept, keptIndex = append(kept, r), append(keptIndex, i)
+			continue
+		}
+
+		if r.Timestamp.Before(last) {
+			if backwards == BackwardsDrop {
+				fixes = append(fixes, MonotonicFix{i, "Timestamp", r.Timestamp.String(), ""})
+				continue
+			}
+
+			t := last
+			for _, next := range records[i+1:] {
+				if fit.IsBaseTime(next.Timestamp) {
+					continue
+				}
+				if gap := next.Timestamp.Sub(last); gap >= 2*time.Second {
+					t = last.Add(gap / 2).Truncate(time.Second)
+				}
+				break
+			}
+			fixes = append(fixes, MonotonicFix{i, "Timestamp", r.Timestamp.String(), t.String()})
+			r.Timestamp = t
+		}
+
+		last = r.Timestamp
+		kept, keptIndex = append(kept, r), append(keptIndex, i)
+	}
+
+	var lastDist uint32
+	haveDist := false
+	for j, r := range kept {
+		if _, ok := recordValue(r, "Distance"); !ok {
+			continue
+		}
+		if haveDist && r.Distance < lastDist {
+			fixes = append(fixes, MonotonicFix{keptIndex[j], "Distance", formatDistance(r.Distance), formatDistance(lastDist)})
+			r.Distance = lastDist
+		}
+		lastDist, haveDist = r.Distance, true
+	}
+
+	sort.SliceStable(fixes, func(i, j int) bool {
+		return fixes[i].Index < fixes[j].Index
+	})
+
+	return kept, fixes
+}
+
+// FixMonotonicActivity repairs the records of activity as FixMonotonic
+// does. If any records were changed, the totals of its laps, sessions and
+// activity message are recomputed with UpdateTotals, keeping all of their
+// other fields. The times are only recomputed if records were dropped or
+// re-timed.
+func FixMonotonicActivity(activity *fit.ActivityFile, backwards BackwardsFix) []MonotonicFix {
+	var fixes []MonotonicFix
+	activity.Records, fixes = FixMonotonic(activity.Records, backwards)
+
+	if len(fixes) > 0 {
+		times := false
+		for _, f := range fixes {
+			if f.Field == "Timestamp" {
+				times = true
+			}
+		}
+		UpdateTotals(activity, 0, times)
+	}
+
+	return fixes
+}
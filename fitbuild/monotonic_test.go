@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func TestFixMonotonic(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		fix   BackwardsFix
+		kept  []int
+		fixes []string
+	}{
+		{"drop", BackwardsDrop, []int{0, 1, 3, 4}, []string{
+			"Records[2].Timestamp: 2020-06-01 07:59:50 +0000 UTC, dropped",
+			"Records[4].Distance: 10.00 m -> 15.00 m",
+		}},
+		{"retime", BackwardsRetime, []int{0, 1, 2, 3, 4}, []string{
+			"Records[2].Timestamp: 2020-06-01 07:59:50 +0000 UTC -> 2020-06-01 08:00:02 +0000 UTC",
+			"Records[4].Distance: 10.00 m -> 15.00 m",
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// A timestamp 10 s before the start, and the distance
+			// going back 5 m
+			records := makeRecords([]int{0, 1, -10, 4, 6}, func(i, t int, r *fit.RecordMsg) {
+				r.Distance = []uint32{0, 500, 1000, 1500, 1000}[i]
+			})
+			orig := append([]*fit.RecordMsg(nil), records...)
+
+			got, fixes := FixMonotonic(records, tc.fix)
+
+			var strs []string
+			for _, f := range fixes {
+				strs = append(strs, f.String())
+			}
+			if !reflect.DeepEqual(strs, tc.fixes) {
+				t.Errorf("fixes %q, want %q", strs, tc.fixes)
+			}
+
+			var want []*fit.RecordMsg
+			for _, i := range tc.kept {
+				want = append(want, orig[i])
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %d records, want %d", len(got), len(want))
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i].Timestamp.Before(got[i-1].Timestamp) || got[i].Distance < got[i-1].Distance {
+					t.Errorf("record %d goes backwards", i)
+				}
+			}
+			if got[len(got)-1].Timestamp != start.Add(6*time.Second) {
+				t.Errorf("last record moved to %v", got[len(got)-1].Timestamp)
+			}
+		})
+	}
+}
+
+func TestFixMonotonicActivityKeepsFields(t *testing.T) {
+	offsets := seconds(0, 600)
+	// One record from before the start
+	offsets = append(offsets[:300], append([]int{-10}, offsets[300:]...)...)
+	records := makeRecords(offsets, steady)
+	laps, session, activityMsg := Summarize(records, Options{LapTime: 5 * time.Minute, Sport: fit.SportCycling})
+
+	session.NormalizedPower = 250
+	session.TotalElapsedTime = 1
+	laps[0].NormalizedPower = 240
+	activity := &fit.ActivityFile{
+		Activity: activityMsg,
+		Sessions: []*fit.SessionMsg{session},
+		Laps:     laps,
+		Records:  records,
+	}
+
+	fixes := FixMonotonicActivity(activity, BackwardsDrop)
+	if len(fixes) != 1 {
+		t.Fatalf("got %d fixes, want 1", len(fixes))
+	}
+
+	if session.NormalizedPower != 250 || laps[0].NormalizedPower != 240 {
+		t.Errorf("normalized power is %d and %d, want 250 and 240", session.NormalizedPower, laps[0].NormalizedPower)
+	}
+	if activity.Sessions[0] != session || len(activity.Laps) != len(laps) {
+		t.Errorf("the session or laps were replaced")
+	}
+	if got := session.GetTotalElapsedTimeScaled(); got != 600 {
+		t.Errorf("session elapsed time %v, want it recomputed as 600", got)
+	}
+
+	// Clamping distances alone keeps the times
+	session.TotalElapsedTime = 1
+	activity.Records[10].Distance = 0
+	if fixes := FixMonotonicActivity(activity, BackwardsDrop); len(fixes) != 1 || fixes[0].Field != "Distance" {
+		t.Fatalf("got fixes %v, want one distance", fixes)
+	}
+	if got := session.GetTotalElapsedTimeScaled(); got != 0.001 {
+		t.Errorf("session elapsed time %v after clamping distances, want it kept as 0.001", got)
+	}
+}
+
+func TestFixMonotonicActivityDistanceOnly(t *testing.T) {
+	data, err := os.ReadFile("../fitdump/testdata/Activity.fit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fitf, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type summary struct {
+		distance, timer, elapsed uint32
+	}
+	session, lap := *activity.Sessions[0], *activity.Laps[0]
+
+	// Put one interior record 0.2 m behind the one before
+	i := len(activity.Records) / 2
+	activity.Records[i].Distance = activity.Records[i-1].Distance - 20
+
+	fixes := FixMonotonicActivity(activity, BackwardsDrop)
+	if len(fixes) != 1 || fixes[0].Field != "Distance" {
+		t.Fatalf("got fixes %v, want one distance", fixes)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		got, want summary
+	}{
+		{"session",
+			summary{activity.Sessions[0].TotalDistance, activity.Sessions[0].TotalTimerTime, activity.Sessions[0].TotalElapsedTime},
+			summary{session.TotalDistance, session.TotalTimerTime, session.TotalElapsedTime}},
+		{"lap",
+			summary{activity.Laps[0].TotalDistance, activity.Laps[0].TotalTimerTime, activity.Laps[0].TotalElapsedTime},
+			summary{lap.TotalDistance, lap.TotalTimerTime, lap.TotalElapsedTime}},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s: distance, timer and elapsed time are %v, want them unchanged as %v", tc.name, tc.got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// PauseFill says how Resample fills the time the timer was stopped
+type PauseFill int
+
+const (
+	// PauseSkip leaves the pauses without records
+	PauseSkip PauseFill = iota
+	// PauseZero fills the pauses with records at a standstill: no speed,
+	// cadence or power, and the distance and position held
+	PauseZero
+)
+
+// ResampleOptions control how Resample fills the gaps between records
+type ResampleOptions struct {
+	// Interval between records, which is a whole number of seconds as
+	// that's the resolution of FIT timestamps
+	Interval time.Duration
+
+	// Pauses are when the timer was stopped, as from fitdump.Pauses
+	Pauses    []fitdump.Pause
+	PauseFill PauseFill
+}
+
+// interpolatedFields change smoothly between samples, so are interpolated
+// in the records filling a gap. All of the other fields are carried forward
+// from the record before the gap.
+var interpolatedFields = []string{
+	"PositionLat",
+	"PositionLong",
+	"Altitude",
+	"EnhancedAltitude",
+	"Distance",
+	"Speed",
+	"EnhancedSpeed",
+}
+
+// rawNumber returns the raw value of a numeric or position field
+func rawNumber(v reflect.Value) float64 {
+	switch x := v.Interface().(type) {
+	case fit.Latitude:
+		return float64(x.Semicircles())
+	case fit.Longitude:
+		return float64(x.Semicircles())
+	}
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	}
+	return float64(v.Uint())
+}
+
+// setRawNumber sets a numeric or position field to the raw value raw
+func setRawNumber(v reflect.Value, raw float64) {
+	raw = math.Round(raw)
+	switch v.Interface().(type) {
+	case fit.Latitude:
+		v.Set(reflect.ValueOf(fit.NewLatitude(int32(raw))))
+		return
+	case fit.Longitude:
+		v.Set(reflect.ValueOf(fit.NewLongitude(int32(raw))))
+		return
+	}
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(raw))
+	default:
+		v.SetUint(uint64(raw))
+	}
+}
+
+// interpolate returns a record at t, between a and b, with the smoothly
+// changing fields interpolated and the rest carried forward from a
+func interpolate(a, b *fit.RecordMsg, t time.Time) *fit.RecordMsg {
+	r := *a
+	r.Timestamp = t
+	// The compressed fields hold values relative to the previous record
+	r.CompressedSpeedDistance = nil
+	r.Speed1s = nil
+
+	frac := float64(t.Sub(a.Timestamp)) / float64(b.Timestamp.Sub(a.Timestamp))
+	va, vb := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+	vr := reflect.ValueOf(&r).Elem()
+	for _, field := range interpolatedFields {
+		info, _ := fitdump.FieldInfo("Record", field)
+		fa, fb := va.FieldByName(field), vb.FieldByName(field)
+		if info.IsInvalid(fa) || info.IsInvalid(fb) {
+			continue
+		}
+		from, to := rawNumber(fa), rawNumber(fb)
+		setRawNumber(vr.FieldByName(field), from+(to-from)*frac)
+	}
+
+	return &r
+}
+
+// standstill returns a record at t for a pause after a, with the position
+// and distance held
+func standstill(a *fit.RecordMsg, t time.Time) *fit.RecordMsg {
+	r := fit.NewRecordMsg()
+	r.Timestamp = t
+	r.PositionLat, r.PositionLong = a.PositionLat, a.PositionLong
+	r.Altitude, r.EnhancedAltitude = a.Altitude, a.EnhancedAltitude
+	r.Distance = a.Distance
+	r.Speed, r.EnhancedSpeed = 0, 0
+	r.Cadence, r.Power = 0, 0
+	return r
+}
+
+func paused(pauses []fitdump.Pause, t time.Time) bool {
+	for _, p := range pauses {
+		if t.After(p.Start) && t.Before(p.Start.Add(p.Duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resample fills the gaps between the records so that there's one at least
+// every opts.Interval, on a grid starting from the first record. The
+// existing records pass through, sorted into time order. Records without a
+// timestamp are dropped, as they can't be placed.
+func Resample(records []*fit.RecordMsg, opts ResampleOptions) []*fit.RecordMsg {
+	var sorted []*fit.RecordMsg
+	for _, r := range records {
+		if !fit.IsBaseTime(r.Timestamp) {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+	if len(sorted) == 0 || opts.Interval <= 0 {
+		return sorted
+	}
+
+	start := sorted[0].Timestamp
+	out := []*fit.RecordMsg{sorted[0]}
+	for i := 1; i < len(sorted); i++ {
+		a, b := sorted[i-1], sorted[i]
+
+		// The first grid point after a
+		steps := a.Timestamp.Sub(start)/opts.Interval + 1
+		for t := start.Add(steps * opts.Interval); t.Before(b.Timestamp); t = t.Add(opts.Interval) {
+			if !paused(opts.Pauses, t) {
+				out = append(out, interpolate(a, b, t))
+			} else if opts.PauseFill == PauseZero {
+				out = append(out, standstill(a, t))
+			}
+		}
+		out = append(out, b)
+	}
+
+	return out
+}
+
+// FillGaps fills each gap of more than maxGap between consecutive records
+// with records every second, interpolated as by Resample. Unlike Resample,
+// the records are left in their order, gaps of maxGap or less are left
+// alone, and nothing is filled while paused. Records without a timestamp
+// pass through. It returns the records and how many were added.
+func FillGaps(records []*fit.RecordMsg, maxGap time.Duration, pauses []fitdump.Pause) ([]*fit.RecordMsg, int) {
+	if maxGap <= 0 {
+		return records, 0
+	}
+
+	var out []*fit.RecordMsg
+	var last *fit.RecordMsg
+	added := 0
+	for _, r := range records {
+		if fit.IsBaseTime(r.Timestamp) {
+			out = append(out, r)
+			continue
+		}
+
+		if last != nil && r.Timestamp.Sub(last.Timestamp) > maxGap {
+			for t := last.Timestamp.Add(time.Second); t.Before(r.Timestamp); t = t.Add(time.Second) {
+				if !paused(pauses, t) {
+					out = append(out, interpolate(last, r, t))
+					added++
+				}
+			}
+		}
+
+		out = append(out, r)
+		last = r
+	}
+
+	return out, added
+}
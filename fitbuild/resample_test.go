@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+func TestResample(t *testing.T) {
+	// Smart recording, with a pause from 20 to 30 s
+	records := makeRecords([]int{0, 4, 10, 20, 30, 31}, steady)
+	pauses := []fitdump.Pause{{Start: start.Add(20 * time.Second), Duration: 10 * time.Second}}
+
+	for _, tc := range []struct {
+		name string
+		fill PauseFill
+		want int
+	}{
+		{"skip", PauseSkip, 23},
+		{"zero", PauseZero, 32},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Resample(records, ResampleOptions{Interval: time.Second, Pauses: pauses, PauseFill: tc.fill})
+			if len(got) != tc.want {
+				t.Fatalf("got %d records, want %d", len(got), tc.want)
+			}
+
+			for i := 1; i < len(got); i++ {
+				if !got[i].Timestamp.After(got[i-1].Timestamp) {
+					t.Fatalf("record %d at %v isn't after the one before", i, got[i].Timestamp)
+				}
+			}
+
+			// Interpolated between the records at 4 and 10 s, with the
+			// heart rate carried forward from 4 s
+			r := got[7]
+			if r.Timestamp != start.Add(7*time.Second) || r.Distance != 3500 || r.HeartRate != 144 {
+				t.Errorf("record at 7 s = %v, distance %d, heart rate %d", r.Timestamp, r.Distance, r.HeartRate)
+			}
+
+			if tc.fill == PauseZero {
+				r := got[25]
+				if r.Speed != 0 || r.Distance != 10000 || r.HeartRate != 0xff {
+					t.Errorf("paused record: speed %d, distance %d, heart rate %d", r.Speed, r.Distance, r.HeartRate)
+				}
+			}
+
+			// The output must survive being encoded
+			fitf, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+			if err != nil {
+				t.Fatal(err)
+			}
+			activity, _ := fitf.Activity()
+			activity.Records = got
+
+			var buf bytes.Buffer
+			if err := fit.Encode(&buf, fitf, binary.LittleEndian); err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := fit.Decode(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			activity, _ = decoded.Activity()
+			if len(activity.Records) != tc.want {
+				t.Errorf("decoded %d records, want %d", len(activity.Records), tc.want)
+			}
+		})
+	}
+}
+
+func TestFillGaps(t *testing.T) {
+	// Gaps of 4 s, 6 s and a paused 10 s
+	records := makeRecords([]int{0, 4, 10, 14, 24, 25}, steady)
+	pauses := []fitdump.Pause{{Start: start.Add(14 * time.Second), Duration: 10 * time.Second}}
+
+	got, added := FillGaps(records, 5*time.Second, pauses)
+	if added != 5 || len(got) != len(records)+added {
+		t.Fatalf("got %d records, %d added, want 5 added", len(got), added)
+	}
+
+	// The 4 s gap is left, and the 6 s one filled from 5 to 9 s
+	for i, want := range []int{0, 4, 5, 6, 7, 8, 9, 10, 14, 24, 25} {
+		if got[i].Timestamp != start.Add(time.Duration(want)*time.Second) {
+			t.Errorf("record %d at %v, want %d s", i, got[i].Timestamp, want)
+		}
+	}
+	if r := got[4]; r.Distance != 3500 || r.HeartRate != 144 {
+		t.Errorf("record at 7 s: distance %d, heart rate %d", r.Distance, r.HeartRate)
+	}
+
+	if got, added := FillGaps(records, 0, nil); added != 0 || len(got) != len(records) {
+		t.Errorf("with no maxGap, %d added", added)
+	}
+}
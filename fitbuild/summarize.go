@@ -0,0 +1,551 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// Package fitbuild builds FIT messages from other messages, such as the
+// summary messages of an activity from its records.
+package fitbuild
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// DefaultPauseGap is used when Options.PauseGap is 0. It's long enough for
+// devices which only record when something changes ("smart recording").
+const DefaultPauseGap = 15 * time.Second
+
+// Options control how Summarize builds the summary messages
+type Options struct {
+	// LapDistance, if non-zero, starts a new lap every this many metres
+	LapDistance float64
+
+	// LapTime, if non-zero and LapDistance isn't set, starts a new lap
+	// every this much elapsed time
+	LapTime time.Duration
+
+	// Laps, if neither LapDistance nor LapTime is set, are existing laps
+	// whose start times mark the lap boundaries. Their trigger, intensity
+	// and calories are passed through. Without any, there's a single lap.
+	Laps []*fit.LapMsg
+
+	// PauseGap is the longest gap between records which counts towards
+	// the timer time. Longer gaps are taken to be the timer being
+	// stopped. 0 means DefaultPauseGap.
+	PauseGap time.Duration
+
+	Sport    fit.Sport
+	SubSport fit.SubSport
+
+	// TotalCalories is passed through to the session, as calories can't
+	// be computed from the records. If it's 0, the session has the total
+	// of the laps' calories, if they're all known.
+	TotalCalories uint16
+}
+
+// segment is a run of records summarised as one lap or session
+type segment struct {
+	// Records are [first, last], and prev is the record before first,
+	// or -1. The segment starts at prev, so that laps are contiguous.
+	first, last, prev int
+	trigger           fit.LapTrigger
+	lap               *fit.LapMsg
+}
+
+type stat struct {
+	sum, max float64
+	n        int
+}
+
+func (s *stat) add(v float64) {
+	if s.n == 0 || v > s.max {
+		s.max = v
+	}
+	s.sum += v
+	s.n++
+}
+
+// totals are the values summarised over a segment, in physical units
+type totals struct {
+	start, end       time.Time
+	elapsed, timer   time.Duration
+	distance         float64
+	ascent, descent  float64
+	haveAltitude     bool
+	startLat, endLat fit.Latitude
+	startLng, endLng fit.Longitude
+	minLat, maxLat   fit.Latitude
+	minLng, maxLng   fit.Longitude
+
+	speed, heartRate, cadence, power stat
+}
+
+// series holds the per-record values which depend on the records around
+// them, computed once for all of the records.
+type series struct {
+	records []*fit.RecordMsg
+	// Cumulative distance at each record, in metres
+	distance []float64
+	// Altitude at each record, or NaN where it's unknown
+	altitude []float64
+}
+
+// msgValue returns the physical value of field of msg, a pointer to one of
+// the fit.XXXMsg types, or false if it's invalid
+func msgValue(msg interface{}, field string) (float64, bool) {
+	val := reflect.ValueOf(msg).Elem()
+	info, ok := fitdump.FieldInfo(val.Type().Name(), field)
+	if !ok {
+		return 0, false
+	}
+	v := val.FieldByName(field)
+	if info.IsInvalid(v) {
+		return 0, false
+	}
+	return info.Value(v)
+}
+
+func recordValue(r *fit.RecordMsg, field string) (float64, bool) {
+	return msgValue(r, field)
+}
+
+// firstValue returns the first of fields which is valid in r
+func firstValue(r *fit.RecordMsg, fields ...string) (float64, bool) {
+	for _, field := range fields {
+		if v, ok := recordValue(r, field); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func hasPosition(r *fit.RecordMsg) bool {
+	return !r.PositionLat.Invalid() && !r.PositionLong.Invalid()
+}
+
+func newSeries(records []*fit.RecordMsg) *series {
+	s := &series{
+		records:  records,
+		distance: make([]float64, len(records)),
+		altitude: make([]float64, len(records)),
+	}
+
+	// Use the distance field if there is one, otherwise the positions
+	useField := false
+	for _, r := range records {
+		if _, ok := recordValue(r, "Distance"); ok {
+			useField = true
+			break
+		}
+	}
+
+	dist := 0.0
+	var last *fit.RecordMsg
+	for i, r := range records {
+		if useField {
+			if d, ok := recordValue(r, "Distance"); ok {
+				dist = d
+			}
+		} else if hasPosition(r) {
+			if last != nil {
+				dist += fitdump.DistanceBetween(last.PositionLat.Degrees(), last.PositionLong.Degrees(),
+					r.PositionLat.Degrees(), r.PositionLong.Degrees())
+			}
+			last = r
+		}
+		s.distance[i] = dist
+
+		s.altitude[i] = math.NaN()
+		if alt, ok := firstValue(r, "EnhancedAltitude", "Altitude"); ok {
+			s.altitude[i] = alt
+		}
+	}
+
+	return s
+}
+
+// splitSegments divides the records into laps according to opts
+func (s *series) splitSegments(opts Options) []segment {
+	n := len(s.records)
+	var segs []segment
+
+	startSeg := func(first int) segment {
+		return segment{first: first, last: n - 1, prev: first - 1, trigger: fit.LapTriggerSessionEnd}
+	}
+
+	switch {
+	case opts.LapDistance > 0, opts.LapTime > 0:
+		seg := startSeg(0)
+		base, start := s.distance[0], s.records[0].Timestamp
+		for i := 0; i < n-1; i++ {
+			split := false
+			if opts.LapDistance > 0 {
+				split = s.distance[i]-base >= opts.LapDistance
+				seg.trigger = fit.LapTriggerDistance
+			} else {
+				split = s.records[i].Timestamp.Sub(start) >= opts.LapTime
+				seg.trigger = fit.LapTriggerTime
+			}
+			if split {
+				seg.last = i
+				segs = append(segs, seg)
+				seg = startSeg(i + 1)
+				base, start = s.distance[i], s.records[i].Timestamp
+			}
+		}
+		seg.trigger = fit.LapTriggerSessionEnd
+		segs = append(segs, seg)
+	case len(opts.Laps) > 0:
+		laps := make([]*fit.LapMsg, len(opts.Laps))
+		copy(laps, opts.Laps)
+		sort.SliceStable(laps, func(i, j int) bool {
+			return laps[i].StartTime.Before(laps[j].StartTime)
+		})
+
+		i := 0
+		for j, lap := range laps {
+			first := i
+			for i < n && (j == len(laps)-1 || s.records[i].Timestamp.Before(laps[j+1].StartTime)) {
+				i++
+			}
+			if i == first {
+				// No records in this lap
+				continue
+			}
+			seg := startSeg(first)
+			seg.last = i - 1
+			seg.trigger = lap.LapTrigger
+			seg.lap = lap
+			segs = append(segs, seg)
+		}
+	default:
+		segs = append(segs, startSeg(0))
+	}
+
+	return segs
+}
+
+// total summarises the records of seg
+func (s *series) total(seg segment, pauseGap time.Duration) *totals {
+	t := &totals{
+		startLat: fit.NewLatitudeInvalid(),
+		startLng: fit.NewLongitudeInvalid(),
+		endLat:   fit.NewLatitudeInvalid(),
+		endLng:   fit.NewLongitudeInvalid(),
+		minLat:   fit.NewLatitudeInvalid(),
+		maxLat:   fit.NewLatitudeInvalid(),
+		minLng:   fit.NewLongitudeInvalid(),
+		maxLng:   fit.NewLongitudeInvalid(),
+	}
+
+	from := seg.first
+	if seg.prev >= 0 {
+		from = seg.prev
+	}
+	t.start = s.records[from].Timestamp
+	t.end = s.records[seg.last].Timestamp
+	t.elapsed = t.end.Sub(t.start)
+	// The distance is cumulative, so the first segment starts from zero
+	// rather than from its first record
+	t.distance = s.distance[seg.last]
+	if seg.first > 0 {
+		t.distance -= s.distance[seg.first-1]
+	}
+
+	// The last known altitude, which may be from before the segment
+	lastAlt := math.NaN()
+	for i := from; i >= 0 && math.IsNaN(lastAlt); i-- {
+		lastAlt = s.altitude[i]
+	}
+
+	for i := seg.first; i <= seg.last; i++ {
+		r := s.records[i]
+
+		if i > from {
+			dt := r.Timestamp.Sub(s.records[i-1].Timestamp)
+			if dt > 0 && dt <= pauseGap {
+				t.timer += dt
+			}
+		}
+
+		if alt := s.altitude[i]; !math.IsNaN(alt) {
+			t.haveAltitude = true
+			if !math.IsNaN(lastAlt) {
+				if d := alt - lastAlt; d > 0 {
+					t.ascent += d
+				} else {
+					t.descent -= d
+				}
+			}
+			lastAlt = alt
+		}
+
+		if hasPosition(r) {
+			if t.startLat.Invalid() {
+				t.startLat, t.startLng = r.PositionLat, r.PositionLong
+			}
+			t.endLat, t.endLng = r.PositionLat, r.PositionLong
+
+			lat, lng := r.PositionLat.Semicircles(), r.PositionLong.Semicircles()
+			if t.minLat.Invalid() || lat < t.minLat.Semicircles() {
+				t.minLat = r.PositionLat
+			}
+			if t.maxLat.Invalid() || lat > t.maxLat.Semicircles() {
+				t.maxLat = r.PositionLat
+			}
+			if t.minLng.Invalid() || lng < t.minLng.Semicircles() {
+				t.minLng = r.PositionLong
+			}
+			if t.maxLng.Invalid() || lng > t.maxLng.Semicircles() {
+				t.maxLng = r.PositionLong
+			}
+		}
+
+		if v, ok := firstValue(r, "EnhancedSpeed", "Speed"); ok {
+			t.speed.add(v)
+		}
+		if v, ok := recordValue(r, "HeartRate"); ok {
+			t.heartRate.add(v)
+		}
+		if v, ok := recordValue(r, "Cadence"); ok {
+			t.cadence.add(v)
+		}
+		if v, ok := recordValue(r, "Power"); ok {
+			t.power.add(v)
+		}
+	}
+
+	return t
+}
+
+// setValue sets field of msg, a pointer to one of the fit.XXXMsg types, to
+// the raw value for the physical value v. Values which don't fit in the
+// field are left invalid.
+func setValue(msg interface{}, field string, v float64) {
+	val := reflect.ValueOf(msg).Elem()
+	f := val.FieldByName(field)
+	info, ok := fitdump.FieldInfo(val.Type().Name(), field)
+	if !f.IsValid() || !ok || math.IsNaN(v) {
+		return
+	}
+
+	raw := math.Round((v + info.Offset) * info.Scale)
+	switch f.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		// The largest value is the invalid one
+		if raw < 0 || f.OverflowUint(uint64(raw)+1) {
+			return
+		}
+		f.SetUint(uint64(raw))
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f.OverflowInt(int64(raw) + 1) {
+			return
+		}
+		f.SetInt(int64(raw))
+	}
+}
+
+// apply sets the fields which laps and sessions have in common
+func (t *totals) apply(msg interface{}) {
+	val := reflect.ValueOf(msg).Elem()
+	val.FieldByName("StartTime").Set(reflect.ValueOf(t.start))
+	val.FieldByName("StartPositionLat").Set(reflect.ValueOf(t.startLat))
+	val.FieldByName("StartPositionLong").Set(reflect.ValueOf(t.startLng))
+	t.applyTotals(msg, true)
+
+	if t.haveAltitude {
+		setValue(msg, "TotalAscent", t.ascent)
+		setValue(msg, "TotalDescent", t.descent)
+	}
+}
+
+// applyTotals sets the distance, and the averages and maxima of a lap or
+// session, and with times, the end time and the elapsed and timer time.
+// Without times, the average speed is over the timer time msg already has.
+// Those without data in the records are left as they were.
+func (t *totals) applyTotals(msg interface{}, times bool) {
+	timer := t.timer.Seconds()
+	if times {
+		val := reflect.ValueOf(msg).Elem()
+		val.FieldByName("Timestamp").Set(reflect.ValueOf(t.end))
+		setValue(msg, "TotalElapsedTime", t.elapsed.Seconds())
+		setValue(msg, "TotalTimerTime", timer)
+	} else if v, ok := msgValue(msg, "TotalTimerTime"); ok {
+		timer = v
+	} else {
+		timer = 0
+	}
+
+	setValue(msg, "TotalDistance", t.distance)
+	if timer > 0 {
+		setValue(msg, "AvgSpeed", t.distance/timer)
+		setValue(msg, "EnhancedAvgSpeed", t.distance/timer)
+	}
+	if t.speed.n > 0 {
+		setValue(msg, "MaxSpeed", t.speed.max)
+		setValue(msg, "EnhancedMaxSpeed", t.speed.max)
+	}
+
+	for _, s := range []struct {
+		name string
+		stat stat
+	}{
+		{"HeartRate", t.heartRate},
+		{"Cadence", t.cadence},
+		{"Power", t.power},
+	} {
+		if s.stat.n > 0 {
+			setValue(msg, "Avg"+s.name, s.stat.sum/float64(s.stat.n))
+			setValue(msg, "Max"+s.name, s.stat.max)
+		}
+	}
+}
+
+// Summarize builds the lap, session and activity messages for an activity
+// from its records, which must be in time order. It computes the distance,
+// elapsed and timer time, average and maximum speed, heart rate, cadence
+// and power, the ascent and descent, and the start and end positions.
+//
+// Values are computed from whichever fields the records have: the distance
+// from the positions if there's no distance field, and enhanced speed and
+// altitude in preference to the plain ones. Fields with no data in the
+// records are left invalid. Averages are over the records with a valid
+// value, so missing samples don't drag them down.
+//
+// The laps are contiguous: each starts where the previous one ended. It
+// returns nils if there are no records.
+func Summarize(records []*fit.RecordMsg, opts Options) (laps []*fit.LapMsg, session *fit.SessionMsg, activity *fit.ActivityMsg) {
+	if len(records) == 0 {
+		return nil, nil, nil
+	}
+
+	pauseGap := opts.PauseGap
+	if pauseGap == 0 {
+		pauseGap = DefaultPauseGap
+	}
+
+	s := newSeries(records)
+
+	calories := 0
+	caloriesKnown := true
+	for i, seg := range s.splitSegments(opts) {
+		lap := fit.NewLapMsg()
+		t := s.total(seg, pauseGap)
+		t.apply(lap)
+		lap.EndPositionLat, lap.EndPositionLong = t.endLat, t.endLng
+
+		lap.MessageIndex = fit.MessageIndex(i)
+		lap.Event = fit.EventLap
+		lap.EventType = fit.EventTypeStop
+		lap.LapTrigger = seg.trigger
+		lap.Sport = opts.Sport
+		lap.SubSport = opts.SubSport
+
+		if seg.lap != nil {
+			lap.Intensity = seg.lap.Intensity
+			lap.TotalCalories = seg.lap.TotalCalories
+		}
+		if lap.TotalCalories == 0xFFFF {
+			caloriesKnown = false
+		} else {
+			calories += int(lap.TotalCalories)
+		}
+
+		laps = append(laps, lap)
+	}
+
+	all := segment{first: 0, last: len(records) - 1, prev: -1}
+	t := s.total(all, pauseGap)
+
+	session = fit.NewSessionMsg()
+	t.apply(session)
+	session.MessageIndex = 0
+	session.Event = fit.EventSession
+	session.EventType = fit.EventTypeStop
+	session.Sport = opts.Sport
+	session.SubSport = opts.SubSport
+	session.Trigger = fit.SessionTriggerActivityEnd
+	session.FirstLapIndex = 0
+	session.NumLaps = uint16(len(laps))
+	session.NecLat, session.NecLong = t.maxLat, t.maxLng
+	session.SwcLat, session.SwcLong = t.minLat, t.minLng
+
+	switch {
+	case opts.TotalCalories != 0:
+		session.TotalCalories = opts.TotalCalories
+	case caloriesKnown && calories < 0xFFFF:
+		session.TotalCalories = uint16(calories)
+	}
+
+	activity = fit.NewActivityMsg()
+	activity.Timestamp = session.Timestamp
+	activity.TotalTimerTime = session.TotalTimerTime
+	activity.NumSessions = 1
+	activity.Type = fit.ActivityModeManual
+	activity.Event = fit.EventActivity
+	activity.EventType = fit.EventTypeStop
+
+	return laps, session, activity
+}
+
+// UpdateTotals recomputes the totals of the existing laps, sessions and
+// activity message of activity from its records, which must be in time
+// order, such as after some have been dropped. Only the end time, the
+// elapsed and timer time, the distance, and the averages and maxima of
+// speed, heart rate, cadence and power change. Everything else, such as
+// the start times, normalized power or training effect, is kept. Laps and
+// sessions without any records are left alone. pauseGap is as in Options.
+//
+// The end times and the elapsed and timer times are only recomputed with
+// times, as the records can't reproduce the device's exact timer, so they
+// should be kept unless records were dropped or re-timed.
+func UpdateTotals(activity *fit.ActivityFile, pauseGap time.Duration, times bool) {
+	records := activity.Records
+	if len(records) == 0 {
+		return
+	}
+	if pauseGap == 0 {
+		pauseGap = DefaultPauseGap
+	}
+
+	s := newSeries(records)
+
+	if len(activity.Laps) > 0 {
+		for _, seg := range s.splitSegments(Options{Laps: activity.Laps}) {
+			s.total(seg, pauseGap).applyTotals(seg.lap, times)
+		}
+	}
+
+	sessions := make([]*fit.SessionMsg, len(activity.Sessions))
+	copy(sessions, activity.Sessions)
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.Before(sessions[j].StartTime)
+	})
+	i := 0
+	for j, session := range sessions {
+		first := i
+		for i < len(records) && (j == len(sessions)-1 || records[i].Timestamp.Before(sessions[j+1].StartTime)) {
+			i++
+		}
+		if i == first {
+			continue
+		}
+		seg := segment{first: first, last: i - 1, prev: -1}
+		s.total(seg, pauseGap).applyTotals(session, times)
+	}
+
+	if times && activity.Activity != nil && len(sessions) > 0 {
+		timer := 0.0
+		for _, session := range sessions {
+			if activity.Activity.Timestamp.Before(session.Timestamp) {
+				activity.Activity.Timestamp = session.Timestamp
+			}
+			timer += session.GetTotalTimerTimeScaled()
+		}
+		setValue(activity.Activity, "TotalTimerTime", timer)
+	}
+}
@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+var start = time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC)
+
+// makeRecords returns a record for each of the offsets in seconds from
+// start, with only the timestamp set, for fn to fill in.
+func makeRecords(offsets []int, fn func(i, t int, r *fit.RecordMsg)) []*fit.RecordMsg {
+	records := make([]*fit.RecordMsg, len(offsets))
+	for i, t := range offsets {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(t) * time.Second)
+		if fn != nil {
+			fn(i, t, r)
+		}
+		records[i] = r
+	}
+	return records
+}
+
+// seconds returns the offsets from a to b inclusive, a second apart
+func seconds(a, b int) []int {
+	var offsets []int
+	for t := a; t <= b; t++ {
+		offsets = append(offsets, t)
+	}
+	return offsets
+}
+
+// steady moves at 5 m/s with every sensor present
+func steady(i, t int, r *fit.RecordMsg) {
+	r.Distance = uint32(t * 5 * 100)
+	r.Speed = 5000
+	r.HeartRate = uint8(140 + t%10)
+	r.Cadence = 90
+	r.Power = 200
+}
+
+func TestSummarizeSteady(t *testing.T) {
+	records := makeRecords(seconds(0, 600), steady)
+	laps, session, activity := Summarize(records, Options{Sport: fit.SportCycling})
+
+	if len(laps) != 1 {
+		t.Fatalf("got %d laps, want 1", len(laps))
+	}
+
+	for _, tc := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"TotalDistance", session.TotalDistance, uint32(300000)},
+		{"TotalElapsedTime", session.TotalElapsedTime, uint32(600000)},
+		{"TotalTimerTime", session.TotalTimerTime, uint32(600000)},
+		{"AvgSpeed", session.AvgSpeed, uint16(5000)},
+		{"MaxSpeed", session.MaxSpeed, uint16(5000)},
+		{"EnhancedAvgSpeed", session.EnhancedAvgSpeed, uint32(5000)},
+		{"AvgHeartRate", session.AvgHeartRate, uint8(144)},
+		{"MaxHeartRate", session.MaxHeartRate, uint8(149)},
+		{"AvgCadence", session.AvgCadence, uint8(90)},
+		{"AvgPower", session.AvgPower, uint16(200)},
+		{"MaxPower", session.MaxPower, uint16(200)},
+		{"StartTime", session.StartTime, start},
+		{"Timestamp", session.Timestamp, start.Add(600 * time.Second)},
+		{"NumLaps", session.NumLaps, uint16(1)},
+		{"Sport", session.Sport, fit.SportCycling},
+		{"Lap TotalDistance", laps[0].TotalDistance, session.TotalDistance},
+		{"Lap TotalTimerTime", laps[0].TotalTimerTime, session.TotalTimerTime},
+		{"Lap AvgHeartRate", laps[0].AvgHeartRate, session.AvgHeartRate},
+		{"Lap LapTrigger", laps[0].LapTrigger, fit.LapTriggerSessionEnd},
+		{"Activity TotalTimerTime", activity.TotalTimerTime, session.TotalTimerTime},
+		{"Activity Timestamp", activity.Timestamp, session.Timestamp},
+		{"Activity NumSessions", activity.NumSessions, uint16(1)},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s = %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func TestSummarizePause(t *testing.T) {
+	// Stopped for five minutes in the middle
+	offsets := append(seconds(0, 299), seconds(600, 899)...)
+	records := makeRecords(offsets, steady)
+
+	_, session, _ := Summarize(records, Options{})
+
+	if got, want := session.TotalElapsedTime, uint32(899000); got != want {
+		t.Errorf("TotalElapsedTime = %d, want %d", got, want)
+	}
+	if got, want := session.TotalTimerTime, uint32(598000); got != want {
+		t.Errorf("TotalTimerTime = %d, want %d", got, want)
+	}
+	// The distance comes from the distance field, which jumps across the
+	// pause
+	if got, want := session.TotalDistance, uint32(899*5*100); got != want {
+		t.Errorf("TotalDistance = %d, want %d", got, want)
+	}
+
+	// With a longer pause gap, the gap counts as moving
+	_, session, _ = Summarize(records, Options{PauseGap: 10 * time.Minute})
+	if got, want := session.TotalTimerTime, uint32(899000); got != want {
+		t.Errorf("with a 10m pause gap, TotalTimerTime = %d, want %d", got, want)
+	}
+}
+
+func TestSummarizeMissingSensors(t *testing.T) {
+	// Distance only, and a heart rate strap which drops out for every
+	// other record
+	records := makeRecords(seconds(0, 99), func(i, t int, r *fit.RecordMsg) {
+		r.Distance = uint32(t * 100)
+		if t%2 == 0 {
+			r.HeartRate = 150
+		}
+	})
+
+	laps, session, _ := Summarize(records, Options{})
+
+	for _, msg := range []struct {
+		name                  string
+		avgHr, maxHr, cadence uint8
+		power, speed, ascent  uint16
+	}{
+		{"session", session.AvgHeartRate, session.MaxHeartRate, session.AvgCadence, session.AvgPower, session.MaxSpeed, session.TotalAscent},
+		{"lap", laps[0].AvgHeartRate, laps[0].MaxHeartRate, laps[0].AvgCadence, laps[0].AvgPower, laps[0].MaxSpeed, laps[0].TotalAscent},
+	} {
+		if msg.avgHr != 150 || msg.maxHr != 150 {
+			t.Errorf("%s: heart rate avg %d max %d, want 150 for both", msg.name, msg.avgHr, msg.maxHr)
+		}
+		if msg.cadence != 0xFF {
+			t.Errorf("%s: AvgCadence = %d, want invalid", msg.name, msg.cadence)
+		}
+		if msg.power != 0xFFFF {
+			t.Errorf("%s: AvgPower = %d, want invalid", msg.name, msg.power)
+		}
+		if msg.speed != 0xFFFF {
+			t.Errorf("%s: MaxSpeed = %d, want invalid", msg.name, msg.speed)
+		}
+		if msg.ascent != 0xFFFF {
+			t.Errorf("%s: TotalAscent = %d, want invalid", msg.name, msg.ascent)
+		}
+	}
+
+	// The average speed comes from the distance and time, so it's still
+	// known without a speed sensor
+	if got, want := session.AvgSpeed, uint16(1000); got != want {
+		t.Errorf("AvgSpeed = %d, want %d", got, want)
+	}
+	if !session.StartPositionLat.Invalid() || !session.NecLat.Invalid() {
+		t.Errorf("positions should be invalid without GPS")
+	}
+}
+
+func TestSummarizePositionsOnly(t *testing.T) {
+	// Heading north by 0.0001 degrees a second, about 11.1 m
+	records := makeRecords(seconds(0, 100), func(i, t int, r *fit.RecordMsg) {
+		r.PositionLat = fit.NewLatitudeDegrees(51.5 + float64(t)*0.0001)
+		r.PositionLong = fit.NewLongitudeDegrees(-0.1)
+	})
+
+	laps, session, _ := Summarize(records, Options{})
+
+	want := 100 * 0.0001 * math.Pi / 180 * 6371000
+	got := float64(session.TotalDistance) / 100
+	if math.Abs(got-want) > 1 {
+		t.Errorf("TotalDistance = %.2f m, want %.2f m", got, want)
+	}
+
+	if session.StartPositionLat != records[0].PositionLat {
+		t.Errorf("StartPositionLat = %v, want %v", session.StartPositionLat, records[0].PositionLat)
+	}
+	if laps[0].EndPositionLat != records[100].PositionLat {
+		t.Errorf("lap EndPositionLat = %v, want %v", laps[0].EndPositionLat, records[100].PositionLat)
+	}
+	if session.NecLat != records[100].PositionLat || session.SwcLat != records[0].PositionLat {
+		t.Errorf("bounding box latitudes %v to %v, want %v to %v",
+			session.SwcLat, session.NecLat, records[0].PositionLat, records[100].PositionLat)
+	}
+}
+
+// checkLapsAddUp checks that the laps are contiguous, and that their
+// distance and time add up to the session's
+func checkLapsAddUp(t *testing.T, laps []*fit.LapMsg, session *fit.SessionMsg) {
+	t.Helper()
+
+	var distance, timer, elapsed uint32
+	for i, lap := range laps {
+		if i > 0 && !lap.StartTime.Equal(laps[i-1].Timestamp) {
+			t.Errorf("lap %d starts at %v, but lap %d ended at %v", i, lap.StartTime, i-1, laps[i-1].Timestamp)
+		}
+		if lap.MessageIndex != fit.MessageIndex(i) {
+			t.Errorf("lap %d has MessageIndex %d", i, lap.MessageIndex)
+		}
+		distance += lap.TotalDistance
+		timer += lap.TotalTimerTime
+		elapsed += lap.TotalElapsedTime
+	}
+
+	if distance != session.TotalDistance {
+		t.Errorf("lap distances add up to %d, session has %d", distance, session.TotalDistance)
+	}
+	if timer != session.TotalTimerTime {
+		t.Errorf("lap timer times add up to %d, session has %d", timer, session.TotalTimerTime)
+	}
+	if elapsed != session.TotalElapsedTime {
+		t.Errorf("lap elapsed times add up to %d, session has %d", elapsed, session.TotalElapsedTime)
+	}
+	if int(session.NumLaps) != len(laps) {
+		t.Errorf("NumLaps = %d, want %d", session.NumLaps, len(laps))
+	}
+}
+
+func TestSummarizeLapSplits(t *testing.T) {
+	// 2500 m at 5 m/s, with a pause in the middle
+	offsets := append(seconds(0, 199), seconds(260, 560)...)
+	records := makeRecords(offsets, func(i, t int, r *fit.RecordMsg) {
+		r.Distance = uint32(i * 5 * 100)
+	})
+
+	testCases := []struct {
+		name      string
+		opts      Options
+		distances []uint32
+		triggers  []fit.LapTrigger
+	}{
+		{
+			name:      "distance",
+			opts:      Options{LapDistance: 1000},
+			distances: []uint32{100000, 100000, 50000},
+			triggers:  []fit.LapTrigger{fit.LapTriggerDistance, fit.LapTriggerDistance, fit.LapTriggerSessionEnd},
+		},
+		{
+			// Elapsed time, so the first lap, with the pause in it,
+			// covers less distance
+			name:      "time",
+			opts:      Options{LapTime: 4 * time.Minute},
+			distances: []uint32{100000, 120000, 30000},
+			triggers:  []fit.LapTrigger{fit.LapTriggerTime, fit.LapTriggerTime, fit.LapTriggerSessionEnd},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			laps, session, _ := Summarize(records, tc.opts)
+
+			if len(laps) != len(tc.distances) {
+				t.Fatalf("got %d laps, want %d", len(laps), len(tc.distances))
+			}
+			for i, lap := range laps {
+				if lap.TotalDistance != tc.distances[i] {
+					t.Errorf("lap %d TotalDistance = %d, want %d", i, lap.TotalDistance, tc.distances[i])
+				}
+				if lap.LapTrigger != tc.triggers[i] {
+					t.Errorf("lap %d LapTrigger = %v, want %v", i, lap.LapTrigger, tc.triggers[i])
+				}
+			}
+
+			checkLapsAddUp(t, laps, session)
+		})
+	}
+}
+
+func TestSummarizeExistingLaps(t *testing.T) {
+	records := makeRecords(seconds(0, 599), steady)
+
+	existing := make([]*fit.LapMsg, 3)
+	for i := range existing {
+		existing[i] = fit.NewLapMsg()
+		existing[i].StartTime = start.Add(time.Duration(i*200) * time.Second)
+		existing[i].LapTrigger = fit.LapTriggerManual
+		existing[i].TotalCalories = uint16(10 * (i + 1))
+	}
+	existing[1].Intensity = fit.IntensityRest
+
+	laps, session, _ := Summarize(records, Options{Laps: existing})
+
+	if len(laps) != 3 {
+		t.Fatalf("got %d laps, want 3", len(laps))
+	}
+	for i, lap := range laps {
+		if lap.TotalCalories != existing[i].TotalCalories {
+			t.Errorf("lap %d TotalCalories = %d, want %d", i, lap.TotalCalories, existing[i].TotalCalories)
+		}
+		if lap.LapTrigger != fit.LapTriggerManual {
+			t.Errorf("lap %d LapTrigger = %v, want Manual", i, lap.LapTrigger)
+		}
+		if lap.Intensity != existing[i].Intensity {
+			t.Errorf("lap %d Intensity = %v, want %v", i, lap.Intensity, existing[i].Intensity)
+		}
+	}
+	if got, want := laps[1].StartTime, start.Add(199*time.Second); !got.Equal(want) {
+		t.Errorf("lap 1 StartTime = %v, want %v", got, want)
+	}
+	if got, want := session.TotalCalories, uint16(60); got != want {
+		t.Errorf("session TotalCalories = %d, want the laps' total %d", got, want)
+	}
+	checkLapsAddUp(t, laps, session)
+
+	_, session, _ = Summarize(records, Options{Laps: existing, TotalCalories: 75})
+	if got, want := session.TotalCalories, uint16(75); got != want {
+		t.Errorf("session TotalCalories = %d, want the passed through %d", got, want)
+	}
+
+	// Without calories for every lap, the session's are unknown
+	existing[2].TotalCalories = 0xFFFF
+	_, session, _ = Summarize(records, Options{Laps: existing})
+	if session.TotalCalories != 0xFFFF {
+		t.Errorf("session TotalCalories = %d, want invalid", session.TotalCalories)
+	}
+}
+
+func TestSummarizeAscent(t *testing.T) {
+	// Up 50 m, down 30 m, with a dropout at the top. Altitude has a
+	// scale of 5 and an offset of 500.
+	alts := []float64{100, 110, 120, 130, 140, math.NaN(), 150, 140, 130, 120}
+	records := makeRecords(seconds(0, len(alts)-1), func(i, t int, r *fit.RecordMsg) {
+		if !math.IsNaN(alts[i]) {
+			r.EnhancedAltitude = uint32((alts[i] + 500) * 5)
+			// The plain field disagrees, and should be ignored
+			r.Altitude = 0
+		}
+	})
+
+	laps, session, _ := Summarize(records, Options{LapTime: 5 * time.Second})
+
+	if session.TotalAscent != 50 || session.TotalDescent != 30 {
+		t.Errorf("ascent %d, descent %d, want 50 and 30", session.TotalAscent, session.TotalDescent)
+	}
+
+	// The climb to the summit after the dropout is in the second lap
+	var ascent, descent uint16
+	for _, lap := range laps {
+		ascent += lap.TotalAscent
+		descent += lap.TotalDescent
+	}
+	if ascent != session.TotalAscent || descent != session.TotalDescent {
+		t.Errorf("laps add up to ascent %d, descent %d, session has %d and %d",
+			ascent, descent, session.TotalAscent, session.TotalDescent)
+	}
+}
+
+func TestSummarizeEmpty(t *testing.T) {
+	laps, session, activity := Summarize(nil, Options{})
+	if laps != nil || session != nil || activity != nil {
+		t.Errorf("got %v, %v, %v, want nils", laps, session, activity)
+	}
+}
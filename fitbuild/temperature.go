@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// temperatureInvalid is the invalid value of the sint8 temperature field
+const temperatureInvalid = 0x7F
+
+// ShiftTemperature moves the temperature of the records earlier by lag, to
+// make up for a sensor which is slow to respond. Each record gets the
+// temperature of the first record at least lag after it, and the records
+// within lag of the end are left with an invalid temperature. Nothing else
+// changes, the timestamps included. The records must be in time order.
+func ShiftTemperature(records []*fit.RecordMsg, lag time.Duration) {
+	temps := make([]int8, len(records))
+	for i, r := range records {
+		temps[i] = r.Temperature
+	}
+
+	for _, r := range records {
+		at := r.Timestamp.Add(lag)
+		j := sort.Search(len(records), func(j int) bool {
+			return !records[j].Timestamp.Before(at)
+		})
+		if j == len(records) {
+			r.Temperature = temperatureInvalid
+			continue
+		}
+		r.Temperature = temps[j]
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+func TestShiftTemperature(t *testing.T) {
+	offsets := []int{0, 1, 2, 4, 5, 6}
+	records := makeRecords(offsets, func(i, t int, r *fit.RecordMsg) {
+		r.Temperature = int8(20 + t)
+	})
+
+	ShiftTemperature(records, 2*time.Second)
+
+	var got []int8
+	for i, r := range records {
+		got = append(got, r.Temperature)
+		if want := start.Add(time.Duration(offsets[i]) * time.Second); !r.Timestamp.Equal(want) {
+			t.Errorf("record %d moved to %v, want %v", i, r.Timestamp, want)
+		}
+	}
+	// 1s+2s has no record, so it takes the next one, at 4s
+	want := []int8{22, 24, 24, 26, temperatureInvalid, temperatureInvalid}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("temperatures %v, want %v", got, want)
+	}
+}
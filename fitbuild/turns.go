@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// TurnOptions control where Turns finds turns
+type TurnOptions struct {
+	// Threshold is the smallest change of bearing, in degrees, which
+	// counts as a turn
+	Threshold float64
+	// Over is how far, in metres, the bearing is measured over before
+	// and after each point, so that the change has to be sustained and
+	// GPS jitter doesn't count
+	Over float64
+	// Spacing is the least distance, in metres, between the course
+	// points. Turns closer than this to the one before are combined
+	// with it.
+	Spacing float64
+}
+
+type turnPoint struct {
+	r        *fit.RecordMsg
+	lat, lng float64
+	// Distance along the track, in metres
+	dist float64
+}
+
+// bearing returns the initial bearing in degrees from the first position
+// to the second, clockwise from north
+func bearing(lat1, lng1, lat2, lng2 float64) float64 {
+	rad := math.Pi / 180
+	dLng := (lng2 - lng1) * rad
+	y := math.Sin(dLng) * math.Cos(lat2*rad)
+	x := math.Cos(lat1*rad)*math.Sin(lat2*rad) - math.Sin(lat1*rad)*math.Cos(lat2*rad)*math.Cos(dLng)
+	return math.Atan2(y, x) / rad
+}
+
+// turnAngle returns the change from bearing a to b, between -180 and 180
+// degrees, positive to the right
+func turnAngle(a, b float64) float64 {
+	d := math.Mod(b-a+540, 360) - 180
+	if d == -180 {
+		return 180
+	}
+	return d
+}
+
+// Turns returns course points for the turns of a track, to give turn
+// warnings on a course which has none. The records, in order along the
+// track, are compared on a bearing change measured over opts.Over before
+// and after each one. Neighbouring records past opts.Threshold are one
+// turn, at the first of the sharpest of them, as are turns less than
+// opts.Spacing after it. Each turn is Left or Right by the change across
+// all of it, or Straight where that cancels out, such as at a staggered
+// crossroads. Records without a position are skipped.
+//
+// The points are named by the distance they're at, from the records'
+// Distance where it's valid, or along the track otherwise.
+func Turns(records []*fit.RecordMsg, opts TurnOptions) []*fit.CoursePointMsg {
+	var points []turnPoint
+	dist := 0.0
+	for _, r := range records {
+		if r.PositionLat.Invalid() || r.PositionLong.Invalid() {
+			continue
+		}
+		p := turnPoint{r: r, lat: r.PositionLat.Degrees(), lng: r.PositionLong.Degrees()}
+		if n := len(points); n > 0 {
+			dist += fitdump.DistanceBetween(points[n-1].lat, points[n-1].lng, p.lat, p.lng)
+		}
+		p.dist = dist
+		points = append(points, p)
+	}
+
+	// The bearing change at each point, or NaN if it's too near the
+	// start or end to have one, with the bearings it's between
+	angles := make([]float64, len(points))
+	in := make([]float64, len(points))
+	out := make([]float64, len(points))
+	lo, hi := 0, 0
+	for i, p := range points {
+		angles[i] = math.NaN()
+		for lo+1 < i && points[lo+1].dist <= p.dist-opts.Over {
+			lo++
+		}
+		if hi < i {
+			hi = i
+		}
+		for hi < len(points) && points[hi].dist < p.dist+opts.Over {
+			hi++
+		}
+		if p.dist-points[lo].dist < opts.Over || hi == len(points) {
+			continue
+		}
+		in[i] = bearing(points[lo].lat, points[lo].lng, p.lat, p.lng)
+		out[i] = bearing(p.lat, p.lng, points[hi].lat, points[hi].lng)
+		angles[i] = turnAngle(in[i], out[i])
+	}
+
+	// Runs of neighbouring points past the threshold, each from first to
+	// last, turning at apex
+	type run struct{ first, last, apex int }
+	var runs []run
+	for i := 0; i < len(points); i++ {
+		if !(math.Abs(angles[i]) >= opts.Threshold) {
+			continue
+		}
+		r := run{first: i, apex: i}
+		for i+1 < len(points) && math.Abs(angles[i+1]) >= opts.Threshold {
+			i++
+			if math.Abs(angles[i]) > math.Abs(angles[r.apex]) {
+				r.apex = i
+			}
+		}
+		r.last = i
+
+		// Closer than the spacing to the one before, so they're one
+		// turn, such as a left and right at a staggered crossroads
+		if n := len(runs); n > 0 && points[r.apex].dist-points[runs[n-1].apex].dist < opts.Spacing {
+			runs[n-1].last = r.last
+			continue
+		}
+		runs = append(runs, r)
+	}
+
+	var turns []*fit.CoursePointMsg
+	for _, r := range runs {
+		net := turnAngle(in[r.first], out[r.last])
+		typ := fit.CoursePointStraight
+		switch {
+		case net >= opts.Threshold:
+			typ = fit.CoursePointRight
+		case net <= -opts.Threshold:
+			typ = fit.CoursePointLeft
+		}
+		p := points[r.apex]
+
+		cp := fit.NewCoursePointMsg()
+		cp.MessageIndex = fit.MessageIndex(len(turns))
+		cp.Timestamp = p.r.Timestamp
+		cp.PositionLat = p.r.PositionLat
+		cp.PositionLong = p.r.PositionLong
+		cp.Type = typ
+		d := p.dist
+		if v, ok := recordValue(p.r, "Distance"); ok {
+			d = v
+		}
+		cp.Distance = uint32(math.Round(d * 100))
+		if typ == fit.CoursePointStraight {
+			cp.Name = fmt.Sprintf("Straight at %.1f km", d/1000)
+		} else {
+			cp.Name = fmt.Sprintf("Turn at %.1f km", d/1000)
+		}
+		turns = append(turns, cp)
+	}
+
+	return turns
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitbuild
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+// walk returns records every 5 m along legs of a track, each a distance in
+// metres north and east
+func walk(legs ...[2]float64) []*fit.RecordMsg {
+	const metresPerDegree = 111320
+	lat, lng := 0.0, 0.0
+	var offsets []int
+	var positions [][2]float64
+	t := 0
+	for _, leg := range legs {
+		n := int(math.Max(math.Abs(leg[0]), math.Abs(leg[1])) / 5)
+		for i := 0; i < n; i++ {
+			lat += leg[0] / float64(n) / metresPerDegree
+			lng += leg[1] / float64(n) / metresPerDegree
+			offsets = append(offsets, t)
+			positions = append(positions, [2]float64{lat, lng})
+			t++
+		}
+	}
+	records := makeRecords(offsets, func(i, t int, r *fit.RecordMsg) {
+		r.PositionLat = fit.NewLatitudeDegrees(positions[i][0])
+		r.PositionLong = fit.NewLongitudeDegrees(positions[i][1])
+	})
+	fitdump.RecomputeDistance(records)
+	return records
+}
+
+func TestTurns(t *testing.T) {
+	opts := TurnOptions{Threshold: 30, Over: 20, Spacing: 50}
+
+	// Right, then left, then a jog which comes back to the same bearing
+	records := walk([2]float64{500, 0}, [2]float64{0, 500}, [2]float64{500, 0},
+		[2]float64{0, 30}, [2]float64{500, 0})
+	turns := Turns(records, opts)
+	want := []fit.CoursePoint{fit.CoursePointRight, fit.CoursePointLeft, fit.CoursePointStraight}
+	if len(turns) != len(want) {
+		for _, cp := range turns {
+			t.Logf("%v %s", cp.Type, cp.Name)
+		}
+		t.Fatalf("got %d turns, want %d", len(turns), len(want))
+	}
+	for i, cp := range turns {
+		if cp.Type != want[i] {
+			t.Errorf("turn %d is %v, want %v", i, cp.Type, want[i])
+		}
+	}
+	if turns[0].Name != "Turn at 0.5 km" {
+		t.Errorf("first turn is named '%s', want 'Turn at 0.5 km'", turns[0].Name)
+	}
+
+	// A gentle bend doesn't count
+	if turns := Turns(walk([2]float64{500, 0}, [2]float64{500, 100}), opts); len(turns) != 0 {
+		t.Errorf("got %d turns on a gentle bend, want none", len(turns))
+	}
+
+	// Two turns 30 m apart are closer than the spacing, so are one
+	turns = Turns(walk([2]float64{500, 0}, [2]float64{0, 30}, [2]float64{500, 0}),
+		TurnOptions{Threshold: 30, Over: 10, Spacing: 50})
+	if len(turns) != 1 {
+		t.Errorf("got %d turns 30 m apart, want 1", len(turns))
+	}
+}
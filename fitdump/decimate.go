@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// DecimateMode selects what happens to the records within each bucket
+type DecimateMode int
+
+const (
+	// DecimateDrop keeps the first record of each bucket
+	DecimateDrop DecimateMode = iota
+	// DecimateAvg replaces each bucket with one record holding the
+	// average of each numeric field
+	DecimateAvg
+)
+
+// ParseDecimateMode parses "drop" or "avg". An empty string is DecimateDrop.
+func ParseDecimateMode(s string) (DecimateMode, error) {
+	switch s {
+	case "", "drop":
+		return DecimateDrop, nil
+	case "avg":
+		return DecimateAvg, nil
+	}
+
+	return DecimateDrop, fmt.Errorf("unknown decimation mode '%s', expected drop or avg", s)
+}
+
+// Decimation describes how to thin out records. Records are grouped into
+// buckets, either of Every consecutive records or of Interval of time, and
+// each bucket becomes a single record. The zero value keeps every record.
+type Decimation struct {
+	Every    int
+	Interval time.Duration
+	Mode     DecimateMode
+}
+
+// ParseEvery parses a bucket size, either a duration such as "5s" or a
+// plain record count such as "10".
+func ParseEvery(s string) (Decimation, error) {
+	if s == "" {
+		return Decimation{}, nil
+	}
+
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 1 {
+			return Decimation{}, fmt.Errorf("record count must be at least 1, got %d", n)
+		}
+		return Decimation{Every: n}, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return Decimation{}, fmt.Errorf("expected a record count or a duration, got '%s'", s)
+	}
+	if d <= 0 {
+		return Decimation{}, fmt.Errorf("interval must be positive, got %v", d)
+	}
+
+	return Decimation{Interval: d}, nil
+}
+
+func (d Decimation) enabled() bool {
+	return d.Every > 1 || d.Interval > 0
+}
+
+func (d Decimation) bucket(i int, r *fit.RecordMsg, start time.Time) int64 {
+	if d.Every > 0 {
+		return int64(i / d.Every)
+	}
+	return int64(r.Timestamp.Sub(start) / d.Interval)
+}
+
+// Decimate thins out records, which must be in time order. The first and
+// last records are always kept as they are, as are any which coincide with
+// the start or end of one of laps, so that the laps still line up with the
+// records afterwards.
+func Decimate(records []*fit.RecordMsg, laps []*fit.LapMsg, d Decimation) []*fit.RecordMsg {
+	if !d.enabled() || len(records) <= 2 {
+		return records
+	}
+
+	boundaries := make(map[time.Time]bool)
+	for _, l := range laps {
+		boundaries[l.StartTime] = true
+		boundaries[l.Timestamp] = true
+	}
+
+	start := records[0].Timestamp
+	var decimated []*fit.RecordMsg
+	var bucket []*fit.RecordMsg
+	flush := func() {
+		if len(bucket) > 0 {
+			decimated = append(decimated, d.reduce(bucket))
+			bucket = nil
+		}
+	}
+
+	prev := int64(-1)
+	for i, r := range records {
+		key := d.bucket(i, r, start)
+
+		if i == 0 || i == len(records)-1 || boundaries[r.Timestamp] {
+			flush()
+			decimated = append(decimated, r)
+			prev = key
+			continue
+		}
+
+		if key != prev {
+			flush()
+			prev = key
+		} else if d.Mode == DecimateDrop && len(bucket) == 0 {
+			// This bucket has already been represented by a
+			// record which was kept
+			continue
+		}
+
+		bucket = append(bucket, r)
+	}
+	flush()
+
+	return decimated
+}
+
+func (d Decimation) reduce(bucket []*fit.RecordMsg) *fit.RecordMsg {
+	if d.Mode == DecimateDrop || len(bucket) == 1 {
+		return bucket[0]
+	}
+
+	return averageRecords(bucket)
+}
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// averageRecords returns a copy of the first record with each numeric field
+// replaced by its average over the valid values in records. Enums, times and
+// anything else which can't sensibly be averaged are taken from the first
+// record.
+func averageRecords(records []*fit.RecordMsg) *fit.RecordMsg {
+	avg := *records[0]
+	dst := reflect.ValueOf(&avg).Elem()
+
+	for i := 0; i < dst.NumField(); i++ {
+		name := dst.Type().Field(i).Name
+		field := dst.Field(i)
+
+		switch field.Interface().(type) {
+		case fit.Latitude:
+			if v, ok := averageField(records, i, nil, semicircles); ok {
+				field.Set(reflect.ValueOf(fit.NewLatitude(int32(math.Round(v)))))
+			}
+			continue
+		case fit.Longitude:
+			if v, ok := averageField(records, i, nil, semicircles); ok {
+				field.Set(reflect.ValueOf(fit.NewLongitude(int32(math.Round(v)))))
+			}
+			continue
+		}
+
+		if field.Type().Implements(stringerType) {
+			continue
+		}
+
+		info, ok := FieldInfo("Record", name)
+		if !ok {
+			continue
+		}
+
+		v, ok := averageField(records, i, &info, rawValue)
+		if !ok {
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			field.SetInt(int64(math.Round(v)))
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			field.SetUint(uint64(math.Round(v)))
+		case reflect.Float32, reflect.Float64:
+			field.SetFloat(v)
+		}
+	}
+
+	return &avg
+}
+
+func semicircles(v reflect.Value) (float64, bool) {
+	switch x := v.Interface().(type) {
+	case fit.Latitude:
+		return float64(x.Semicircles()), !x.Invalid()
+	case fit.Longitude:
+		return float64(x.Semicircles()), !x.Invalid()
+	}
+	return 0, false
+}
+
+func rawValue(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// averageField averages field i over the records where it's valid, using get
+// to extract the value. The average is of the raw value, so the result can
+// be stored straight back in the field.
+func averageField(records []*fit.RecordMsg, i int, info *Info, get func(reflect.Value) (float64, bool)) (float64, bool) {
+	sum, n := 0.0, 0
+	for _, r := range records {
+		v := reflect.ValueOf(r).Elem().Field(i)
+		if info != nil && info.IsInvalid(v) {
+			continue
+		}
+		if f, ok := get(v); ok {
+			sum += f
+			n++
+		}
+	}
+
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float64(n), true
+}
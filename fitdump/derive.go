@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Derived is a value computed from each record and those before it, for an
+// extra column of the tabular output
+type Derived string
+
+const (
+	// DeriveAccel is the change in speed since the previous record, in
+	// m/s²
+	DeriveAccel Derived = "accel"
+	// DeriveGrade is the change in altitude over the change in distance
+	// across the window, in percent
+	DeriveGrade Derived = "grade"
+	// DeriveVAM is the change in altitude across the window, in vertical
+	// metres per hour
+	DeriveVAM Derived = "vam"
+)
+
+var derivedColumns = map[Derived]struct {
+	name   string
+	digits int
+}{
+	DeriveAccel: {"DerivedAccel", 3},
+	DeriveGrade: {"DerivedGrade", 1},
+	DeriveVAM:   {"DerivedVam", 0},
+}
+
+// ParseDerived parses a comma-separated list of derived values, such as
+// "accel,grade,vam"
+func ParseDerived(s string) ([]Derived, error) {
+	var derived []Derived
+	seen := make(map[Derived]bool)
+	for _, name := range strings.Split(s, ",") {
+		d := Derived(strings.ToLower(strings.TrimSpace(name)))
+		if _, ok := derivedColumns[d]; !ok {
+			return nil, fmt.Errorf("unknown value '%s', expected accel, grade or vam", name)
+		}
+		if !seen[d] {
+			seen[d] = true
+			derived = append(derived, d)
+		}
+	}
+	return derived, nil
+}
+
+// derivedValue returns the physical value of the first of fields which is
+// valid in r
+func derivedValue(r *fit.RecordMsg, fields ...string) (float64, bool) {
+	msg := reflect.ValueOf(r).Elem()
+	for _, field := range fields {
+		if v := scaledValue(msg, field); finite(v) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// derive returns d for each of records, which must be in time order, or NaN
+// where it can't be worked out: for the first record, records at the same
+// time or distance as the one they're compared with, and records missing
+// the values it needs. Grade and VAM compare each record with the earliest
+// one no more than window before it, or the previous record if window is 0.
+func derive(records []*fit.RecordMsg, d Derived, window time.Duration) []float64 {
+	values := make([]float64, len(records))
+	lo := 0
+	for i, r := range records {
+		values[i] = math.NaN()
+		if i == 0 {
+			continue
+		}
+
+		if d == DeriveAccel {
+			prev := records[i-1]
+			dt := r.Timestamp.Sub(prev.Timestamp).Seconds()
+			v0, ok0 := derivedValue(prev, "EnhancedSpeed", "Speed")
+			v1, ok1 := derivedValue(r, "EnhancedSpeed", "Speed")
+			if ok0 && ok1 && dt > 0 {
+				values[i] = (v1 - v0) / dt
+			}
+			continue
+		}
+
+		for lo < i-1 && r.Timestamp.Sub(records[lo].Timestamp) > window {
+			lo++
+		}
+		from := records[lo]
+		a0, ok0 := derivedValue(from, "EnhancedAltitude", "Altitude")
+		a1, ok1 := derivedValue(r, "EnhancedAltitude", "Altitude")
+		if !ok0 || !ok1 {
+			continue
+		}
+
+		switch d {
+		case DeriveGrade:
+			d0, ok0 := derivedValue(from, "Distance")
+			d1, ok1 := derivedValue(r, "Distance")
+			if ok0 && ok1 && d1 > d0 {
+				values[i] = (a1 - a0) / (d1 - d0) * 100
+			}
+		case DeriveVAM:
+			if dt := r.Timestamp.Sub(from.Timestamp).Hours(); dt > 0 {
+				values[i] = (a1 - a0) / dt
+			}
+		}
+	}
+	return values
+}
+
+// DerivedColumns returns an extra column for each of derived, computed from
+// records, which must be in time order and are the ones the columns will be
+// written with. Cells which can't be worked out, such as for the first
+// record or while stopped, are left empty. See derive for window.
+func DerivedColumns(records []*fit.RecordMsg, derived []Derived, window time.Duration) []ExtraColumn {
+	var cols []ExtraColumn
+	for _, d := range derived {
+		col := derivedColumns[d]
+		cells := make(map[*fit.RecordMsg]string, len(records))
+		for i, v := range derive(records, d, window) {
+			if finite(v) {
+				cells[records[i]] = strconv.FormatFloat(v, 'f', col.digits, 64)
+			}
+		}
+		cols = append(cols, ExtraColumn{
+			Name: col.name,
+			Value: func(r *fit.RecordMsg) string {
+				return cells[r]
+			},
+		})
+	}
+	return cols
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// The fit package skips developer fields, and keeps their descriptions to
+// itself, so they're read from the raw messages.
+const (
+	fieldDescriptionDevDataIndex = 0 // uint8
+	fieldDescriptionFieldNum     = 1 // uint8
+	fieldDescriptionBaseType     = 2 // uint8
+	fieldDescriptionName         = 3 // string
+	fieldDescriptionScale        = 6 // uint8
+	fieldDescriptionOffset       = 7 // sint8
+	fieldDescriptionUnits        = 8 // string
+
+	developerDataIdDevDataIndex = 3 // uint8
+)
+
+// DevField is a developer field of the records, such as the air speed from
+// an aero sensor
+type DevField struct {
+	Name  string
+	Units string
+
+	devIndex, num byte
+	baseType      byte
+	scale, offset float64
+}
+
+// DevFields holds the numeric developer fields of a file's records, and
+// their samples keyed by Unix time. Samples at the invalid value are left
+// out.
+type DevFields struct {
+	// Fields with any samples, in the order they were first seen
+	Fields  []*DevField
+	Samples map[*DevField]map[int64]float64
+
+	// The developer data indexes with an ID so far. Field descriptions
+	// and developer fields are ignored until there's one.
+	ids map[byte]bool
+	// The fields described so far, in order. A later, different
+	// description of the same field replaces the earlier one.
+	described []*DevField
+}
+
+func rawString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+type baseType struct {
+	size          int
+	signed, float bool
+	invalid       uint64
+}
+
+// The numeric FIT base types, by their base type number
+var baseTypes = map[byte]baseType{
+	0x00: {1, false, false, 0xff},               // enum
+	0x01: {1, true, false, 0x7f},                // sint8
+	0x02: {1, false, false, 0xff},               // uint8
+	0x0a: {1, false, false, 0},                  // uint8z
+	0x0d: {1, false, false, 0xff},               // byte
+	0x83: {2, true, false, 0x7fff},              // sint16
+	0x84: {2, false, false, 0xffff},             // uint16
+	0x8b: {2, false, false, 0},                  // uint16z
+	0x85: {4, true, false, 0x7fffffff},          // sint32
+	0x86: {4, false, false, 0xffffffff},         // uint32
+	0x8c: {4, false, false, 0},                  // uint32z
+	0x88: {4, false, true, 0xffffffff},          // float32
+	0x8e: {8, true, false, 0x7fffffffffffffff},  // sint64
+	0x8f: {8, false, false, 0xffffffffffffffff}, // uint64
+	0x90: {8, false, false, 0},                  // uint64z
+	0x89: {8, false, true, 0xffffffffffffffff},  // float64
+}
+
+// devValue decodes a developer field of a numeric base type, returning false
+// if it's at the invalid value. Arrays and strings aren't supported.
+func devValue(b []byte, typ byte, order binary.ByteOrder) (float64, bool) {
+	bt, ok := baseTypes[typ]
+	if !ok || len(b) != bt.size {
+		return 0, false
+	}
+
+	var u uint64
+	switch bt.size {
+	case 1:
+		u = uint64(b[0])
+	case 2:
+		u = uint64(order.Uint16(b))
+	case 4:
+		u = uint64(order.Uint32(b))
+	case 8:
+		u = order.Uint64(b)
+	}
+	if u == bt.invalid {
+		return 0, false
+	}
+
+	switch {
+	case bt.float && bt.size == 4:
+		return float64(math.Float32frombits(uint32(u))), true
+	case bt.float:
+		return math.Float64frombits(u), true
+	case bt.signed:
+		// Sign extend from the field's size
+		shift := 64 - 8*bt.size
+		return float64(int64(u<<shift) >> shift), true
+	}
+	return float64(u), true
+}
+
+func newDevFields() *DevFields {
+	return &DevFields{
+		Samples: make(map[*DevField]map[int64]float64),
+		ids:     make(map[byte]bool),
+	}
+}
+
+// add collects the field description or developer fields of raw. A field
+// description only applies to the messages after it, so records from before
+// a description arrives part way through the file don't have a sample for
+// it. Files without a developer data ID have no developer fields, so
+// nothing is done until there's one.
+func (d *DevFields) add(raw *fitstream.RawMessage) {
+	switch raw.Num {
+	case fit.MesgNumDeveloperDataId:
+		if devIndex, ok := raw.Uint(developerDataIdDevDataIndex); ok {
+			d.ids[byte(devIndex)] = true
+		}
+	case fit.MesgNumFieldDescription:
+		if len(d.ids) == 0 {
+			return
+		}
+
+		devIndex, ok1 := raw.Uint(fieldDescriptionDevDataIndex)
+		num, ok2 := raw.Uint(fieldDescriptionFieldNum)
+		typ, ok3 := raw.Uint(fieldDescriptionBaseType)
+		if !ok1 || !ok2 || !ok3 {
+			return
+		}
+
+		field := &DevField{
+			devIndex: byte(devIndex),
+			num:      byte(num),
+			baseType: byte(typ),
+			scale:    1,
+		}
+		if b, ok := raw.Field(fieldDescriptionName); ok {
+			field.Name = rawString(b)
+		}
+		if field.Name == "" {
+			field.Name = "developer_" + strconv.Itoa(int(devIndex)) + "_" + strconv.Itoa(int(num))
+		}
+		if b, ok := raw.Field(fieldDescriptionUnits); ok {
+			field.Units = rawString(b)
+		}
+		if v, ok := raw.Uint(fieldDescriptionScale); ok && v != 0xff && v != 0 {
+			field.scale = float64(v)
+		}
+		if v, ok := raw.Uint(fieldDescriptionOffset); ok && v != 0x7f {
+			field.offset = float64(int8(v))
+		}
+
+		replaced := false
+		for i, f := range d.described {
+			if f.devIndex == field.devIndex && f.num == field.num {
+				if *f != *field {
+					d.described[i] = field
+				}
+				replaced = true
+			}
+		}
+		if !replaced {
+			d.described = append(d.described, field)
+		}
+	case fit.MesgNumRecord:
+		if raw.Timestamp.IsZero() {
+			return
+		}
+		t := raw.Timestamp.Unix()
+
+		for _, field := range d.described {
+			b, ok := raw.DevField(field.devIndex, field.num)
+			if !ok {
+				continue
+			}
+			v, ok := devValue(b, field.baseType, raw.ByteOrder())
+			if !ok {
+				continue
+			}
+
+			samples := d.Samples[field]
+			if samples == nil {
+				samples = make(map[int64]float64)
+				d.Samples[field] = samples
+				d.Fields = append(d.Fields, field)
+			}
+			samples[t] = v/field.scale - field.offset
+		}
+	}
+}
+
+// ReadDevFields reads the numeric developer fields of the records in the
+// FIT file in r. Use ReadExtras or Extras to read them along with the rest.
+func ReadDevFields(r io.Reader) (*DevFields, error) {
+	x, err := ReadExtras(r)
+	if err != nil {
+		return nil, err
+	}
+	return x.DevFields, nil
+}
+
+// Columns returns the record columns for the developer fields with any
+// samples, named after the field with its units.
+func (d *DevFields) Columns() []ExtraColumn {
+	var cols []ExtraColumn
+	for _, field := range d.Fields {
+		name := field.Name
+		if field.Units != "" {
+			name += " (" + field.Units + ")"
+		}
+		cols = append(cols, sampleColumn(name, d.Samples[field]))
+	}
+	return cols
+}
@@ -0,0 +1,458 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Options control how values are dumped
+type Options struct {
+	// MaxDepth stops the dump descending into structs and slices nested
+	// deeper than this level, printing "..." in their place. 0 means no
+	// limit.
+	MaxDepth int
+
+	// MaxSlice limits the number of elements printed for each slice,
+	// with a count of the omitted ones printed after. 0 means no limit.
+	MaxSlice int
+
+	// Tail prints the last Tail elements of each slice, after the first
+	// MaxSlice and a count of the omitted ones between. With Tail set,
+	// MaxSlice of 0 means none of the first. 0 means no tail.
+	Tail int
+
+	// SpeedUnit selects how speed fields are presented
+	SpeedUnit SpeedUnit
+
+	// Units selects the system of the values which don't have an option
+	// of their own, such as temperatures
+	Units UnitSystem
+
+	// Missing is printed in place of values which couldn't be computed,
+	// which come out as NaN or infinite, such as the average of no
+	// samples or the pace when stopped. "" means "-". See ParseMissing.
+	Missing string
+
+	// Minimal omits the slice element counts and the "---" struct
+	// terminators, leaving only "name:" headers and "name: value" leaves.
+	// The count of elements left out by MaxSlice is still printed.
+	Minimal bool
+
+	// SnakeCase prints field names in the FIT profile's snake_case form
+	// instead of the Go names.
+	SnakeCase bool
+
+	// Debugf, if set, is called with detail about what was left out of
+	// the dump, such as fields skipped for holding invalid values.
+	Debugf func(format string, args ...interface{})
+
+	// Enhanced selects whether a message's legacy and enhanced fields,
+	// such as Speed and EnhancedSpeed, are both dumped
+	Enhanced EnhancedPreference
+
+	// FieldNumbers prints each field's number and base type from the
+	// FIT profile after its name, like "HeartRate (field 3, uint8)", for
+	// the fields the profile has
+	FieldNumbers bool
+
+	// Components prints the fields which each composite field is
+	// expanded into beneath it, with their scale and unit applied. They're
+	// still printed in their own right too.
+	Components bool
+
+	// Index prefixes each message in a slice with its sequence number
+	// across all of the slices dumped, as well as its index within the
+	// slice. The fit package groups messages by type when decoding, so
+	// this is the order in the dump rather than the order in the file.
+	Index bool
+
+	// Location, if set, is the zone UTC times are shown in. Local times
+	// already carry their own offset, so are left alone.
+	Location *time.Location
+
+	// Select, if set, limits the dump to a single message. Other slices
+	// of messages are left out, but messages held on their own, like the
+	// file_id, are still dumped.
+	Select *Selector
+
+	// Exclude, if set, leaves the messages in it out of the dump, along
+	// with the slices holding them
+	Exclude Exclusion
+
+	// Invalid selects whether fields holding invalid values are left
+	// out, printed raw or marked as invalid
+	Invalid InvalidPolicy
+
+	// Paths prints each value on its own line after its full Path from
+	// the value dumped, like "Records[3].HeartRate: 142", instead of as
+	// a tree, so that lines can be told apart when grepping. Index and
+	// FieldNumbers don't apply.
+	Paths bool
+}
+
+// Section is where one of the top-level messages or slices of messages
+// starts in the dump
+type Section struct {
+	Name string
+	// Count is the number of elements in a slice, or 0 for a message
+	Count int
+	// Line counts from 1, and Offset is in bytes from 0, from the start
+	// of everything written by the Dumper
+	Line, Offset int
+}
+
+// countingWriter keeps track of how far into the output it is
+type countingWriter struct {
+	w            io.Writer
+	lines, bytes int
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.bytes += n
+	cw.lines += bytes.Count(p[:n], []byte("\n"))
+	return n, err
+}
+
+// Dumper writes a human-readable tree representation of decoded FIT data
+type Dumper struct {
+	w    *countingWriter
+	opts Options
+
+	// Running count of messages dumped from slices, for Options.Index
+	seq int
+
+	// Path of the value being dumped, for Options.Paths
+	path Path
+
+	sections []Section
+}
+
+// NewDumper returns a Dumper which writes to w
+func NewDumper(w io.Writer, opts Options) *Dumper {
+	return &Dumper{
+		w:    &countingWriter{w: w},
+		opts: opts,
+	}
+}
+
+// Sections returns where each of the messages and slices of messages held
+// directly in the values dumped so far starts, such as a file's Records
+func (d *Dumper) Sections() []Section {
+	return d.sections
+}
+
+// section records the start of a section, if it's at the top level of a
+// dumped value
+func (d *Dumper) section(level int, name string, count int) {
+	if level != 1 {
+		return
+	}
+	d.sections = append(d.sections, Section{
+		Name:   name,
+		Count:  count,
+		Line:   d.w.lines + 1,
+		Offset: d.w.bytes,
+	})
+}
+
+// Dump recursively dumps val, which is typically a fit.File or one of the
+// fit.XXXFile types, under the given name.
+func (d *Dumper) Dump(val reflect.Value, name string) {
+	d.dumpRecursive(val, nil, name, 0)
+}
+
+func (d *Dumper) printIndent(level int, format string, args ...interface{}) {
+	fmt.Fprintf(d.w, "%s", strings.Repeat("\t", level))
+	fmt.Fprintf(d.w, format, args...)
+}
+
+// printLeaf prints a value, under its name in the tree or after its path
+func (d *Dumper) printLeaf(level int, name, value string) {
+	if d.opts.Paths {
+		if len(d.path) > 0 {
+			name = d.path.String()
+		}
+		fmt.Fprintf(d.w, "%s: %s\n", name, value)
+		return
+	}
+	d.printIndent(level, "%s: %s\n", name, value)
+}
+
+// printHeader prints the line which starts a struct or slice in the tree,
+// which isn't needed with paths
+func (d *Dumper) printHeader(level int, header string) {
+	if !d.opts.Paths {
+		d.printIndent(level, "%s:\n", header)
+	}
+}
+
+func (d *Dumper) debugf(format string, args ...interface{}) {
+	if d.opts.Debugf != nil {
+		d.opts.Debugf(format, args...)
+	}
+}
+
+// dumpInvalid prints an invalid field according to Options.Invalid
+func (d *Dumper) dumpInvalid(field reflect.Value, name string, level int) {
+	text, ok := d.opts.Invalid.invalidText(rawText(field))
+	if !ok {
+		d.debugf("skipped invalid field %s", name)
+		return
+	}
+	d.printLeaf(level, name, text)
+}
+
+func (d *Dumper) dumpField(field reflect.Value, info *Info, name string, level int) {
+	if t, ok := field.Interface().(time.Time); ok && d.opts.Location != nil && t.Location() == time.UTC {
+		field = reflect.ValueOf(t.In(d.opts.Location))
+	}
+
+	if method := field.MethodByName("String"); method.IsValid() {
+		str := method.Call(nil)[0].String()
+		if invalidEnum(field) {
+			d.dumpInvalid(field, name, level)
+			return
+		}
+		d.printLeaf(level, name, str)
+	} else if info != nil {
+		if info.IsInvalid(field) {
+			d.dumpInvalid(field, name, level)
+			return
+		}
+		if v, ok := info.Value(field); ok {
+			if text, ok := d.opts.convert(v, *info, true); ok {
+				d.printLeaf(level, name, text)
+				return
+			}
+		}
+		d.printLeaf(level, name, fmt.Sprintf("%v", field))
+	} else if IsInvalid(field) {
+		// FIXME: Without profile information, this can't handle the
+		// 'z' variants, so a field might be incorrectly excluded if
+		// it's a 'z' type and holds a value which looks invalid for
+		// a non-'z' type.
+		d.dumpInvalid(field, name, level)
+		return
+	} else {
+		d.printLeaf(level, name, fmt.Sprintf("%+v", field))
+	}
+}
+
+// dumpComponents prints the components of field i of msg, if it has any and
+// it's valid
+func (d *Dumper) dumpComponents(msg reflect.Value, i int, info *Info, level int) {
+	name := msg.Type().Field(i).Name
+	comps := Components(msg.Type().Name(), name)
+	if len(comps) == 0 || info == nil || info.IsInvalid(msg.Field(i)) {
+		return
+	}
+
+	for _, comp := range comps {
+		compInfo, ok := FieldInfo(msg.Type().Name(), comp)
+		if !ok {
+			continue
+		}
+		text := FormatCell(msg.FieldByName(comp), compInfo, d.opts)
+		if text == "" {
+			continue
+		}
+		unit := compInfo.Unit
+		if d.opts.converts(compInfo) {
+			unit = d.opts.ConvertedUnit(compInfo)
+		}
+		if unit != "" && text != d.opts.missing() {
+			text += " " + unit
+		}
+		if d.opts.SnakeCase {
+			comp = SnakeCase(comp)
+		}
+		saved := d.path
+		d.path = d.path.field(comp)
+		d.printLeaf(level, comp, text)
+		d.path = saved
+	}
+}
+
+func exported(name string) bool {
+	r, l := utf8.DecodeRune([]byte(name))
+	if r == utf8.RuneError && (l <= 1) {
+		// I guess this should never be able to happen
+		panic("unicode error")
+	}
+
+	return unicode.IsUpper(r)
+}
+
+func isStructSlice(val reflect.Value) bool {
+	t := val.Type().Elem()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+// dumpSelected dumps the message picked by Options.Select from a slice of
+// messages, or nothing if it's the wrong slice
+func (d *Dumper) dumpSelected(val reflect.Value, name string, level int) {
+	sel := d.opts.Select
+	seq := d.seq
+	d.seq += val.Len()
+
+	if !sel.matches(val, name) {
+		if val.Len() > 0 {
+			d.debugf("%s: left out by the selection %v", name, sel)
+		}
+		return
+	}
+	if sel.Index >= val.Len() {
+		return
+	}
+
+	if d.opts.SnakeCase {
+		name = SnakeCase(name)
+	}
+	d.section(level, name, 0)
+	d.printHeader(level, name)
+	elemName := fmt.Sprintf("[%d]", sel.Index)
+	if d.opts.Index {
+		elemName = fmt.Sprintf("#%d %s", seq+sel.Index, elemName)
+	}
+	saved := d.path
+	d.path = d.path.field(name).index(sel.Index)
+	d.dumpRecursive(reflect.Indirect(val.Index(sel.Index)), nil, elemName, level+1)
+	d.path = saved
+}
+
+func (d *Dumper) truncated(level int) bool {
+	return d.opts.MaxDepth > 0 && level >= d.opts.MaxDepth
+}
+
+func (d *Dumper) dumpRecursive(val reflect.Value, info *Info, name string, level int) {
+	// TODO: I'm not very happy with all the different conditions/branches
+	// here. It's a bit spaghetti
+	if method := val.MethodByName("String"); method.IsValid() {
+		// For Stringers, dump them right away
+		d.dumpField(val, info, name, level)
+	} else {
+		switch val.Kind() {
+		case reflect.Struct:
+			d.section(level, name, 0)
+			if d.truncated(level) {
+				d.printLeaf(level, name, "...")
+				break
+			}
+			// TODO: If all fields are invalid or unexported,
+			// should we skip it entirely?
+			d.printHeader(level, name)
+			for i := 0; i < val.NumField(); i++ {
+				v := val.Field(i)
+				name = val.Type().Field(i).Name
+				if !exported(name) {
+					continue
+				}
+				var fieldInfo *Info
+				if fi, ok := FieldInfo(val.Type().Name(), name); ok {
+					fieldInfo = &fi
+				}
+				if d.opts.Enhanced.redundant(val, name) {
+					d.debugf("skipped %s, its paired field is preferred", name)
+					continue
+				}
+				if d.opts.Exclude.excludes(v, name) {
+					d.debugf("%s: left out by the exclusion", name)
+					continue
+				}
+				if d.opts.Select != nil && v.Kind() == reflect.Slice && isStructSlice(v) {
+					d.dumpSelected(v, name, level+1)
+					continue
+				}
+				if d.opts.SnakeCase {
+					name = SnakeCase(name)
+				}
+				saved := d.path
+				d.path = d.path.field(name)
+				if d.opts.FieldNumbers && fieldInfo != nil && !d.opts.Paths {
+					name = fmt.Sprintf("%s (field %d, %s)", name, fieldInfo.Num, fieldInfo.BaseType)
+				}
+				d.dumpRecursive(v, fieldInfo, name, level+1)
+				d.path = saved
+				if d.opts.Components {
+					d.dumpComponents(val, i, fieldInfo, level+2)
+				}
+			}
+			if !d.opts.Minimal && !d.opts.Paths {
+				d.printIndent(level, "---\n")
+			}
+		case reflect.Ptr:
+			if val.IsNil() {
+				break
+			}
+			d.dumpRecursive(reflect.Indirect(val), info, name, level)
+		case reflect.Slice:
+			if val.Len() == 0 {
+				break
+			}
+			d.section(level, name, val.Len())
+			header := fmt.Sprintf("%s (%d elems)", name, val.Len())
+			if d.opts.Minimal {
+				header = name
+			}
+			if d.truncated(level) {
+				d.printLeaf(level, header, "...")
+				break
+			}
+			d.printHeader(level, header)
+			n := val.Len()
+			head, tail := n, 0
+			if d.opts.MaxSlice > 0 || d.opts.Tail > 0 {
+				head, tail = d.opts.MaxSlice, d.opts.Tail
+				if head+tail >= n {
+					head, tail = n, 0
+				}
+			}
+			dumpElem := func(i int) {
+				elem := reflect.Indirect(val.Index(i))
+				name := fmt.Sprintf("[%d]", i)
+				if elem.Kind() == reflect.Struct {
+					if d.opts.Index && !d.opts.Paths {
+						name = fmt.Sprintf("#%d %s", d.seq, name)
+					}
+					d.seq++
+				}
+				saved := d.path
+				d.path = d.path.index(i)
+				d.dumpRecursive(elem, nil, name, level+1)
+				d.path = saved
+			}
+			for i := 0; i < head; i++ {
+				dumpElem(i)
+			}
+			if skipped := n - head - tail; skipped > 0 {
+				if isStructSlice(val) {
+					// Keep the numbering consistent with the full dump
+					d.seq += skipped
+				}
+				if d.opts.Paths {
+					d.printLeaf(level, header, fmt.Sprintf("... (%d more)", skipped))
+				} else {
+					d.printIndent(level+1, "... (%d more)\n", skipped)
+				}
+			}
+			for i := n - tail; i < n; i++ {
+				dumpElem(i)
+			}
+		default:
+			d.dumpField(val, info, name, level)
+		}
+	}
+}
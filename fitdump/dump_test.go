@@ -0,0 +1,1227 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files with the current output")
+
+func decodeTestFile(t *testing.T, name string) *fit.File {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	fitf, err := fit.Decode(f)
+	if err != nil {
+		t.Fatalf("decoding %s: %v", name, err)
+	}
+
+	return fitf
+}
+
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	golden := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("output doesn't match %s:\ngot:\n%s\nwant:\n%s", golden, got, want)
+	}
+}
+
+func TestDumpGolden(t *testing.T) {
+	testCases := []struct {
+		name   string
+		file   string
+		opts   Options
+		body   func(f *fit.File) (interface{}, error)
+		golden string
+	}{
+		{
+			name:   "activity",
+			file:   "Activity.fit",
+			body:   func(f *fit.File) (interface{}, error) { return f.Activity() },
+			golden: "Activity",
+		},
+		{
+			name:   "course",
+			file:   "Course.fit",
+			body:   func(f *fit.File) (interface{}, error) { return f.Course() },
+			golden: "Course",
+		},
+		{
+			name:   "settings",
+			file:   "Settings.fit",
+			body:   func(f *fit.File) (interface{}, error) { return f.Settings() },
+			golden: "Settings",
+		},
+		{
+			name:   "course minimal snake",
+			file:   "Course.fit",
+			opts:   Options{Minimal: true, SnakeCase: true},
+			body:   func(f *fit.File) (interface{}, error) { return f.Course() },
+			golden: "Course-minimal-snake",
+		},
+		{
+			name:   "activity truncated",
+			file:   "Activity.fit",
+			opts:   Options{MaxDepth: 2, MaxSlice: 1},
+			body:   func(f *fit.File) (interface{}, error) { return f.Activity() },
+			golden: "Activity-truncated",
+		},
+		{
+			name:   "activity minimal truncated",
+			file:   "Activity.fit",
+			opts:   Options{MaxDepth: 2, MaxSlice: 1, Minimal: true},
+			body:   func(f *fit.File) (interface{}, error) { return f.Activity() },
+			golden: "Activity-minimal-truncated",
+		},
+		{
+			name:   "activity index",
+			file:   "Activity.fit",
+			opts:   Options{MaxDepth: 2, MaxSlice: 2, Index: true},
+			body:   func(f *fit.File) (interface{}, error) { return f.Activity() },
+			golden: "Activity-index",
+		},
+		{
+			name:   "activity components",
+			file:   "Activity.fit",
+			opts:   Options{MaxSlice: 2, Components: true},
+			body:   func(f *fit.File) (interface{}, error) { return f.Activity() },
+			golden: "Activity-components",
+		},
+		{
+			name:   "activity select",
+			file:   "Activity.fit",
+			opts:   Options{Index: true, Select: &Selector{Message: "record", Index: 3}},
+			body:   func(f *fit.File) (interface{}, error) { return f.Activity() },
+			golden: "Activity-select",
+		},
+		{
+			name:   "activity field numbers",
+			file:   "Activity.fit",
+			opts:   Options{MaxSlice: 1, FieldNumbers: true},
+			body:   func(f *fit.File) (interface{}, error) { return f.Activity() },
+			golden: "Activity-field-numbers",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fitf := decodeTestFile(t, tc.file)
+
+			body, err := tc.body(fitf)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			d := NewDumper(&buf, tc.opts)
+			d.Dump(reflect.ValueOf(*fitf), tc.file)
+			d.Dump(reflect.ValueOf(body), reflect.TypeOf(body).Elem().Name())
+
+			checkGolden(t, tc.golden, buf.Bytes())
+		})
+	}
+}
+
+// A freshly constructed message has every field set to its invalid value, so
+// only the fields set explicitly should appear in the dump.
+func TestDumpSuppressesInvalid(t *testing.T) {
+	rec := fit.NewRecordMsg()
+	rec.Timestamp = time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC)
+	rec.HeartRate = 140
+	rec.Power = 0
+
+	var buf bytes.Buffer
+	NewDumper(&buf, Options{}).Dump(reflect.ValueOf(rec), "Record")
+
+	want := "Record:\n" +
+		"\tTimestamp: 2020-06-01 08:00:00 +0000 UTC\n" +
+		"\tHeartRate: 140\n" +
+		"\tPower: 0\n" +
+		"---\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestInvalidPolicy(t *testing.T) {
+	rec := fit.NewRecordMsg()
+	rec.Timestamp = time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC)
+	rec.HeartRate = 140
+	other := fit.NewRecordMsg()
+	other.Timestamp = rec.Timestamp.Add(time.Second)
+	other.Cadence = 90
+	records := []*fit.RecordMsg{rec, other}
+
+	for _, tc := range []struct {
+		policy  InvalidPolicy
+		cadence string
+		csv     string
+	}{
+		{InvalidHide, "", "2020-06-01T08:00:00Z,140,\n"},
+		{InvalidShow, "\tCadence: 255\n", "2020-06-01T08:00:00Z,140,255\n"},
+		{InvalidMark, "\tCadence: <invalid>\n", "2020-06-01T08:00:00Z,140,NA\n"},
+	} {
+		var buf bytes.Buffer
+		NewDumper(&buf, Options{Invalid: tc.policy}).Dump(reflect.ValueOf(rec), "Record")
+		dump := buf.String()
+		if tc.cadence == "" && strings.Contains(dump, "Cadence") || !strings.Contains(dump, "\tHeartRate: 140\n"+tc.cadence) {
+			t.Errorf("policy %d, dump:\n%s", tc.policy, dump)
+		}
+
+		buf.Reset()
+		if err := WriteRecords(&buf, records, ',', Options{Invalid: tc.policy}); err != nil {
+			t.Fatal(err)
+		}
+		rows := strings.SplitAfter(buf.String(), "\n")
+		if rows[0] != "Timestamp,HeartRate,Cadence\n" || rows[1] != tc.csv {
+			t.Errorf("policy %d, csv:\n%s", tc.policy, buf.String())
+		}
+	}
+}
+
+func TestDumpEnhancedPreference(t *testing.T) {
+	rec := fit.NewRecordMsg()
+	rec.Timestamp = time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC)
+	rec.Speed = 5000
+	rec.EnhancedSpeed = 5000
+	// Only the legacy field is valid, so it's kept whatever the preference
+	rec.Altitude = 2600
+
+	for _, tc := range []struct {
+		pref EnhancedPreference
+		want string
+	}{
+		{ShowBoth, "\tAltitude: 2600\n\tSpeed: 5000\n\tEnhancedSpeed: 5000\n"},
+		{PreferEnhanced, "\tAltitude: 2600\n\tEnhancedSpeed: 5000\n"},
+		{PreferLegacy, "\tAltitude: 2600\n\tSpeed: 5000\n"},
+	} {
+		var buf bytes.Buffer
+		NewDumper(&buf, Options{Enhanced: tc.pref}).Dump(reflect.ValueOf(rec), "Record")
+
+		want := "Record:\n" +
+			"\tTimestamp: 2020-06-01 08:00:00 +0000 UTC\n" +
+			tc.want +
+			"---\n"
+		if got := buf.String(); got != want {
+			t.Errorf("preference %d, got:\n%s\nwant:\n%s", tc.pref, got, want)
+		}
+	}
+}
+
+func TestInfoIsInvalid(t *testing.T) {
+	testCases := []struct {
+		msg, field string
+		value      interface{}
+		invalid    bool
+	}{
+		{"Record", "HeartRate", uint8(0xff), true},
+		{"Record", "HeartRate", uint8(0), false},
+		{"Record", "Power", uint16(0xffff), true},
+		{"Record", "Altitude", uint16(0xffff), true},
+		{"Record", "Altitude", uint16(2500), false},
+		{"Record", "Timestamp", fit.NewRecordMsg().Timestamp, true},
+		{"Record", "Timestamp", time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC), false},
+		{"Record", "PositionLat", fit.NewLatitudeInvalid(), true},
+		{"Record", "PositionLat", fit.NewLatitudeDegrees(51.5), false},
+		// 'z' types use zero as their invalid value
+		{"FileId", "SerialNumber", uint32(0), true},
+		{"FileId", "SerialNumber", uint32(0xffffffff), false},
+		{"Session", "NumLaps", uint16(0xffff), true},
+	}
+
+	for _, tc := range testCases {
+		info, ok := FieldInfo(tc.msg, tc.field)
+		if !ok {
+			t.Errorf("%s.%s: no field info", tc.msg, tc.field)
+			continue
+		}
+
+		if got := info.IsInvalid(reflect.ValueOf(tc.value)); got != tc.invalid {
+			t.Errorf("%s.%s = %v: IsInvalid() = %v, want %v",
+				tc.msg, tc.field, tc.value, got, tc.invalid)
+		}
+	}
+}
+
+func TestIsInvalidEnum(t *testing.T) {
+	testCases := []struct {
+		value   fmt.Stringer
+		invalid bool
+	}{
+		{fit.LapTriggerInvalid, true},
+		{fit.LapTriggerManual, false},
+		{fit.ManufacturerInvalid, true},
+		{fit.BoolInvalid, true},
+		{fit.BoolTrue, false},
+		// Bitfields and 'z' enums use zero
+		{fit.FileFlagsInvalid, true},
+		{fit.FileFlagsRead, false},
+		// A real value, despite its name
+		{fit.AttitudeValidityMagInvalid, false},
+		{fit.NewLatitudeInvalid(), true},
+		{fit.NewLatitudeDegrees(51.5), false},
+	}
+
+	for _, tc := range testCases {
+		if got := IsInvalidEnum(tc.value); got != tc.invalid {
+			t.Errorf("IsInvalidEnum(%T(%v)) = %v, want %v", tc.value, tc.value, got, tc.invalid)
+		}
+	}
+}
+
+func TestIsInvalidFallback(t *testing.T) {
+	testCases := []struct {
+		value   interface{}
+		invalid bool
+	}{
+		{int8(0x7f), true},
+		{uint8(0xff), true},
+		{uint8(0xfe), false},
+		{int16(0x7fff), true},
+		{uint16(0xffff), true},
+		{int32(0x7fffffff), true},
+		{uint32(0xffffffff), true},
+		{int64(0x7fffffffffffffff), true},
+		{uint64(0xffffffffffffffff), true},
+		{"", true},
+		{"name", false},
+		{[]uint8{}, true},
+		{[]uint8{1}, false},
+	}
+
+	for _, tc := range testCases {
+		if got := IsInvalid(reflect.ValueOf(tc.value)); got != tc.invalid {
+			t.Errorf("IsInvalid(%T(%v)) = %v, want %v", tc.value, tc.value, got, tc.invalid)
+		}
+	}
+}
+
+func TestWriteRecordsGolden(t *testing.T) {
+	testCases := []struct {
+		name   string
+		comma  rune
+		golden string
+	}{
+		{"csv", ',', "Course-records.csv"},
+		{"tsv", '\t', "Course-records.tsv"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			course, err := decodeTestFile(t, "Course.fit").Course()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err := WriteRecords(&buf, course.Records, tc.comma, Options{}); err != nil {
+				t.Fatal(err)
+			}
+
+			checkGolden(t, tc.golden, buf.Bytes())
+		})
+	}
+}
+
+// fullDecodeCSV is the csv output from a full decode of
+// CompressedSpeedDistance.fit. The fit package accumulates its distances in
+// a variable which carries on from one decode to the next, so it's only
+// right for the first one, and kept for the test to be run again.
+var fullDecodeCSV struct {
+	once sync.Once
+	csv  []byte
+	err  error
+}
+
+// streamRecords streams the records of data twice, as fit-dump -stream
+// does, calling fn with each one
+func streamRecords(t *testing.T, data []byte, fn func(*fit.RecordMsg)) {
+	t.Helper()
+
+	sr, err := fitstream.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		msg, err := sr.Next()
+		if err == io.EOF {
+			return
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if r, ok := msg.(*fit.RecordMsg); ok {
+			fn(r)
+		}
+	}
+}
+
+func TestStreamCompressedSpeedDistance(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "CompressedSpeedDistance.fit"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fullDecodeCSV.once.Do(func() {
+		var fitf *fit.File
+		fitf, fullDecodeCSV.err = fit.Decode(bytes.NewReader(data))
+		if fullDecodeCSV.err != nil {
+			return
+		}
+		var activity *fit.ActivityFile
+		activity, fullDecodeCSV.err = fitf.Activity()
+		if fullDecodeCSV.err != nil {
+			return
+		}
+		var buf bytes.Buffer
+		fullDecodeCSV.err = WriteRecords(&buf, activity.Records, ',', Options{})
+		fullDecodeCSV.csv = buf.Bytes()
+	})
+	if fullDecodeCSV.err != nil {
+		t.Fatal(fullDecodeCSV.err)
+	}
+
+	// Once for the columns, and again to write the rows, each from the
+	// start of the file whatever the first left behind
+	var columns RecordColumns
+	streamRecords(t, data, columns.Add)
+	var buf bytes.Buffer
+	rw := NewRecordWriter(&buf, ',', &columns, Options{})
+	streamRecords(t, data, func(r *fit.RecordMsg) {
+		if err := rw.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if err := rw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := strings.Split(buf.String(), "\n")
+	want := strings.Split(string(fullDecodeCSV.csv), "\n")
+	if len(got) != len(want) {
+		t.Fatalf("streamed %d lines, full decode %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("line %d: streamed %q, full decode %q", i+1, got[i], want[i])
+		}
+	}
+}
+
+func TestInfluxWriterGolden(t *testing.T) {
+	fitf := decodeTestFile(t, "Course.fit")
+	course, err := fitf.Course()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	iw := NewInfluxWriter(&buf, InfluxTags(fitf.FileId, course.Course.Sport))
+	for _, r := range course.Records {
+		if err := iw.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := iw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	checkGolden(t, "Course-records.influx", buf.Bytes())
+}
+
+func TestWriteGPXRouteGolden(t *testing.T) {
+	fitf := decodeTestFile(t, "Course.fit")
+	course, err := fitf.Course()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Course.fit has no course points, so add one part way along
+	r := course.Records[2]
+	cp := fit.NewCoursePointMsg()
+	cp.Timestamp, cp.Distance = r.Timestamp, r.Distance
+	cp.PositionLat, cp.PositionLong = r.PositionLat, r.PositionLong
+	cp.Type, cp.Name = fit.CoursePointLeft, "Turn <left>"
+	course.CoursePoints = append(course.CoursePoints, cp)
+
+	var buf bytes.Buffer
+	if err := WriteGPXRoute(&buf, course, true); err != nil {
+		t.Fatal(err)
+	}
+
+	checkGolden(t, "Course-route.gpx", buf.Bytes())
+}
+
+func TestXMLDumperGolden(t *testing.T) {
+	fitf := decodeTestFile(t, "Settings.fit")
+	settings, err := fitf.Settings()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	x := NewXMLDumper(&buf, Options{SnakeCase: true})
+	x.Dump(reflect.ValueOf(*fitf), "file")
+	x.Dump(reflect.ValueOf(*settings), "settings_file")
+	if err := x.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	checkGolden(t, "Settings.xml", buf.Bytes())
+}
+
+func TestReadPhysio(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "Physio.fit"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	p, err := ReadPhysio(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1000000000 in FIT time
+	start := int64(1631065600)
+
+	if len(p.Respiration) != 9 {
+		t.Errorf("got %d respiration samples, want 9", len(p.Respiration))
+	}
+	if _, ok := p.Respiration[start+3]; ok {
+		t.Errorf("invalid respiration sample wasn't excluded")
+	}
+	if got := p.Respiration[start+1]; got != 15.1 {
+		t.Errorf("respiration at +1s = %v, want 15.1", got)
+	}
+
+	want := map[int64]float64{start: 95, start + 8: 97}
+	if !reflect.DeepEqual(p.Spo2, want) {
+		t.Errorf("SpO2 = %v, want %v", p.Spo2, want)
+	}
+}
+
+func TestReadDevFields(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "DevFields.fit"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	d, err := ReadDevFields(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(d.Fields) != 1 {
+		t.Fatalf("got %d developer fields, want 1", len(d.Fields))
+	}
+	field := d.Fields[0]
+	if field.Name != "air_speed" || field.Units != "m/s" {
+		t.Errorf("field is %q in %q, want air_speed in m/s", field.Name, field.Units)
+	}
+
+	// 1000000000 in FIT time. The first two records come before the
+	// description, and the fifth is invalid.
+	start := int64(1631065600)
+	want := map[int64]float64{start + 2: 5.2, start + 3: 5.3, start + 5: 5.5}
+	if got := d.Samples[field]; !reflect.DeepEqual(got, want) {
+		t.Errorf("samples = %v, want %v", got, want)
+	}
+}
+
+func TestSmoothRecords(t *testing.T) {
+	start := time.Date(2020, 9, 13, 12, 0, 0, 0, time.UTC)
+	speeds := []uint16{1000, 1000, 4000, 1000, 0xffff, 1000}
+
+	var records []*fit.RecordMsg
+	for i, s := range speeds {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(i) * time.Second)
+		r.Speed = s
+		records = append(records, r)
+	}
+
+	smoothed := SmoothRecords(records, 3*time.Second, "Speed")
+
+	want := []uint16{1000, 2000, 2000, 2500, 0xffff, 1000}
+	for i, r := range smoothed {
+		if r.Speed != want[i] {
+			t.Errorf("record %d: speed %d, want %d", i, r.Speed, want[i])
+		}
+		if records[i].Speed != speeds[i] {
+			t.Errorf("record %d: original speed changed to %d", i, records[i].Speed)
+		}
+	}
+}
+
+func TestPrivacy(t *testing.T) {
+	z, err := ParsePrivacyZone("51.5, -0.1, 0.5km")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z != (PrivacyZone{51.5, -0.1, 500}) {
+		t.Errorf("parsed zone %+v", z)
+	}
+
+	p := Privacy{FuzzPosition: 100, Zones: []PrivacyZone{z}}
+
+	var records []*fit.RecordMsg
+	for _, lat := range []float64{51.5, 51.501, 51.51, 51.5104} {
+		r := fit.NewRecordMsg()
+		r.PositionLat = fit.NewLatitudeDegrees(lat)
+		r.PositionLong = fit.NewLongitudeDegrees(-0.1)
+		records = append(records, r)
+	}
+
+	records = p.DropZones(records)
+	if len(records) != 2 {
+		t.Fatalf("%d records left after dropping the zone, want 2", len(records))
+	}
+
+	if n := p.Positions(reflect.ValueOf(records)); n != 2 {
+		t.Errorf("moved %d positions, want 2", n)
+	}
+	// Both are within the same 100 m cell, so must end up in the same place
+	if records[0].PositionLat != records[1].PositionLat || records[0].PositionLong != records[1].PositionLong {
+		t.Errorf("nearby positions snapped to different points")
+	}
+
+	RecomputeDistance(records)
+	if records[1].Distance != 0 {
+		t.Errorf("distance between identical positions is %d", records[1].Distance)
+	}
+}
+
+func TestRecomputeDistanceWithoutPositions(t *testing.T) {
+	activity, err := decodeTestFile(t, "Physio.fit").Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := activity.Records
+	for i, r := range records {
+		r.Distance = uint32(i * 500)
+	}
+	RecomputeDistance(records)
+	for i, r := range records {
+		if r.Distance != uint32(i*500) {
+			t.Errorf("record %d: distance without a position changed to %d", i, r.Distance)
+		}
+	}
+}
+
+func TestPauses(t *testing.T) {
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	event := func(sec int, e fit.Event, typ fit.EventType, data uint32) *fit.EventMsg {
+		ev := fit.NewEventMsg()
+		ev.Timestamp = start.Add(time.Duration(sec) * time.Second)
+		ev.Event, ev.EventType, ev.Data = e, typ, data
+		return ev
+	}
+
+	events := []*fit.EventMsg{
+		event(0, fit.EventTimer, fit.EventTypeStart, uint32(fit.TimerTriggerManual)),
+		event(60, fit.EventTimer, fit.EventTypeStopAll, uint32(fit.TimerTriggerAuto)),
+		event(90, fit.EventTimer, fit.EventTypeStart, uint32(fit.TimerTriggerAuto)),
+		// The older encoding, with the activity event
+		event(120, fit.EventActivity, fit.EventTypeStop, 0),
+		event(120, fit.EventTimer, fit.EventTypeStop, uint32(fit.TimerTriggerManual)),
+		event(300, fit.EventActivity, fit.EventTypeStart, 0),
+		event(400, fit.EventTimer, fit.EventTypeStopAll, uint32(fit.TimerTriggerManual)),
+	}
+
+	want := []Pause{
+		{start.Add(60 * time.Second), 30 * time.Second, "auto"},
+		{start.Add(120 * time.Second), 3 * time.Minute, "manual"},
+	}
+	if got := Pauses(events); !reflect.DeepEqual(got, want) {
+		t.Errorf("Pauses() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLint(t *testing.T) {
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	now := start.Add(24 * time.Hour)
+
+	record := func(sec int, speed uint16, hr uint8, dist uint32) *fit.RecordMsg {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(sec) * time.Second)
+		r.Speed, r.HeartRate, r.Distance = speed, hr, dist
+		return r
+	}
+
+	session := fit.NewSessionMsg()
+	session.StartTime, session.Timestamp = start, start.Add(time.Minute)
+	session.TotalElapsedTime, session.TotalDistance = 60000, 10000
+	session.StartPositionLat, session.StartPositionLong = fit.NewLatitude(0), fit.NewLongitude(0)
+
+	lap := fit.NewLapMsg()
+	lap.StartTime, lap.Timestamp = start, start.Add(2*time.Minute)
+	lap.TotalElapsedTime, lap.TotalDistance = 120000, 10000
+
+	activity := fit.ActivityFile{
+		Sessions: []*fit.SessionMsg{session},
+		Laps:     []*fit.LapMsg{lap},
+		Records: []*fit.RecordMsg{
+			record(0, 5000, 120, 0),
+			record(2, 60000, 251, 1000),
+			record(1, 5000, 120, 500),
+			record(3*86400, 5000, 120, 0xFFFFFFFF),
+		},
+	}
+
+	var got []string
+	for _, f := range Lint(reflect.ValueOf(activity), now) {
+		got = append(got, f.Rule+" "+f.Path)
+	}
+	want := []string{
+		"time-range Records[3].Timestamp",
+		"speed Records[1].Speed",
+		"heart-rate Records[1].HeartRate",
+		"record-order Records[2].Timestamp",
+		"distance-order Records[2].Distance",
+		"lap-totals Laps[0].TotalElapsedTime",
+		"null-island Sessions[0].StartPositionLat",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lint() found %q, want %q", got, want)
+	}
+}
+
+func TestDumpSections(t *testing.T) {
+	fitf := decodeTestFile(t, "Activity.fit")
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	d := NewDumper(&buf, Options{MaxSlice: 2})
+	d.Dump(reflect.ValueOf(*fitf), "Activity.fit")
+	d.Dump(reflect.ValueOf(*activity), "ActivityFile")
+
+	out := buf.String()
+	lines := strings.Split(out, "\n")
+	var names []string
+	for _, s := range d.Sections() {
+		names = append(names, s.Name)
+		if !strings.HasPrefix(out[s.Offset:], "\t"+s.Name) {
+			t.Errorf("%s: byte %d doesn't start the section", s.Name, s.Offset)
+		}
+		if !strings.HasPrefix(lines[s.Line-1], "\t"+s.Name) {
+			t.Errorf("%s: line %d doesn't start the section", s.Name, s.Line)
+		}
+	}
+
+	want := []string{"FileId", "FileCreator", "Activity", "Sessions", "Laps", "Records", "Events"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("sections %q, want %q", names, want)
+	}
+}
+
+func TestDumpExclude(t *testing.T) {
+	fitf := decodeTestFile(t, "Activity.fit")
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	excl, err := ParseExclusion("records, file_id,Event")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	d := NewDumper(&buf, Options{MaxSlice: 2, Exclude: excl})
+	d.Dump(reflect.ValueOf(*fitf), "Activity.fit")
+	d.Dump(reflect.ValueOf(*activity), "ActivityFile")
+
+	var names []string
+	for _, s := range d.Sections() {
+		names = append(names, s.Name)
+	}
+	want := []string{"FileCreator", "Activity", "Sessions", "Laps"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("sections %q, want %q", names, want)
+	}
+
+	if _, err := ParseExclusion("record,"); err == nil {
+		t.Error("empty message name wasn't an error")
+	}
+}
+
+func TestFormatMissing(t *testing.T) {
+	for _, missing := range []string{"-", "n/a"} {
+		text, err := ParseMissing(missing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := Options{Missing: text}
+
+		for _, got := range []string{
+			opts.FormatFloat(math.NaN(), 1, "W"),
+			opts.FormatFloat(math.Inf(1), 1, ""),
+			Options{Missing: text, SpeedUnit: SpeedMinutesPerKilometer}.FormatSpeed(0),
+			Options{Missing: text, SpeedUnit: SpeedKilometersPerHour}.FormatSpeed(math.NaN()),
+		} {
+			if got != missing {
+				t.Errorf("got %q, want %q", got, missing)
+			}
+		}
+	}
+
+	if got := (Options{}).FormatFloat(math.NaN(), 1, "W"); got != "-" {
+		t.Errorf("got %q, want \"-\"", got)
+	}
+
+	if got := (Options{}).FormatFloat(1.26, 1, "W"); got != "1.3 W" {
+		t.Errorf("got %q, want \"1.3 W\"", got)
+	}
+
+	b, err := json.Marshal([]Float{1.5, Float(math.NaN()), Float(math.Inf(-1))})
+	if err != nil || string(b) != "[1.5,null,null]" {
+		t.Errorf("got %s, %v", b, err)
+	}
+
+	if _, err := ParseMissing("NaN"); err == nil {
+		t.Error("ParseMissing(\"NaN\") wasn't an error")
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	defer delete(formats, "dummy")
+
+	RegisterFormat("dummy", func(w io.Writer, fitf *fit.File) error {
+		_, err := fmt.Fprintf(w, "%v file from %v\n", fitf.Type(), fitf.FileId.Manufacturer)
+		return err
+	})
+
+	f, ok := LookupFormat("dummy")
+	if !ok {
+		t.Fatalf("dummy isn't registered, only %q", FormatNames())
+	}
+
+	var buf bytes.Buffer
+	if err := f(&buf, decodeTestFile(t, "Activity.fit")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "Activity file from Dynastream\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if _, ok := LookupFormat("nonexistent"); ok {
+		t.Error("found a format which wasn't registered")
+	}
+}
+
+func TestDumpPaths(t *testing.T) {
+	fitf := decodeTestFile(t, "Activity.fit")
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, snake := range []bool{false, true} {
+		var buf bytes.Buffer
+		d := NewDumper(&buf, Options{MaxSlice: 2, Paths: true, SnakeCase: snake})
+		d.Dump(reflect.ValueOf(*fitf), "Activity.fit")
+		d.Dump(reflect.ValueOf(*activity), "ActivityFile")
+		if !snake {
+			checkGolden(t, "Activity-paths", buf.Bytes())
+		}
+
+		// Every line's path should lead back to a value in the file or
+		// its body
+		for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+			s, value, ok := strings.Cut(line, ": ")
+			if !ok {
+				t.Errorf("line %q has no path", line)
+				continue
+			}
+			p, err := ParsePath(s)
+			if err != nil {
+				t.Errorf("line %q: %v", line, err)
+				continue
+			}
+			if p.String() != s {
+				t.Errorf("path %q formats as %q", s, p)
+			}
+			if strings.HasPrefix(value, "...") {
+				continue
+			}
+			if _, err := p.Lookup(reflect.ValueOf(fitf)); err == nil {
+				continue
+			}
+			if _, err := p.Lookup(reflect.ValueOf(activity)); err != nil {
+				t.Errorf("line %q: %v", line, err)
+			}
+		}
+	}
+
+	p, err := ParsePath("records[3].heart_rate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := p.Lookup(reflect.ValueOf(activity))
+	if err != nil || v.Interface() != activity.Records[3].HeartRate {
+		t.Errorf("Lookup(%v) = %v, %v, want %v", p, v, err, activity.Records[3].HeartRate)
+	}
+	if _, err := (Path{{Name: "Records"}, {Index: len(activity.Records)}}).Lookup(reflect.ValueOf(activity)); err == nil {
+		t.Error("index past the end wasn't an error")
+	}
+
+	for _, bad := range []string{"", "Records[", "Records[-1]", "Records[+1]", "Records[1]x", ".HeartRate", "Records..HeartRate", "Records[1].", "Heart Rate"} {
+		if _, err := ParsePath(bad); err == nil {
+			t.Errorf("ParsePath(%q) wasn't an error", bad)
+		}
+	}
+}
+
+func TestUnitsImperial(t *testing.T) {
+	rec := fit.NewRecordMsg()
+	rec.Temperature = 21
+	info, ok := FieldInfo("Record", "Temperature")
+	if !ok || !info.IsTemperature() {
+		t.Fatalf("Temperature isn't a temperature: %+v", info)
+	}
+	field := reflect.ValueOf(rec).Elem().FieldByName("Temperature")
+
+	if got := FormatCell(field, info, Options{}); got != "21" {
+		t.Errorf("metric: got %q, want \"21\"", got)
+	}
+	units, err := ParseUnits("imperial")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := FormatCell(field, info, Options{Units: units}); got != "69.8" {
+		t.Errorf("imperial: got %q, want \"69.8\"", got)
+	}
+
+	// The unit goes in the header, so the cells are numbers
+	var buf bytes.Buffer
+	if err := WriteRecords(&buf, []*fit.RecordMsg{rec}, ',', Options{Units: units}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "Temperature (F)\n69.8\n"; got != want {
+		t.Errorf("imperial csv: got %q, want %q", got, want)
+	}
+
+	if _, err := ParseUnits("kelvin"); err == nil {
+		t.Error("ParseUnits(\"kelvin\") wasn't an error")
+	}
+}
+
+func TestSpeedUnitFields(t *testing.T) {
+	rec := fit.NewRecordMsg()
+	rec.Speed = 2500
+	rec.VerticalSpeed = -500
+	opts := Options{SpeedUnit: SpeedMinutesPerKilometer}
+
+	for _, tc := range []struct {
+		field, want string
+	}{
+		{"Speed", "6:40"},
+		{"VerticalSpeed", "-0.500"},
+	} {
+		info, ok := FieldInfo("Record", tc.field)
+		if !ok {
+			t.Fatalf("no field info for %s", tc.field)
+		}
+		field := reflect.ValueOf(rec).Elem().FieldByName(tc.field)
+		if got := FormatCell(field, info, opts); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.field, got, tc.want)
+		}
+	}
+}
+
+func TestFormatCellBalance(t *testing.T) {
+	for _, tc := range []struct {
+		msg, field string
+		value      interface{}
+		want       string
+	}{
+		{"Record", "LeftRightBalance", fit.LeftRightBalance(0x80 | 52), "R 52"},
+		{"Record", "LeftRightBalance", fit.LeftRightBalance(48), "48"},
+		{"Session", "LeftRightBalance", fit.LeftRightBalance100(0x8000 | 5034), "R 50.34"},
+	} {
+		info, _ := FieldInfo(tc.msg, tc.field)
+		if got := FormatCell(reflect.ValueOf(tc.value), info, Options{}); got != tc.want {
+			t.Errorf("%s.%s %#x: got %q, want %q", tc.msg, tc.field, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestUnknownEnums(t *testing.T) {
+	activity := &fit.ActivityFile{}
+	for _, sport := range []fit.Sport{fit.Sport(200), fit.SportRunning, fit.Sport(200), fit.Sport(201)} {
+		s := fit.NewSessionMsg()
+		s.Sport = sport
+		activity.Sessions = append(activity.Sessions, s)
+	}
+
+	var got []string
+	for _, u := range UnknownEnums(reflect.ValueOf(*activity)) {
+		got = append(got, fmt.Sprintf("%v x%d", u, u.Count))
+	}
+	want := []string{"session.sport (Sport): 200 x2", "session.sport (Sport): 201 x1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// Only the Invalid constant is invalid, not every name ending in it
+	msg := fit.NewAviationAttitudeMsg()
+	msg.Validity = []fit.AttitudeValidity{fit.AttitudeValidityMagInvalid, fit.AttitudeValidityInvalid}
+	var buf bytes.Buffer
+	NewDumper(&buf, Options{Minimal: true}).Dump(reflect.ValueOf(*msg), "AviationAttitude")
+	if out := buf.String(); !strings.Contains(out, "[0]: MagInvalid") || strings.Contains(out, "[1]") {
+		t.Errorf("invalid values dumped wrongly:\n%s", out)
+	}
+}
+
+func TestSchemaCheck(t *testing.T) {
+	schema, err := ParseSchema(strings.NewReader(`
+# Comments and blank lines are ignored
+session.sport
+Session.TotalDistance
+record.heart_rate # never valid below
+hrv
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	activity := &fit.ActivityFile{}
+	s := fit.NewSessionMsg()
+	s.Sport = fit.SportRunning
+	s.TotalDistance = 100000
+	s.AvgHeartRate = 150
+	activity.Sessions = append(activity.Sessions, s)
+	activity.Records = append(activity.Records, fit.NewRecordMsg(), fit.NewRecordMsg())
+	activity.Laps = append(activity.Laps, fit.NewLapMsg())
+	activity.Laps[0].TotalDistance = 100000
+
+	var got []string
+	for _, p := range schema.Check(FieldsPresent(reflect.ValueOf(*activity))) {
+		got = append(got, p.String())
+	}
+	want := []string{
+		"record.heart_rate: invalid in all 2 messages",
+		"hrv: missing, no hrv messages",
+		"session.avg_heart_rate: unexpected, valid in 1 of 1 message",
+		"lap: unexpected, 1 message",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	for _, bad := range []string{"recrod", "record.hart_rate", "record.heart-rate"} {
+		if _, err := ParseSchema(strings.NewReader(bad)); err == nil {
+			t.Errorf("ParseSchema(%q) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	fitf := decodeTestFile(t, "Activity.fit")
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteHTML(&buf, "Activity.fit", fitf, reflect.ValueOf(*activity), Options{MaxSlice: 2}); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "Activity.html", buf.Bytes())
+}
+
+func TestShiftTimes(t *testing.T) {
+	fitf := decodeTestFile(t, "Activity.fit")
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gaps := make([]time.Duration, len(activity.Records))
+	for i, r := range activity.Records {
+		gaps[i] = r.Timestamp.Sub(activity.Records[0].Timestamp)
+	}
+
+	earliest, ok := EarliestTime(reflect.ValueOf(*activity))
+	if !ok {
+		t.Fatal("no times found")
+	}
+	target := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := ShiftTimes(reflect.ValueOf(*activity), target.Sub(earliest))
+	if n == 0 {
+		t.Fatal("no times moved")
+	}
+
+	if got, _ := EarliestTime(reflect.ValueOf(*activity)); !got.Equal(target) {
+		t.Errorf("earliest time is %v after shifting, want %v", got, target)
+	}
+	for i, r := range activity.Records {
+		if got := r.Timestamp.Sub(activity.Records[0].Timestamp); got != gaps[i] {
+			t.Errorf("record %d is %v after the first, want %v", i, got, gaps[i])
+		}
+	}
+
+	// Invalid times stay invalid
+	lap := fit.NewLapMsg()
+	ShiftTimes(reflect.ValueOf(lap), time.Hour)
+	if !fit.IsBaseTime(lap.StartTime) {
+		t.Errorf("invalid start time moved to %v", lap.StartTime)
+	}
+}
+
+func TestDerivedColumns(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	record := func(secs int, speed, alt, dist float64) *fit.RecordMsg {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(secs) * time.Second)
+		r.Speed = uint16(speed * 1000)
+		r.Altitude = uint16((alt + 500) * 5)
+		r.Distance = uint32(dist * 100)
+		return r
+	}
+	records := []*fit.RecordMsg{
+		record(0, 5, 100, 0),
+		record(1, 6, 101, 5),
+		// Stopped, then a second record at the same time
+		record(2, 0, 101, 5),
+		record(2, 0, 101, 5),
+	}
+
+	cols := DerivedColumns(records, []Derived{DeriveAccel, DeriveGrade, DeriveVAM}, 0)
+	want := [][]string{
+		{"", "", ""},
+		{"1.000", "20.0", "3600"},
+		{"-6.000", "", "0"},
+		{"", "", ""},
+	}
+	for i, r := range records {
+		for j, col := range cols {
+			if got := col.Value(r); got != want[i][j] {
+				t.Errorf("record %d: %s is '%s', want '%s'", i, col.Name, got, want[i][j])
+			}
+		}
+	}
+
+	if _, err := ParseDerived("accel,slope"); err == nil {
+		t.Error("unknown derived value accepted")
+	}
+}
+
+func TestDumpTail(t *testing.T) {
+	records := []int{10, 11, 12, 13, 14}
+	for _, tc := range []struct {
+		maxSlice, tail int
+		want           string
+	}{
+		{0, 2, "R (5 elems):\n\t... (3 more)\n\t[3]: 13\n\t[4]: 14\n"},
+		{1, 1, "R (5 elems):\n\t[0]: 10\n\t... (3 more)\n\t[4]: 14\n"},
+		{2, 3, "R (5 elems):\n\t[0]: 10\n\t[1]: 11\n\t[2]: 12\n\t[3]: 13\n\t[4]: 14\n"},
+	} {
+		var buf bytes.Buffer
+		NewDumper(&buf, Options{MaxSlice: tc.maxSlice, Tail: tc.tail}).Dump(reflect.ValueOf(records), "R")
+		if got := buf.String(); got != tc.want {
+			t.Errorf("-max-slice %d -tail %d: got\n%s\nwant\n%s", tc.maxSlice, tc.tail, got, tc.want)
+		}
+	}
+}
+
+func TestSelectSession(t *testing.T) {
+	start := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	at := func(secs int) time.Time {
+		return start.Add(time.Duration(secs) * time.Second)
+	}
+
+	var activity fit.ActivityFile
+	for i, secs := range []int{0, 100} {
+		s := fit.NewSessionMsg()
+		s.StartTime = at(secs)
+		s.Timestamp = at(secs + 99)
+		s.TotalElapsedTime = 99 * 1000
+		s.MessageIndex = fit.MessageIndex(i)
+		activity.Sessions = append(activity.Sessions, s)
+
+		lap := fit.NewLapMsg()
+		lap.StartTime = s.StartTime
+		activity.Laps = append(activity.Laps, lap)
+	}
+	for secs := 0; secs < 200; secs += 10 {
+		r := fit.NewRecordMsg()
+		r.Timestamp = at(secs)
+		activity.Records = append(activity.Records, r)
+	}
+
+	if err := SelectSession(&activity, 2); err != nil {
+		t.Fatal(err)
+	}
+	if len(activity.Sessions) != 1 || activity.Sessions[0].MessageIndex != 1 {
+		t.Errorf("got %d sessions, want only the second", len(activity.Sessions))
+	}
+	if len(activity.Laps) != 1 || !activity.Laps[0].StartTime.Equal(at(100)) {
+		t.Errorf("got %d laps, want only the second", len(activity.Laps))
+	}
+	if len(activity.Records) != 10 || !activity.Records[0].Timestamp.Equal(at(100)) {
+		t.Errorf("got %d records from %v, want 10 from %v", len(activity.Records), activity.Records[0].Timestamp, at(100))
+	}
+
+	if err := SelectSession(&activity, 2); err == nil {
+		t.Error("selected session 2 of 1")
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for _, c := range []struct{ name, want string }{
+		{"HeartRate", "heart_rate"},
+		{"FileId", "file_id"},
+		{"Cadence256", "cadence256"},
+		{"LeftRightBalance100", "left_right_balance_100"},
+		{"Speed1s", "speed_1s"},
+		{"Timestamp16", "timestamp_16"},
+		// Not in the profile, so guessed
+		{"CRC", "crc"},
+		{"FooBar2", "foo_bar2"},
+	} {
+		if got := SnakeCase(c.name); got != c.want {
+			t.Errorf("SnakeCase(%q) = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"reflect"
+	"strings"
+)
+
+// EnhancedPreference selects which of a pair of legacy and enhanced fields,
+// such as Speed and EnhancedSpeed, is dumped when a message has both. The
+// enhanced fields have a larger range, and newer devices fill in both.
+type EnhancedPreference int
+
+const (
+	// ShowBoth dumps both fields of each pair
+	ShowBoth EnhancedPreference = iota
+	// PreferEnhanced leaves out the legacy field if the enhanced one is
+	// valid
+	PreferEnhanced
+	// PreferLegacy leaves out the enhanced field if the legacy one is
+	// valid
+	PreferLegacy
+)
+
+// enhancedPrefix marks the enhanced field of a pair. The rest of its name
+// is the legacy field's.
+const enhancedPrefix = "Enhanced"
+
+// pairedField returns the other field of the legacy/enhanced pair which
+// the named field of msg belongs to, and whether it's the enhanced one
+func pairedField(msg reflect.Value, name string) (other string, enhanced, ok bool) {
+	if legacy := strings.TrimPrefix(name, enhancedPrefix); legacy != name {
+		_, ok := msg.Type().FieldByName(legacy)
+		return legacy, true, ok
+	}
+	_, ok = msg.Type().FieldByName(enhancedPrefix + name)
+	return enhancedPrefix + name, false, ok
+}
+
+// redundant reports whether the named field of msg should be left out in
+// favour of the other field of its pair, which holds a valid value
+func (p EnhancedPreference) redundant(msg reflect.Value, name string) bool {
+	if p == ShowBoth {
+		return false
+	}
+
+	other, enhanced, ok := pairedField(msg, name)
+	if !ok || enhanced == (p == PreferEnhanced) {
+		return false
+	}
+
+	info, ok := FieldInfo(msg.Type().Name(), other)
+	if !ok {
+		return false
+	}
+	return !info.IsInvalid(msg.FieldByName(other))
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// UnknownEnum is a value of an enum field which the fit package has no name
+// for, such as a sport or manufacturer newer than its profile
+type UnknownEnum struct {
+	// Message and Field are in the profile's snake_case form
+	Message, Field string
+	// Type is the fit package's type, such as Sport
+	Type  string
+	Value string
+	// Count is the number of messages holding the value
+	Count int
+}
+
+func (u UnknownEnum) String() string {
+	return fmt.Sprintf("%s.%s (%s): %s", u.Message, u.Field, u.Type, u.Value)
+}
+
+// uintEnums are the fit types which are enumerations, but whose base type
+// in the profile isn't enum. The others without one, such as MessageIndex
+// or AttitudeValidity, are numbers or bit flags, which don't have a name for
+// every value.
+var uintEnums = map[string]bool{
+	"BatteryStatus":    true,
+	"ExerciseCategory": true,
+	"FitBaseType":      true,
+	"FitBaseUnit":      true,
+	"Manufacturer":     true,
+	"MesgNum":          true,
+}
+
+// isEnum reports whether a field of type t, described by info, holds an
+// enumeration. ActivityClass is an enum in the profile, but holds a level
+// from 0 to 100 with a flag bit for athletes.
+func isEnum(t reflect.Type, info Info) bool {
+	if t.Name() == "ActivityClass" {
+		return false
+	}
+	return strings.TrimSuffix(info.BaseType, "[]") == "enum" || uintEnums[t.Name()]
+}
+
+// unknownValue returns the value of v, an enum, if it has no name. The fit
+// package prints those like "Sport(58)".
+func unknownValue(v reflect.Value) (string, bool) {
+	s, ok := v.Interface().(fmt.Stringer)
+	if !ok {
+		return "", false
+	}
+	str, prefix := s.String(), v.Type().Name()+"("
+	if !strings.HasPrefix(str, prefix) || !strings.HasSuffix(str, ")") {
+		return "", false
+	}
+	return str[len(prefix) : len(str)-1], true
+}
+
+// UnknownEnums returns the enum values in the messages of val, a fit.File or
+// a file body such as fit.ActivityFile, which the fit package has no name
+// for, in the order they're first found. Each value is listed once per
+// field, with the number of messages holding it.
+func UnknownEnums(val reflect.Value) []UnknownEnum {
+	var unknown []UnknownEnum
+	index := make(map[UnknownEnum]int)
+
+	add := func(msg reflect.Value, field string, v reflect.Value) {
+		value, ok := unknownValue(v)
+		if !ok {
+			return
+		}
+		key := UnknownEnum{
+			Message: SnakeCase(strings.TrimSuffix(msg.Type().Name(), "Msg")),
+			Field:   SnakeCase(field),
+			Type:    v.Type().Name(),
+			Value:   value,
+		}
+		if i, ok := index[key]; ok {
+			unknown[i].Count++
+			return
+		}
+		index[key] = len(unknown)
+		key.Count = 1
+		unknown = append(unknown, key)
+	}
+
+	walkMessages(val, func(path string, msg reflect.Value) {
+		for i := 0; i < msg.NumField(); i++ {
+			name := msg.Type().Field(i).Name
+			info, ok := FieldInfo(msg.Type().Name(), name)
+			if !exported(name) || !ok {
+				continue
+			}
+
+			field := msg.Field(i)
+			if field.Kind() == reflect.Slice {
+				if !isEnum(field.Type().Elem(), info) {
+					continue
+				}
+				for j := 0; j < field.Len(); j++ {
+					add(msg, name, field.Index(j))
+				}
+			} else if isEnum(field.Type(), info) {
+				add(msg, name, field)
+			}
+		}
+	})
+
+	return unknown
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// GearChange is the gear combination carried in the data field of
+// front_gear_change and rear_gear_change events. Gear numbers count from 1
+// for the innermost gear, and the tooth counts are the size of that gear.
+type GearChange struct {
+	FrontGearNum uint8
+	FrontGear    uint8
+	RearGearNum  uint8
+	RearGear     uint8
+}
+
+// DecodeGearChange unpacks the gear_change_data components from an event's
+// Data field.
+func DecodeGearChange(data uint32) GearChange {
+	return GearChange{
+		RearGearNum:  uint8(data),
+		RearGear:     uint8(data >> 8),
+		FrontGearNum: uint8(data >> 16),
+		FrontGear:    uint8(data >> 24),
+	}
+}
+
+// Ratio returns the gear ratio, front teeth over rear teeth, or 0 if either
+// tooth count is unknown.
+func (g GearChange) Ratio() float64 {
+	if g.FrontGear == 0 || g.RearGear == 0 {
+		return 0
+	}
+	return float64(g.FrontGear) / float64(g.RearGear)
+}
+
+func (g GearChange) String() string {
+	return fmt.Sprintf("%dx%d", g.FrontGear, g.RearGear)
+}
+
+// DescribeEvent returns a readable form of an event's data field, whose
+// meaning depends on the event: e.g. "manual" for a timer event's trigger,
+// "175 bpm" for hr_high_alert or "50x25" for a gear change. It's the raw
+// number for events whose data isn't known, and "" if there's no data.
+// opts.SpeedUnit and opts.Missing are used for speed alerts and the virtual
+// partner pace.
+func DescribeEvent(ev *fit.EventMsg, opts Options) string {
+	if !FieldValid(ev, "Data") {
+		return ""
+	}
+
+	speed := func() string {
+		mps := float64(ev.Data) / 1000
+		if opts.SpeedUnit == SpeedRaw {
+			return fmt.Sprintf("%.3f m/s", mps)
+		}
+		return opts.FormatSpeed(mps)
+	}
+
+	switch ev.Event {
+	case fit.EventTimer:
+		return SnakeCase(fit.TimerTrigger(ev.Data).String())
+	case fit.EventCoursePoint:
+		return fmt.Sprintf("course point %d", ev.Data)
+	case fit.EventBattery:
+		return fmt.Sprintf("%.3f V", float64(ev.Data)/1000)
+	case fit.EventVirtualPartnerPace, fit.EventSpeedHighAlert, fit.EventSpeedLowAlert:
+		return speed()
+	case fit.EventHrHighAlert, fit.EventHrLowAlert:
+		return fmt.Sprintf("%d bpm", ev.Data)
+	case fit.EventCadHighAlert, fit.EventCadLowAlert:
+		return fmt.Sprintf("%d rpm", ev.Data)
+	case fit.EventPowerHighAlert, fit.EventPowerLowAlert:
+		return fmt.Sprintf("%d W", ev.Data)
+	case fit.EventElevHighAlert, fit.EventElevLowAlert:
+		return fmt.Sprintf("%.1f m", float64(ev.Data)/5-500)
+	case fit.EventTimeDurationAlert:
+		return (time.Duration(ev.Data) * time.Millisecond).String()
+	case fit.EventDistanceDurationAlert:
+		return fmt.Sprintf("%.2f m", float64(ev.Data)/100)
+	case fit.EventCalorieDurationAlert:
+		return fmt.Sprintf("%d kcal", ev.Data)
+	case fit.EventFitnessEquipment:
+		return SnakeCase(fit.FitnessEquipmentState(ev.Data).String())
+	case fit.EventSportPoint:
+		return fmt.Sprintf("%d-%d", ev.Data&0xffff, ev.Data>>16)
+	case fit.EventFrontGearChange, fit.EventRearGearChange:
+		return DecodeGearChange(ev.Data).String()
+	case fit.EventRiderPositionChange:
+		return SnakeCase(fit.RiderPositionType(ev.Data).String())
+	case fit.EventCommTimeout:
+		return SnakeCase(fit.CommTimeoutType(ev.Data).String())
+	}
+
+	return fmt.Sprint(ev.Data)
+}
+
+// Pause is a stretch of time with the timer stopped
+type Pause struct {
+	Start    time.Time
+	Duration time.Duration
+	// Trigger is what stopped the timer, such as "manual" or "auto", if
+	// the event says
+	Trigger string
+}
+
+// timerEvent reports whether an event starts or stops the timer. Older
+// files use the activity event rather than the timer one, and the
+// deprecated begin/end event types.
+func timerEvent(ev *fit.EventMsg) (stop, ok bool) {
+	if ev.Event != fit.EventTimer && ev.Event != fit.EventActivity {
+		return false, false
+	}
+
+	switch ev.EventType {
+	case fit.EventTypeStart, fit.EventTypeBeginDepreciated:
+		return false, true
+	case fit.EventTypeStop, fit.EventTypeStopAll, fit.EventTypeStopDisable,
+		fit.EventTypeStopDisableAll, fit.EventTypeEndDepreciated, fit.EventTypeEndAllDepreciated:
+		return true, true
+	}
+
+	return false, false
+}
+
+// Pauses returns the pauses in a list of events, from each stop of the timer
+// to the start which follows it. Repeated stops or starts, which some
+// devices write for both the timer and the activity, are merged. A stop
+// with no start after it is the end of the activity, not a pause.
+func Pauses(events []*fit.EventMsg) []Pause {
+	sorted := make([]*fit.EventMsg, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	var pauses []Pause
+	var stopped *Pause
+	for _, ev := range sorted {
+		stop, ok := timerEvent(ev)
+		if !ok {
+			continue
+		}
+
+		if stop {
+			if stopped == nil {
+				stopped = &Pause{Start: ev.Timestamp}
+			}
+			if stopped.Trigger == "" && ev.Event == fit.EventTimer {
+				stopped.Trigger = DescribeEvent(ev, Options{})
+			}
+			continue
+		}
+
+		if stopped != nil {
+			stopped.Duration = ev.Timestamp.Sub(stopped.Start)
+			pauses = append(pauses, *stopped)
+			stopped = nil
+		}
+	}
+
+	return pauses
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"io"
+
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// Extras collects what the fit package doesn't decode from the raw messages
+// of a file: the respiration rate and SpO2, and the developer fields of the
+// records. It's fed one message at a time, so that it can be filled in by
+// a pass over the file which is being made anyway, rather than reading the
+// file again.
+type Extras struct {
+	Physio    *Physio
+	DevFields *DevFields
+}
+
+// NewExtras returns an empty Extras, ready for Add
+func NewExtras() *Extras {
+	return &Extras{
+		Physio:    newPhysio(),
+		DevFields: newDevFields(),
+	}
+}
+
+// Add collects from raw, which must be the next data message of the file
+func (x *Extras) Add(raw *fitstream.RawMessage) {
+	x.Physio.add(raw)
+	x.DevFields.add(raw)
+}
+
+// ReadExtras reads the extras from the FIT file in r, without decoding its
+// messages
+func ReadExtras(r io.Reader) (*Extras, error) {
+	sr, err := fitstream.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	x := NewExtras()
+	for {
+		raw, err := sr.NextRaw()
+		if err == io.EOF {
+			return x, nil
+		} else if err != nil {
+			return nil, err
+		}
+		x.Add(raw)
+	}
+}
+
+// Columns returns the record columns for the extras with any samples. A nil
+// *Extras has none.
+func (x *Extras) Columns() []ExtraColumn {
+	if x == nil {
+		return nil
+	}
+	return append(x.Physio.Columns(), x.DevFields.Columns()...)
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// Package fitdump provides helpers for walking and presenting decoded FIT
+// files.
+package fitdump
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+//go:generate go run gen_fieldinfo.go
+
+// Info describes how the raw value of a message field should be interpreted.
+// The physical value is Raw / Scale - Offset, in Unit.
+type Info struct {
+	// Num is the field number in the FIT profile, and BaseType the name of
+	// its base type there, such as "uint16" or "uint8[]" for an array
+	Num      int
+	BaseType string
+
+	Unit   string
+	Scale  float64
+	Offset float64
+
+	// Invalid is the sentinel value used to indicate the field is not
+	// set. It is nil for fields which determine their own validity,
+	// like arrays, times and positions.
+	Invalid interface{}
+
+	// speed is set for the horizontal speeds, which Options.SpeedUnit
+	// applies to
+	speed bool
+}
+
+// FieldInfo returns the profile information for the given field of the given
+// message. msg is the message name, as used by the fit package, with or
+// without the "Msg" suffix - e.g. "Record" or "RecordMsg".
+func FieldInfo(msg, field string) (Info, bool) {
+	fields, ok := fieldInfos[strings.TrimSuffix(msg, "Msg")]
+	if !ok {
+		return Info{}, false
+	}
+	info, ok := fields[field]
+	info.speed = speedFields[strings.TrimSuffix(msg, "Msg")][field]
+	return info, ok
+}
+
+// FieldValid reports whether field of msg holds a value rather than the
+// field's invalid sentinel. msg is one of the fit package's messages, or a
+// pointer to one.
+func FieldValid(msg interface{}, field string) bool {
+	val := reflect.Indirect(reflect.ValueOf(msg))
+	v := val.FieldByName(field)
+	if info, ok := FieldInfo(val.Type().Name(), field); ok {
+		return !info.IsInvalid(v)
+	}
+	return !IsInvalid(v)
+}
+
+// FieldName returns the name of the field of msg with profile field number
+// num, or false if the fit package doesn't know it. msg is named as for
+// FieldInfo.
+func FieldName(msg string, num int) (string, bool) {
+	for name, info := range fieldInfos[strings.TrimSuffix(msg, "Msg")] {
+		if info.Num == num {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Components returns the fields which the given field of msg is expanded
+// into when it's decoded, such as the gear numbers packed into an Event's
+// Data. Most fields have none.
+func Components(msg, field string) []string {
+	return fieldComponents[strings.TrimSuffix(msg, "Msg")][field]
+}
+
+// Apply converts a raw field value to its physical value by applying the
+// scale and offset.
+func (i Info) Apply(raw float64) float64 {
+	return raw/i.Scale - i.Offset
+}
+
+// Value returns the physical value of a numeric field, with the scale and
+// offset applied. ok is false if v isn't numeric.
+func (i Info) Value(v reflect.Value) (value float64, ok bool) {
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return i.Apply(float64(v.Int())), true
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return i.Apply(float64(v.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		return i.Apply(v.Float()), true
+	}
+
+	return 0, false
+}
+
+// IsInvalid reports whether v holds the invalid value for the field.
+func (i Info) IsInvalid(v reflect.Value) bool {
+	if v.CanInterface() {
+		switch x := v.Interface().(type) {
+		case time.Time:
+			return fit.IsBaseTime(x)
+		case interface{ Invalid() bool }:
+			return x.Invalid()
+		}
+	}
+
+	if i.Invalid == nil {
+		return IsInvalid(v)
+	}
+
+	inv := reflect.ValueOf(i.Invalid)
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool() == inv.Bool()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == inv.Int()
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == inv.Uint()
+	case reflect.Float32, reflect.Float64:
+		// The invalid value is a NaN, so can't be compared directly
+		return math.IsNaN(v.Float())
+	case reflect.String:
+		return v.String() == inv.String()
+	}
+
+	return false
+}
+
+// IsInvalid reports whether v holds the invalid value for its kind. This is
+// a best-effort fallback for values without profile information, as it can't
+// tell the 'z' variants of the base types apart from the others.
+func IsInvalid(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool() == false
+	case reflect.Int8:
+		return v.Int() == 0x7f
+	case reflect.Int16:
+		return v.Int() == 0x7fff
+	case reflect.Int32:
+		return v.Int() == 0x7fffffff
+	case reflect.Int64:
+		return v.Int() == 0x7fffffffffffffff
+	case reflect.Uint8:
+		return v.Uint() == 0xff
+	case reflect.Uint16:
+		return v.Uint() == 0xffff
+	case reflect.Uint32:
+		return v.Uint() == 0xffffffff
+	case reflect.Uint64:
+		return v.Uint() == 0xffffffffffffffff
+	case reflect.Float32, reflect.Float64:
+		return math.IsNaN(v.Float())
+	case reflect.String:
+		return v.String() == ""
+	case reflect.Slice:
+		return v.Len() == 0
+	}
+
+	return false
+}
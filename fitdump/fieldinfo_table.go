@@ -0,0 +1,4823 @@
+// Code generated by gen_fieldinfo.go from fit@v0.14.0. DO NOT EDIT.
+
+package fitdump
+
+var fieldInfos = map[string]map[string]Info{
+	"AccelerometerData": {},
+	"Activity": {
+		"Timestamp":      {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TotalTimerTime": {Num: 0, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"NumSessions":    {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Type":           {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Event":          {Num: 3, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventType":      {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"LocalTimestamp": {Num: 5, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"EventGroup":     {Num: 6, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"AntChannelId": {},
+	"AntRx": {
+		"Timestamp":           {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"FractionalTimestamp": {Num: 0, BaseType: "uint16", Unit: "s", Scale: 32768, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MesgId":              {Num: 1, BaseType: "byte", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MesgData":            {Num: 2, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ChannelNumber":       {Num: 3, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Data":                {Num: 4, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"AntTx": {
+		"Timestamp":           {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"FractionalTimestamp": {Num: 0, BaseType: "uint16", Unit: "s", Scale: 32768, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MesgId":              {Num: 1, BaseType: "byte", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MesgData":            {Num: 2, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ChannelNumber":       {Num: 3, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Data":                {Num: 4, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"AviationAttitude": {
+		"Timestamp":             {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TimestampMs":           {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SystemTime":            {Num: 1, BaseType: "uint32[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Pitch":                 {Num: 2, BaseType: "sint16[]", Unit: "radians", Scale: 10430.38, Offset: 0, Invalid: nil},
+		"Roll":                  {Num: 3, BaseType: "sint16[]", Unit: "radians", Scale: 10430.38, Offset: 0, Invalid: nil},
+		"AccelLateral":          {Num: 4, BaseType: "sint16[]", Unit: "m/s^2", Scale: 100, Offset: 0, Invalid: nil},
+		"AccelNormal":           {Num: 5, BaseType: "sint16[]", Unit: "m/s^2", Scale: 100, Offset: 0, Invalid: nil},
+		"TurnRate":              {Num: 6, BaseType: "sint16[]", Unit: "radians/second", Scale: 1024, Offset: 0, Invalid: nil},
+		"Stage":                 {Num: 7, BaseType: "enum[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"AttitudeStageComplete": {Num: 8, BaseType: "uint8[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Track":                 {Num: 9, BaseType: "uint16[]", Unit: "radians", Scale: 10430.38, Offset: 0, Invalid: nil},
+		"Validity":              {Num: 10, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"BarometerData": {},
+	"BikeProfile": {
+		"MessageIndex":             {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Name":                     {Num: 0, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Sport":                    {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SubSport":                 {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Odometer":                 {Num: 3, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"BikeSpdAntId":             {Num: 4, BaseType: "uint16z", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0x0000)},
+		"BikeCadAntId":             {Num: 5, BaseType: "uint16z", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0x0000)},
+		"BikeSpdcadAntId":          {Num: 6, BaseType: "uint16z", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0x0000)},
+		"BikePowerAntId":           {Num: 7, BaseType: "uint16z", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0x0000)},
+		"CustomWheelsize":          {Num: 8, BaseType: "uint16", Unit: "m", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AutoWheelsize":            {Num: 9, BaseType: "uint16", Unit: "m", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"BikeWeight":               {Num: 10, BaseType: "uint16", Unit: "kg", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PowerCalFactor":           {Num: 11, BaseType: "uint16", Unit: "%", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AutoWheelCal":             {Num: 12, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AutoPowerZero":            {Num: 13, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Id":                       {Num: 14, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SpdEnabled":               {Num: 15, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"CadEnabled":               {Num: 16, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SpdcadEnabled":            {Num: 17, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"PowerEnabled":             {Num: 18, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"CrankLength":              {Num: 19, BaseType: "uint8", Unit: "mm", Scale: 2, Offset: -110, Invalid: uint8(0xFF)},
+		"Enabled":                  {Num: 20, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"BikeSpdAntIdTransType":    {Num: 21, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"BikeCadAntIdTransType":    {Num: 22, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"BikeSpdcadAntIdTransType": {Num: 23, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"BikePowerAntIdTransType":  {Num: 24, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"OdometerRollover":         {Num: 37, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FrontGearNum":             {Num: 38, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"FrontGear":                {Num: 39, BaseType: "uint8z[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"RearGearNum":              {Num: 40, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"RearGear":                 {Num: 41, BaseType: "uint8z[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ShimanoDi2Enabled":        {Num: 44, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"BloodPressure": {
+		"Timestamp":            {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"SystolicPressure":     {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"DiastolicPressure":    {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MeanArterialPressure": {Num: 2, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Map3SampleMean":       {Num: 3, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MapMorningValues":     {Num: 4, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MapEveningValues":     {Num: 5, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HeartRate":            {Num: 6, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"HeartRateType":        {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Status":               {Num: 8, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"UserProfileIndex":     {Num: 9, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"CadenceZone": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HighValue":    {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Name":         {Num: 1, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"CameraEvent": {},
+	"Capabilities": {
+		"Languages":             {Num: 0, BaseType: "uint8z[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Sports":                {Num: 1, BaseType: "uint8z[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"WorkoutsSupported":     {Num: 21, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+		"ConnectivitySupported": {Num: 23, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+	},
+	"ClimbPro": {},
+	"Connectivity": {
+		"BluetoothEnabled":            {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"BluetoothLeEnabled":          {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AntEnabled":                  {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Name":                        {Num: 3, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"LiveTrackingEnabled":         {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"WeatherConditionsEnabled":    {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"WeatherAlertsEnabled":        {Num: 6, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AutoActivityUploadEnabled":   {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"CourseDownloadEnabled":       {Num: 8, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"WorkoutDownloadEnabled":      {Num: 9, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"GpsEphemerisDownloadEnabled": {Num: 10, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"IncidentDetectionEnabled":    {Num: 11, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"GrouptrackEnabled":           {Num: 12, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"Course": {
+		"Sport":        {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Name":         {Num: 5, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Capabilities": {Num: 6, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+		"SubSport":     {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"CoursePoint": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Timestamp":    {Num: 1, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"PositionLat":  {Num: 2, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"PositionLong": {Num: 3, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Distance":     {Num: 4, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Type":         {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Name":         {Num: 6, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Favorite":     {Num: 8, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"DeveloperDataId": {
+		"DeveloperId":        {Num: 0, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ApplicationId":      {Num: 1, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ManufacturerId":     {Num: 2, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"DeveloperDataIndex": {Num: 3, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ApplicationVersion": {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+	},
+	"DeviceAuxBatteryInfo": {
+		"Timestamp":         {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"DeviceIndex":       {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"BatteryVoltage":    {Num: 1, BaseType: "uint16", Unit: "V", Scale: 256, Offset: 0, Invalid: uint16(0xFFFF)},
+		"BatteryStatus":     {Num: 2, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"BatteryIdentifier": {Num: 3, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"DeviceInfo": {
+		"Timestamp":           {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"DeviceIndex":         {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DeviceType":          {Num: 1, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Manufacturer":        {Num: 2, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SerialNumber":        {Num: 3, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+		"Product":             {Num: 4, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SoftwareVersion":     {Num: 5, BaseType: "uint16", Unit: "", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HardwareVersion":     {Num: 6, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"CumOperatingTime":    {Num: 7, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"BatteryVoltage":      {Num: 10, BaseType: "uint16", Unit: "V", Scale: 256, Offset: 0, Invalid: uint16(0xFFFF)},
+		"BatteryStatus":       {Num: 11, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SensorPosition":      {Num: 18, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Descriptor":          {Num: 19, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"AntTransmissionType": {Num: 20, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"AntDeviceNumber":     {Num: 21, BaseType: "uint16z", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0x0000)},
+		"AntNetwork":          {Num: 22, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SourceType":          {Num: 25, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ProductName":         {Num: 27, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"DeviceSettings": {
+		"ActiveTimeZone":         {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"UtcOffset":              {Num: 1, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TimeOffset":             {Num: 2, BaseType: "uint32[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TimeMode":               {Num: 4, BaseType: "enum[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TimeZoneOffset":         {Num: 5, BaseType: "sint8[]", Unit: "hr", Scale: 4, Offset: 0, Invalid: nil},
+		"BacklightMode":          {Num: 12, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ActivityTrackerEnabled": {Num: 36, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ClockTime":              {Num: 39, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"PagesEnabled":           {Num: 40, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"MoveAlertEnabled":       {Num: 46, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DateMode":               {Num: 47, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DisplayOrientation":     {Num: 55, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MountingSide":           {Num: 56, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DefaultPage":            {Num: 57, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"AutosyncMinSteps":       {Num: 58, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AutosyncMinTime":        {Num: 59, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TapSensitivity":         {Num: 174, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"DiveAlarm": {},
+	"DiveGas":   {},
+	"DiveSettings": {
+		"Name":                {Num: 0, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"HeartRateSourceType": {Num: 19, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"HeartRateSource":     {Num: 20, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"DiveSummary": {},
+	"Event": {
+		"Timestamp":           {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Event":               {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventType":           {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Data16":              {Num: 2, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Data":                {Num: 3, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"EventGroup":          {Num: 4, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Score":               {Num: 7, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"OpponentScore":       {Num: 8, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FrontGearNum":        {Num: 9, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"FrontGear":           {Num: 10, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"RearGearNum":         {Num: 11, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"RearGear":            {Num: 12, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"RadarThreatLevelMax": {Num: 21, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"RadarThreatCount":    {Num: 22, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"ExdDataConceptConfiguration": {
+		"ScreenIndex":  {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ConceptField": {Num: 1, BaseType: "byte", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FieldId":      {Num: 2, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ConceptIndex": {Num: 3, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DataPage":     {Num: 4, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ConceptKey":   {Num: 5, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Scaling":      {Num: 6, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DataUnits":    {Num: 8, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Qualifier":    {Num: 9, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Descriptor":   {Num: 10, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"IsSigned":     {Num: 11, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"ExdDataFieldConfiguration": {
+		"ScreenIndex":  {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ConceptField": {Num: 1, BaseType: "byte", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FieldId":      {Num: 2, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ConceptCount": {Num: 3, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DisplayType":  {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Title":        {Num: 5, BaseType: "string[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"ExdScreenConfiguration": {
+		"ScreenIndex":   {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FieldCount":    {Num: 1, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Layout":        {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ScreenEnabled": {Num: 3, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"ExerciseTitle": {
+		"MessageIndex":     {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"ExerciseCategory": {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"ExerciseName":     {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"WktStepName":      {Num: 2, BaseType: "string[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"FieldCapabilities": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"File":         {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MesgNum":      {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FieldNum":     {Num: 2, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Count":        {Num: 3, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"FieldDescription": {
+		"DeveloperDataIndex":    {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FieldDefinitionNumber": {Num: 1, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FitBaseTypeId":         {Num: 2, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FieldName":             {Num: 3, BaseType: "string[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Scale":                 {Num: 6, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Offset":                {Num: 7, BaseType: "sint8", Unit: "", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"Units":                 {Num: 8, BaseType: "string[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"FitBaseUnitId":         {Num: 13, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"NativeMesgNum":         {Num: 14, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"NativeFieldNum":        {Num: 15, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"FileCapabilities": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Type":         {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Flags":        {Num: 1, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"Directory":    {Num: 2, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"MaxCount":     {Num: 3, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MaxSize":      {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+	},
+	"FileCreator": {
+		"SoftwareVersion": {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HardwareVersion": {Num: 1, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"FileId": {
+		"Type":         {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Manufacturer": {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Product":      {Num: 2, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SerialNumber": {Num: 3, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+		"TimeCreated":  {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Number":       {Num: 5, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"ProductName":  {Num: 8, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"Goal": {
+		"MessageIndex":    {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Sport":           {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SubSport":        {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"StartDate":       {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"EndDate":         {Num: 3, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Type":            {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Value":           {Num: 5, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Repeat":          {Num: 6, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TargetValue":     {Num: 7, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Recurrence":      {Num: 8, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"RecurrenceValue": {Num: 9, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Enabled":         {Num: 10, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Source":          {Num: 11, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"GpsMetadata":   {},
+	"GyroscopeData": {},
+	"Hr": {
+		"Timestamp":           {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"FractionalTimestamp": {Num: 0, BaseType: "uint16", Unit: "s", Scale: 32768, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Time256":             {Num: 1, BaseType: "uint8", Unit: "s", Scale: 256, Offset: 0, Invalid: uint8(0xFF)},
+		"FilteredBpm":         {Num: 6, BaseType: "uint8[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"EventTimestamp":      {Num: 9, BaseType: "uint32[]", Unit: "s", Scale: 1024, Offset: 0, Invalid: nil},
+		"EventTimestamp12":    {Num: 10, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"HrZone": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HighBpm":      {Num: 1, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Name":         {Num: 2, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"HrmProfile": {
+		"MessageIndex":      {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Enabled":           {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"HrmAntId":          {Num: 1, BaseType: "uint16z", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0x0000)},
+		"LogHrv":            {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"HrmAntIdTransType": {Num: 3, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+	},
+	"Hrv": {
+		"Time": {Num: 0, BaseType: "uint16[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+	},
+	"Jump": {},
+	"Lap": {
+		"MessageIndex":                  {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Timestamp":                     {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Event":                         {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventType":                     {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"StartTime":                     {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"StartPositionLat":              {Num: 3, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"StartPositionLong":             {Num: 4, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"EndPositionLat":                {Num: 5, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"EndPositionLong":               {Num: 6, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TotalElapsedTime":              {Num: 7, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalTimerTime":                {Num: 8, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalDistance":                 {Num: 9, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalCycles":                   {Num: 10, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalCalories":                 {Num: 11, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalFatCalories":              {Num: 12, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgSpeed":                      {Num: 13, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MaxSpeed":                      {Num: 14, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgHeartRate":                  {Num: 15, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxHeartRate":                  {Num: 16, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgCadence":                    {Num: 17, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxCadence":                    {Num: 18, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgPower":                      {Num: 19, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MaxPower":                      {Num: 20, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalAscent":                   {Num: 21, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalDescent":                  {Num: 22, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Intensity":                     {Num: 23, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"LapTrigger":                    {Num: 24, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Sport":                         {Num: 25, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventGroup":                    {Num: 26, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"NumLengths":                    {Num: 32, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"NormalizedPower":               {Num: 33, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"LeftRightBalance":              {Num: 34, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FirstLengthIndex":              {Num: 35, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgStrokeDistance":             {Num: 37, BaseType: "uint16", Unit: "m", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SwimStroke":                    {Num: 38, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SubSport":                      {Num: 39, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"NumActiveLengths":              {Num: 40, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalWork":                     {Num: 41, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"AvgAltitude":                   {Num: 42, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"MaxAltitude":                   {Num: 43, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"GpsAccuracy":                   {Num: 44, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgGrade":                      {Num: 45, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgPosGrade":                   {Num: 46, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgNegGrade":                   {Num: 47, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxPosGrade":                   {Num: 48, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxNegGrade":                   {Num: 49, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgTemperature":                {Num: 50, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"MaxTemperature":                {Num: 51, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"TotalMovingTime":               {Num: 52, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"AvgPosVerticalSpeed":           {Num: 53, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgNegVerticalSpeed":           {Num: 54, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxPosVerticalSpeed":           {Num: 55, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxNegVerticalSpeed":           {Num: 56, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"TimeInHrZone":                  {Num: 57, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInSpeedZone":               {Num: 58, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInCadenceZone":             {Num: 59, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInPowerZone":               {Num: 60, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"RepetitionNum":                 {Num: 61, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MinAltitude":                   {Num: 62, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"MinHeartRate":                  {Num: 63, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"WktStepIndex":                  {Num: 71, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"OpponentScore":                 {Num: 74, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"StrokeCount":                   {Num: 75, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ZoneCount":                     {Num: 76, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"AvgVerticalOscillation":        {Num: 77, BaseType: "uint16", Unit: "mm", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgStanceTimePercent":          {Num: 78, BaseType: "uint16", Unit: "percent", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgStanceTime":                 {Num: 79, BaseType: "uint16", Unit: "ms", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgFractionalCadence":          {Num: 80, BaseType: "uint8", Unit: "rpm", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxFractionalCadence":          {Num: 81, BaseType: "uint8", Unit: "rpm", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalFractionalCycles":         {Num: 82, BaseType: "uint8", Unit: "cycles", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"PlayerScore":                   {Num: 83, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgTotalHemoglobinConc":        {Num: 84, BaseType: "uint16[]", Unit: "g/dL", Scale: 100, Offset: 0, Invalid: nil},
+		"MinTotalHemoglobinConc":        {Num: 85, BaseType: "uint16[]", Unit: "g/dL", Scale: 100, Offset: 0, Invalid: nil},
+		"MaxTotalHemoglobinConc":        {Num: 86, BaseType: "uint16[]", Unit: "g/dL", Scale: 100, Offset: 0, Invalid: nil},
+		"AvgSaturatedHemoglobinPercent": {Num: 87, BaseType: "uint16[]", Unit: "%", Scale: 10, Offset: 0, Invalid: nil},
+		"MinSaturatedHemoglobinPercent": {Num: 88, BaseType: "uint16[]", Unit: "%", Scale: 10, Offset: 0, Invalid: nil},
+		"MaxSaturatedHemoglobinPercent": {Num: 89, BaseType: "uint16[]", Unit: "%", Scale: 10, Offset: 0, Invalid: nil},
+		"EnhancedAvgSpeed":              {Num: 110, BaseType: "uint32", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedMaxSpeed":              {Num: 111, BaseType: "uint32", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedAvgAltitude":           {Num: 112, BaseType: "uint32", Unit: "m", Scale: 5, Offset: 500, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedMinAltitude":           {Num: 113, BaseType: "uint32", Unit: "m", Scale: 5, Offset: 500, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedMaxAltitude":           {Num: 114, BaseType: "uint32", Unit: "m", Scale: 5, Offset: 500, Invalid: uint32(0xFFFFFFFF)},
+		"AvgVam":                        {Num: 121, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"Length": {
+		"MessageIndex":       {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Timestamp":          {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Event":              {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventType":          {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"StartTime":          {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TotalElapsedTime":   {Num: 3, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalTimerTime":     {Num: 4, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalStrokes":       {Num: 5, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgSpeed":           {Num: 6, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SwimStroke":         {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgSwimmingCadence": {Num: 9, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventGroup":         {Num: 10, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalCalories":      {Num: 11, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"LengthType":         {Num: 12, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"PlayerScore":        {Num: 18, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"OpponentScore":      {Num: 19, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"StrokeCount":        {Num: 20, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ZoneCount":          {Num: 21, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"MagnetometerData": {},
+	"MemoGlob":         {},
+	"MesgCapabilities": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"File":         {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MesgNum":      {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"CountType":    {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Count":        {Num: 3, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"MetZone": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HighBpm":      {Num: 1, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Calories":     {Num: 2, BaseType: "uint16", Unit: "kcal / min", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FatCalories":  {Num: 3, BaseType: "uint8", Unit: "kcal / min", Scale: 10, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"MonitoringInfo": {
+		"Timestamp":      {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"LocalTimestamp": {Num: 0, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"Monitoring": {
+		"Timestamp":       {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"DeviceIndex":     {Num: 0, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Calories":        {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Distance":        {Num: 2, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Cycles":          {Num: 3, BaseType: "uint32", Unit: "cycles", Scale: 2, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"ActiveTime":      {Num: 4, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"ActivityType":    {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ActivitySubtype": {Num: 6, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Distance16":      {Num: 8, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Cycles16":        {Num: 9, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"ActiveTime16":    {Num: 10, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"LocalTimestamp":  {Num: 11, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"NmeaSentence": {
+		"Timestamp":   {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TimestampMs": {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Sentence":    {Num: 1, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"ObdiiData":             {},
+	"OhrSettings":           {},
+	"OneDSensorCalibration": {},
+	"PowerZone": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HighValue":    {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Name":         {Num: 2, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"Record": {
+		"Timestamp":                     {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"PositionLat":                   {Num: 0, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"PositionLong":                  {Num: 1, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Altitude":                      {Num: 2, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"HeartRate":                     {Num: 3, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Cadence":                       {Num: 4, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Distance":                      {Num: 5, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Speed":                         {Num: 6, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Power":                         {Num: 7, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"CompressedSpeedDistance":       {Num: 8, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Grade":                         {Num: 9, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"Resistance":                    {Num: 10, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TimeFromCourse":                {Num: 11, BaseType: "sint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: int32(0x7FFFFFFF)},
+		"CycleLength":                   {Num: 12, BaseType: "uint8", Unit: "m", Scale: 100, Offset: 0, Invalid: uint8(0xFF)},
+		"Temperature":                   {Num: 13, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"Speed1s":                       {Num: 17, BaseType: "uint8[]", Unit: "m/s", Scale: 16, Offset: 0, Invalid: nil},
+		"Cycles":                        {Num: 18, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalCycles":                   {Num: 19, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"CompressedAccumulatedPower":    {Num: 28, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AccumulatedPower":              {Num: 29, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"LeftRightBalance":              {Num: 30, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"GpsAccuracy":                   {Num: 31, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"VerticalSpeed":                 {Num: 32, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"Calories":                      {Num: 33, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"VerticalOscillation":           {Num: 39, BaseType: "uint16", Unit: "mm", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"StanceTimePercent":             {Num: 40, BaseType: "uint16", Unit: "percent", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"StanceTime":                    {Num: 41, BaseType: "uint16", Unit: "ms", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"ActivityType":                  {Num: 42, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"LeftTorqueEffectiveness":       {Num: 43, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"RightTorqueEffectiveness":      {Num: 44, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"LeftPedalSmoothness":           {Num: 45, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"RightPedalSmoothness":          {Num: 46, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"CombinedPedalSmoothness":       {Num: 47, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"Time128":                       {Num: 48, BaseType: "uint8", Unit: "s", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"StrokeType":                    {Num: 49, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Zone":                          {Num: 50, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"BallSpeed":                     {Num: 51, BaseType: "uint16", Unit: "m/s", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Cadence256":                    {Num: 52, BaseType: "uint16", Unit: "rpm", Scale: 256, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FractionalCadence":             {Num: 53, BaseType: "uint8", Unit: "rpm", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalHemoglobinConc":           {Num: 54, BaseType: "uint16", Unit: "g/dL", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalHemoglobinConcMin":        {Num: 55, BaseType: "uint16", Unit: "g/dL", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalHemoglobinConcMax":        {Num: 56, BaseType: "uint16", Unit: "g/dL", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SaturatedHemoglobinPercent":    {Num: 57, BaseType: "uint16", Unit: "%", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SaturatedHemoglobinPercentMin": {Num: 58, BaseType: "uint16", Unit: "%", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SaturatedHemoglobinPercentMax": {Num: 59, BaseType: "uint16", Unit: "%", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"DeviceIndex":                   {Num: 62, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EnhancedSpeed":                 {Num: 73, BaseType: "uint32", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedAltitude":              {Num: 78, BaseType: "uint32", Unit: "m", Scale: 5, Offset: 500, Invalid: uint32(0xFFFFFFFF)},
+	},
+	"Schedule": {
+		"Manufacturer":  {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Product":       {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SerialNumber":  {Num: 2, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+		"TimeCreated":   {Num: 3, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Completed":     {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Type":          {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ScheduledTime": {Num: 6, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"SdmProfile": {
+		"MessageIndex":      {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Enabled":           {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SdmAntId":          {Num: 1, BaseType: "uint16z", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0x0000)},
+		"SdmCalFactor":      {Num: 2, BaseType: "uint16", Unit: "%", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Odometer":          {Num: 3, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"SpeedSource":       {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SdmAntIdTransType": {Num: 5, BaseType: "uint8z", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0x00)},
+		"OdometerRollover":  {Num: 7, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"SegmentFile": {
+		"MessageIndex":          {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FileUuid":              {Num: 1, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Enabled":               {Num: 3, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"UserProfilePrimaryKey": {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"LeaderType":            {Num: 7, BaseType: "enum[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"LeaderGroupPrimaryKey": {Num: 8, BaseType: "uint32[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"LeaderActivityId":      {Num: 9, BaseType: "uint32[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"SegmentId": {
+		"Name":                  {Num: 0, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Uuid":                  {Num: 1, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Sport":                 {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Enabled":               {Num: 3, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"UserProfilePrimaryKey": {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"DeviceId":              {Num: 5, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"DefaultRaceLeader":     {Num: 6, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DeleteStatus":          {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SelectionType":         {Num: 8, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"SegmentLap": {
+		"MessageIndex":                {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Timestamp":                   {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Event":                       {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventType":                   {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"StartTime":                   {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"StartPositionLat":            {Num: 3, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"StartPositionLong":           {Num: 4, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"EndPositionLat":              {Num: 5, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"EndPositionLong":             {Num: 6, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TotalElapsedTime":            {Num: 7, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalTimerTime":              {Num: 8, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalDistance":               {Num: 9, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalCycles":                 {Num: 10, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalCalories":               {Num: 11, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalFatCalories":            {Num: 12, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgSpeed":                    {Num: 13, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MaxSpeed":                    {Num: 14, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgHeartRate":                {Num: 15, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxHeartRate":                {Num: 16, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgCadence":                  {Num: 17, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxCadence":                  {Num: 18, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgPower":                    {Num: 19, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MaxPower":                    {Num: 20, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalAscent":                 {Num: 21, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalDescent":                {Num: 22, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Sport":                       {Num: 23, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventGroup":                  {Num: 24, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"NecLat":                      {Num: 25, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"NecLong":                     {Num: 26, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"SwcLat":                      {Num: 27, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"SwcLong":                     {Num: 28, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Name":                        {Num: 29, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"NormalizedPower":             {Num: 30, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"LeftRightBalance":            {Num: 31, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SubSport":                    {Num: 32, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalWork":                   {Num: 33, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"AvgAltitude":                 {Num: 34, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"MaxAltitude":                 {Num: 35, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"GpsAccuracy":                 {Num: 36, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgGrade":                    {Num: 37, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgPosGrade":                 {Num: 38, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgNegGrade":                 {Num: 39, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxPosGrade":                 {Num: 40, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxNegGrade":                 {Num: 41, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgTemperature":              {Num: 42, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"MaxTemperature":              {Num: 43, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"TotalMovingTime":             {Num: 44, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"AvgPosVerticalSpeed":         {Num: 45, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgNegVerticalSpeed":         {Num: 46, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxPosVerticalSpeed":         {Num: 47, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxNegVerticalSpeed":         {Num: 48, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"TimeInHrZone":                {Num: 49, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInSpeedZone":             {Num: 50, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInCadenceZone":           {Num: 51, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInPowerZone":             {Num: 52, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"RepetitionNum":               {Num: 53, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MinAltitude":                 {Num: 54, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"MinHeartRate":                {Num: 55, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ActiveTime":                  {Num: 56, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"WktStepIndex":                {Num: 57, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SportEvent":                  {Num: 58, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgLeftTorqueEffectiveness":  {Num: 59, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgRightTorqueEffectiveness": {Num: 60, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgLeftPedalSmoothness":      {Num: 61, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgRightPedalSmoothness":     {Num: 62, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgCombinedPedalSmoothness":  {Num: 63, BaseType: "uint8", Unit: "percent", Scale: 2, Offset: 0, Invalid: uint8(0xFF)},
+		"Status":                      {Num: 64, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Uuid":                        {Num: 65, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"AvgFractionalCadence":        {Num: 66, BaseType: "uint8", Unit: "rpm", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxFractionalCadence":        {Num: 67, BaseType: "uint8", Unit: "rpm", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalFractionalCycles":       {Num: 68, BaseType: "uint8", Unit: "cycles", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"FrontGearShiftCount":         {Num: 69, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"RearGearShiftCount":          {Num: 70, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"SegmentLeaderboardEntry": {
+		"MessageIndex":    {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Name":            {Num: 0, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Type":            {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"GroupPrimaryKey": {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"ActivityId":      {Num: 3, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"SegmentTime":     {Num: 4, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+	},
+	"SegmentPoint": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PositionLat":  {Num: 1, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"PositionLong": {Num: 2, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Distance":     {Num: 3, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Altitude":     {Num: 4, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"LeaderTime":   {Num: 5, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+	},
+	"Session": {
+		"MessageIndex":                 {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Timestamp":                    {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Event":                        {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EventType":                    {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"StartTime":                    {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"StartPositionLat":             {Num: 3, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"StartPositionLong":            {Num: 4, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Sport":                        {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SubSport":                     {Num: 6, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalElapsedTime":             {Num: 7, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalTimerTime":               {Num: 8, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalDistance":                {Num: 9, BaseType: "uint32", Unit: "m", Scale: 100, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalCycles":                  {Num: 10, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TotalCalories":                {Num: 11, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalFatCalories":             {Num: 13, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgSpeed":                     {Num: 14, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MaxSpeed":                     {Num: 15, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgHeartRate":                 {Num: 16, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxHeartRate":                 {Num: 17, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgCadence":                   {Num: 18, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxCadence":                   {Num: 19, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgPower":                     {Num: 20, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MaxPower":                     {Num: 21, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalAscent":                  {Num: 22, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalDescent":                 {Num: 23, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalTrainingEffect":          {Num: 24, BaseType: "uint8", Unit: "", Scale: 10, Offset: 0, Invalid: uint8(0xFF)},
+		"FirstLapIndex":                {Num: 25, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"NumLaps":                      {Num: 26, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"EventGroup":                   {Num: 27, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Trigger":                      {Num: 28, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"NecLat":                       {Num: 29, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"NecLong":                      {Num: 30, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"SwcLat":                       {Num: 31, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"SwcLong":                      {Num: 32, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"NumLengths":                   {Num: 33, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"NormalizedPower":              {Num: 34, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TrainingStressScore":          {Num: 35, BaseType: "uint16", Unit: "tss", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"IntensityFactor":              {Num: 36, BaseType: "uint16", Unit: "if", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"LeftRightBalance":             {Num: 37, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgStrokeCount":               {Num: 41, BaseType: "uint32", Unit: "strokes/lap", Scale: 10, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"AvgStrokeDistance":            {Num: 42, BaseType: "uint16", Unit: "m", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SwimStroke":                   {Num: 43, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"PoolLength":                   {Num: 44, BaseType: "uint16", Unit: "m", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"ThresholdPower":               {Num: 45, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PoolLengthUnit":               {Num: 46, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"NumActiveLengths":             {Num: 47, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"TotalWork":                    {Num: 48, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"AvgAltitude":                  {Num: 49, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"MaxAltitude":                  {Num: 50, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"GpsAccuracy":                  {Num: 51, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgGrade":                     {Num: 52, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgPosGrade":                  {Num: 53, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgNegGrade":                  {Num: 54, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxPosGrade":                  {Num: 55, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxNegGrade":                  {Num: 56, BaseType: "sint16", Unit: "%", Scale: 100, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgTemperature":               {Num: 57, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"MaxTemperature":               {Num: 58, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"TotalMovingTime":              {Num: 59, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"AvgPosVerticalSpeed":          {Num: 60, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"AvgNegVerticalSpeed":          {Num: 61, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxPosVerticalSpeed":          {Num: 62, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"MaxNegVerticalSpeed":          {Num: 63, BaseType: "sint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: int16(0x7FFF)},
+		"MinHeartRate":                 {Num: 64, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TimeInHrZone":                 {Num: 65, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInSpeedZone":              {Num: 66, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInCadenceZone":            {Num: 67, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"TimeInPowerZone":              {Num: 68, BaseType: "uint32[]", Unit: "s", Scale: 1000, Offset: 0, Invalid: nil},
+		"AvgLapTime":                   {Num: 69, BaseType: "uint32", Unit: "s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"BestLapIndex":                 {Num: 70, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MinAltitude":                  {Num: 71, BaseType: "uint16", Unit: "m", Scale: 5, Offset: 500, Invalid: uint16(0xFFFF)},
+		"PlayerScore":                  {Num: 82, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"OpponentScore":                {Num: 83, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"OpponentName":                 {Num: 84, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"StrokeCount":                  {Num: 85, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ZoneCount":                    {Num: 86, BaseType: "uint16[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"MaxBallSpeed":                 {Num: 87, BaseType: "uint16", Unit: "m/s", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgBallSpeed":                 {Num: 88, BaseType: "uint16", Unit: "m/s", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgVerticalOscillation":       {Num: 89, BaseType: "uint16", Unit: "mm", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgStanceTimePercent":         {Num: 90, BaseType: "uint16", Unit: "percent", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgStanceTime":                {Num: 91, BaseType: "uint16", Unit: "ms", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"AvgFractionalCadence":         {Num: 92, BaseType: "uint8", Unit: "rpm", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"MaxFractionalCadence":         {Num: 93, BaseType: "uint8", Unit: "rpm", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"TotalFractionalCycles":        {Num: 94, BaseType: "uint8", Unit: "cycles", Scale: 128, Offset: 0, Invalid: uint8(0xFF)},
+		"SportIndex":                   {Num: 111, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"EnhancedAvgSpeed":             {Num: 124, BaseType: "uint32", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedMaxSpeed":             {Num: 125, BaseType: "uint32", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedAvgAltitude":          {Num: 126, BaseType: "uint32", Unit: "m", Scale: 5, Offset: 500, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedMinAltitude":          {Num: 127, BaseType: "uint32", Unit: "m", Scale: 5, Offset: 500, Invalid: uint32(0xFFFFFFFF)},
+		"EnhancedMaxAltitude":          {Num: 128, BaseType: "uint32", Unit: "m", Scale: 5, Offset: 500, Invalid: uint32(0xFFFFFFFF)},
+		"TotalAnaerobicTrainingEffect": {Num: 137, BaseType: "uint8", Unit: "", Scale: 10, Offset: 0, Invalid: uint8(0xFF)},
+		"AvgVam":                       {Num: 139, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"Set": {
+		"WeightDisplayUnit": {Num: 9, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"SlaveDevice": {
+		"Manufacturer": {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Product":      {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"Software": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Version":      {Num: 3, BaseType: "uint16", Unit: "", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PartNumber":   {Num: 5, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"SpeedZone": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HighValue":    {Num: 0, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Name":         {Num: 1, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"Sport": {
+		"Sport":    {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SubSport": {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Name":     {Num: 3, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"StressLevel":             {},
+	"ThreeDSensorCalibration": {},
+	"TimestampCorrelation":    {},
+	"Totals": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Timestamp":    {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"TimerTime":    {Num: 0, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Distance":     {Num: 1, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Calories":     {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Sport":        {Num: 3, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ElapsedTime":  {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Sessions":     {Num: 5, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"ActiveTime":   {Num: 6, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+	},
+	"TrainingFile": {
+		"Timestamp":    {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Type":         {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Manufacturer": {Num: 1, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Product":      {Num: 2, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SerialNumber": {Num: 3, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+		"TimeCreated":  {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+	},
+	"UserProfile": {
+		"MessageIndex":               {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FriendlyName":               {Num: 0, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Gender":                     {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Age":                        {Num: 2, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Height":                     {Num: 3, BaseType: "uint8", Unit: "m", Scale: 100, Offset: 0, Invalid: uint8(0xFF)},
+		"Weight":                     {Num: 4, BaseType: "uint16", Unit: "kg", Scale: 10, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Language":                   {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ElevSetting":                {Num: 6, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"WeightSetting":              {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"RestingHeartRate":           {Num: 8, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DefaultMaxRunningHeartRate": {Num: 9, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DefaultMaxBikingHeartRate":  {Num: 10, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DefaultMaxHeartRate":        {Num: 11, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"HrSetting":                  {Num: 12, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SpeedSetting":               {Num: 13, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DistSetting":                {Num: 14, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"PowerSetting":               {Num: 16, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ActivityClass":              {Num: 17, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"PositionSetting":            {Num: 18, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TemperatureSetting":         {Num: 21, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"LocalId":                    {Num: 22, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"GlobalId":                   {Num: 23, BaseType: "byte[]", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"HeightSetting":              {Num: 30, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"UserRunningStepLength":      {Num: 31, BaseType: "uint16", Unit: "m", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"UserWalkingStepLength":      {Num: 32, BaseType: "uint16", Unit: "m", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"VideoClip": {},
+	"VideoDescription": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MessageCount": {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Text":         {Num: 1, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"VideoFrame": {},
+	"Video":      {},
+	"VideoTitle": {
+		"MessageIndex": {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MessageCount": {Num: 0, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Text":         {Num: 1, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+	},
+	"WatchfaceSettings": {},
+	"WeatherAlert": {
+		"Timestamp":  {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ReportId":   {Num: 0, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"IssueTime":  {Num: 1, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ExpireTime": {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Severity":   {Num: 3, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Type":       {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"WeatherConditions": {
+		"Timestamp":                {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"WeatherReport":            {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Temperature":              {Num: 1, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"Condition":                {Num: 2, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"WindDirection":            {Num: 3, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"WindSpeed":                {Num: 4, BaseType: "uint16", Unit: "m/s", Scale: 1000, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PrecipitationProbability": {Num: 5, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TemperatureFeelsLike":     {Num: 6, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"RelativeHumidity":         {Num: 7, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Location":                 {Num: 8, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"ObservedAtTime":           {Num: 9, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ObservedLocationLat":      {Num: 10, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"ObservedLocationLong":     {Num: 11, BaseType: "sint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"DayOfWeek":                {Num: 12, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"HighTemperature":          {Num: 13, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+		"LowTemperature":           {Num: 14, BaseType: "sint8", Unit: "C", Scale: 1, Offset: 0, Invalid: int8(0x7F)},
+	},
+	"WeightScale": {
+		"Timestamp":         {Num: 253, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: nil},
+		"Weight":            {Num: 0, BaseType: "uint16", Unit: "kg", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PercentFat":        {Num: 1, BaseType: "uint16", Unit: "%", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PercentHydration":  {Num: 2, BaseType: "uint16", Unit: "%", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"VisceralFatMass":   {Num: 3, BaseType: "uint16", Unit: "kg", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"BoneMass":          {Num: 4, BaseType: "uint16", Unit: "kg", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MuscleMass":        {Num: 5, BaseType: "uint16", Unit: "kg", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"BasalMet":          {Num: 7, BaseType: "uint16", Unit: "kcal/day", Scale: 4, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PhysiqueRating":    {Num: 8, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ActiveMet":         {Num: 9, BaseType: "uint16", Unit: "kcal/day", Scale: 4, Offset: 0, Invalid: uint16(0xFFFF)},
+		"MetabolicAge":      {Num: 10, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"VisceralFatRating": {Num: 11, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"UserProfileIndex":  {Num: 12, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+	},
+	"Workout": {
+		"Sport":          {Num: 4, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Capabilities":   {Num: 5, BaseType: "uint32z", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0x00000000)},
+		"NumValidSteps":  {Num: 6, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"WktName":        {Num: 8, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"SubSport":       {Num: 11, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"PoolLength":     {Num: 14, BaseType: "uint16", Unit: "m", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PoolLengthUnit": {Num: 15, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"WorkoutSession": {
+		"MessageIndex":   {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"Sport":          {Num: 0, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SubSport":       {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"NumValidSteps":  {Num: 2, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"FirstStepIndex": {Num: 3, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PoolLength":     {Num: 4, BaseType: "uint16", Unit: "m", Scale: 100, Offset: 0, Invalid: uint16(0xFFFF)},
+		"PoolLengthUnit": {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+	"WorkoutStep": {
+		"MessageIndex":                   {Num: 254, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"WktStepName":                    {Num: 0, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"DurationType":                   {Num: 1, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"DurationValue":                  {Num: 2, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"TargetType":                     {Num: 3, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"TargetValue":                    {Num: 4, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"CustomTargetValueLow":           {Num: 5, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"CustomTargetValueHigh":          {Num: 6, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"Intensity":                      {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"Notes":                          {Num: 8, BaseType: "string", Unit: "", Scale: 1, Offset: 0, Invalid: ""},
+		"Equipment":                      {Num: 9, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ExerciseCategory":               {Num: 10, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"SecondaryTargetType":            {Num: 19, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"SecondaryTargetValue":           {Num: 20, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"SecondaryCustomTargetValueLow":  {Num: 21, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+		"SecondaryCustomTargetValueHigh": {Num: 22, BaseType: "uint32", Unit: "", Scale: 1, Offset: 0, Invalid: uint32(0xFFFFFFFF)},
+	},
+	"ZonesTarget": {
+		"MaxHeartRate":             {Num: 1, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"ThresholdHeartRate":       {Num: 2, BaseType: "uint8", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"FunctionalThresholdPower": {Num: 3, BaseType: "uint16", Unit: "", Scale: 1, Offset: 0, Invalid: uint16(0xFFFF)},
+		"HrCalcType":               {Num: 5, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+		"PwrCalcType":              {Num: 7, BaseType: "enum", Unit: "", Scale: 1, Offset: 0, Invalid: uint8(0xFF)},
+	},
+}
+
+var fieldComponents = map[string]map[string][]string{
+	"AntRx": {
+		"MesgData": {"Data", "ChannelNumber"},
+	},
+	"AntTx": {
+		"MesgData": {"Data", "ChannelNumber"},
+	},
+	"Event": {
+		"Data":   {"Score", "OpponentScore", "RearGearNum", "RearGear", "FrontGearNum", "FrontGear"},
+		"Data16": {"Data"},
+	},
+	"ExdDataConceptConfiguration": {
+		"ConceptField": {"FieldId", "ConceptIndex"},
+	},
+	"ExdDataFieldConfiguration": {
+		"ConceptField": {"FieldId", "ConceptCount"},
+	},
+	"Lap": {
+		"AvgAltitude": {"EnhancedAvgAltitude"},
+		"AvgSpeed":    {"EnhancedAvgSpeed"},
+		"MaxAltitude": {"EnhancedMaxAltitude"},
+		"MaxSpeed":    {"EnhancedMaxSpeed"},
+		"MinAltitude": {"EnhancedMinAltitude"},
+	},
+	"Record": {
+		"Altitude":                   {"EnhancedAltitude"},
+		"CompressedAccumulatedPower": {"AccumulatedPower"},
+		"CompressedSpeedDistance":    {"Speed", "Distance"},
+		"Cycles":                     {"TotalCycles"},
+		"Speed":                      {"EnhancedSpeed"},
+	},
+	"Session": {
+		"AvgAltitude": {"EnhancedAvgAltitude"},
+		"AvgSpeed":    {"EnhancedAvgSpeed"},
+		"MaxAltitude": {"EnhancedMaxAltitude"},
+		"MaxSpeed":    {"EnhancedMaxSpeed"},
+		"MinAltitude": {"EnhancedMinAltitude"},
+	},
+}
+
+var enumInvalids = map[string]uint64{
+	"ActivityClass":                 0xFF,
+	"ActivityLevel":                 0xFF,
+	"ActivityMode":                  0xFF,
+	"ActivitySubtype":               0xFF,
+	"ActivityType":                  0xFF,
+	"AnalogWatchfaceLayout":         0xFF,
+	"AntNetwork":                    0xFF,
+	"AntplusDeviceType":             0xFF,
+	"AttitudeStage":                 0xFF,
+	"AttitudeValidity":              0xFFFF,
+	"AutoActivityDetect":            0xFFFFFFFF,
+	"AutoSyncFrequency":             0xFF,
+	"AutolapTrigger":                0xFF,
+	"Autoscroll":                    0xFF,
+	"BacklightMode":                 0xFF,
+	"BacklightTimeout":              0xFF,
+	"BatteryStatus":                 0xFF,
+	"BenchPressExerciseName":        0xFFFF,
+	"BikeLightBeamAngleMode":        0xFF,
+	"BikeLightNetworkConfigType":    0xFF,
+	"BodyLocation":                  0xFF,
+	"Bool":                          255,
+	"BpStatus":                      0xFF,
+	"CalfRaiseExerciseName":         0xFFFF,
+	"CameraEventType":               0xFF,
+	"CameraOrientationType":         0xFF,
+	"CardioExerciseName":            0xFFFF,
+	"CarryExerciseName":             0xFFFF,
+	"Checksum":                      0xFF,
+	"ChopExerciseName":              0xFFFF,
+	"ClimbProEvent":                 0xFF,
+	"CommTimeoutType":               0xFFFF,
+	"ConnectivityCapabilities":      0x00000000,
+	"CoreExerciseName":              0xFFFF,
+	"CourseCapabilities":            0x00000000,
+	"CoursePoint":                   0xFF,
+	"CrunchExerciseName":            0xFFFF,
+	"CurlExerciseName":              0xFFFF,
+	"DateMode":                      0xFF,
+	"DayOfWeek":                     0xFF,
+	"DeadliftExerciseName":          0xFFFF,
+	"DeviceIndex":                   0xFF,
+	"DigitalWatchfaceLayout":        0xFF,
+	"DisplayHeart":                  0xFF,
+	"DisplayMeasure":                0xFF,
+	"DisplayOrientation":            0xFF,
+	"DisplayPosition":               0xFF,
+	"DisplayPower":                  0xFF,
+	"DiveAlarmType":                 0xFF,
+	"DiveBacklightMode":             0xFF,
+	"DiveGasStatus":                 0xFF,
+	"Event":                         0xFF,
+	"EventType":                     0xFF,
+	"ExdDataUnits":                  0xFF,
+	"ExdDescriptors":                0xFF,
+	"ExdDisplayType":                0xFF,
+	"ExdLayout":                     0xFF,
+	"ExdQualifiers":                 0xFF,
+	"ExerciseCategory":              0xFFFF,
+	"FaveroProduct":                 0xFFFF,
+	"FileFlags":                     0x00,
+	"FileType":                      0xFF,
+	"FitBaseType":                   0xFF,
+	"FitBaseUnit":                   0xFFFF,
+	"FitnessEquipmentState":         0xFF,
+	"FlyeExerciseName":              0xFFFF,
+	"GarminProduct":                 0xFFFF,
+	"Gender":                        0xFF,
+	"Goal":                          0xFF,
+	"GoalRecurrence":                0xFF,
+	"GoalSource":                    0xFF,
+	"HipRaiseExerciseName":          0xFFFF,
+	"HipStabilityExerciseName":      0xFFFF,
+	"HipSwingExerciseName":          0xFFFF,
+	"HrType":                        0xFF,
+	"HrZoneCalc":                    0xFF,
+	"HyperextensionExerciseName":    0xFFFF,
+	"Intensity":                     0xFF,
+	"Language":                      0xFF,
+	"LanguageBits0":                 0x00,
+	"LanguageBits1":                 0x00,
+	"LanguageBits2":                 0x00,
+	"LanguageBits3":                 0x00,
+	"LanguageBits4":                 0x00,
+	"LapTrigger":                    0xFF,
+	"LateralRaiseExerciseName":      0xFFFF,
+	"LeftRightBalance":              0xFF,
+	"LeftRightBalance100":           0xFFFF,
+	"LegCurlExerciseName":           0xFFFF,
+	"LegRaiseExerciseName":          0xFFFF,
+	"LengthType":                    0xFF,
+	"LocalDeviceType":               0xFF,
+	"LocaltimeIntoDay":              0xFFFFFFFF,
+	"LungeExerciseName":             0xFFFF,
+	"Manufacturer":                  0xFFFF,
+	"MesgCount":                     0xFF,
+	"MesgNum":                       0xFFFF,
+	"MessageIndex":                  0xFFFF,
+	"OlympicLiftExerciseName":       0xFFFF,
+	"PlankExerciseName":             0xFFFF,
+	"PlyoExerciseName":              0xFFFF,
+	"PowerPhaseType":                0xFF,
+	"PullUpExerciseName":            0xFFFF,
+	"PushUpExerciseName":            0xFFFF,
+	"PwrZoneCalc":                   0xFF,
+	"RadarThreatLevelType":          0xFF,
+	"RiderPositionType":             0xFF,
+	"RowExerciseName":               0xFFFF,
+	"RunExerciseName":               0xFFFF,
+	"Schedule":                      0xFF,
+	"SegmentDeleteStatus":           0xFF,
+	"SegmentLapStatus":              0xFF,
+	"SegmentLeaderboardType":        0xFF,
+	"SegmentSelectionType":          0xFF,
+	"SensorType":                    0xFF,
+	"SessionTrigger":                0xFF,
+	"SetType":                       0xFF,
+	"ShoulderPressExerciseName":     0xFFFF,
+	"ShoulderStabilityExerciseName": 0xFFFF,
+	"ShrugExerciseName":             0xFFFF,
+	"Side":                          0xFF,
+	"SitUpExerciseName":             0xFFFF,
+	"SourceType":                    0xFF,
+	"Sport":                         0xFF,
+	"SportBits0":                    0x00,
+	"SportBits1":                    0x00,
+	"SportBits2":                    0x00,
+	"SportBits3":                    0x00,
+	"SportBits4":                    0x00,
+	"SportBits5":                    0x00,
+	"SportBits6":                    0x00,
+	"SportEvent":                    0xFF,
+	"SquatExerciseName":             0xFFFF,
+	"StrokeType":                    0xFF,
+	"SubSport":                      0xFF,
+	"SupportedExdScreenLayouts":     0x00000000,
+	"SwimStroke":                    0xFF,
+	"Switch":                        0xFF,
+	"TapSensitivity":                0xFF,
+	"TimeIntoDay":                   0xFFFFFFFF,
+	"TimeMode":                      0xFF,
+	"TimeZone":                      0xFF,
+	"TimerTrigger":                  0xFF,
+	"TissueModelType":               0xFF,
+	"Tone":                          0xFF,
+	"TotalBodyExerciseName":         0xFFFF,
+	"TricepsExtensionExerciseName":  0xFFFF,
+	"TurnType":                      0xFF,
+	"UserLocalId":                   0xFFFF,
+	"WarmUpExerciseName":            0xFFFF,
+	"WatchfaceMode":                 0xFF,
+	"WaterType":                     0xFF,
+	"WeatherReport":                 0xFF,
+	"WeatherSevereType":             0xFF,
+	"WeatherSeverity":               0xFF,
+	"WeatherStatus":                 0xFF,
+	"Weight":                        0xFFFF,
+	"WktStepDuration":               0xFF,
+	"WktStepTarget":                 0xFF,
+	"WorkoutCapabilities":           0x00000000,
+	"WorkoutEquipment":              0xFF,
+	"WorkoutHr":                     0xFFFFFFFF,
+	"WorkoutPower":                  0xFFFFFFFF,
+}
+
+// profileNames maps the Go names of messages, fields, types and enum
+// values to their names in the profile, from profile 21.40
+var profileNames = map[string]string{
+	"AAndD":                          "a_and_d",
+	"AbdominalLegRotations":          "abdominal_leg_rotations",
+	"AbsJabs":                        "abs_jabs",
+	"AbsolutePressure":               "absolute_pressure",
+	"AccelCalFactor":                 "accel_cal_factor",
+	"AccelLateral":                   "accel_lateral",
+	"AccelNormal":                    "accel_normal",
+	"AccelX":                         "accel_x",
+	"AccelY":                         "accel_y",
+	"AccelZ":                         "accel_z",
+	"Accelerometer":                  "accelerometer",
+	"AccelerometerData":              "accelerometer_data",
+	"Accumulate":                     "accumulate",
+	"AccumulatedPower":               "accumulated_power",
+	"AceSensor":                      "ace_sensor",
+	"AcornProjectsAps":               "acorn_projects_aps",
+	"Actigraphcorp":                  "actigraphcorp",
+	"Active":                         "active",
+	"ActiveCalories":                 "active_calories",
+	"ActiveMet":                      "active_met",
+	"ActiveMinutes":                  "active_minutes",
+	"ActiveTime":                     "active_time",
+	"ActiveTime16":                   "active_time_16",
+	"ActiveTimeZone":                 "active_time_zone",
+	"Activity":                       "activity",
+	"ActivityClass":                  "activity_class",
+	"ActivityEnd":                    "activity_end",
+	"ActivityId":                     "activity_id",
+	"ActivityIdString":               "activity_id_string",
+	"ActivityLevel":                  "activity_level",
+	"ActivitySubtype":                "activity_subtype",
+	"ActivitySummary":                "activity_summary",
+	"ActivityTime":                   "activity_time",
+	"ActivityTrackerEnabled":         "activity_tracker_enabled",
+	"ActivityType":                   "activity_type",
+	"ActivityUpload":                 "activity_upload",
+	"Adelaide":                       "adelaide",
+	"Advisory":                       "advisory",
+	"Age":                            "age",
+	"AirQuality":                     "air_quality",
+	"AirStagnation":                  "air_stagnation",
+	"AlarmType":                      "alarm_type",
+	"AlatechTechnologyLtd":           "alatech_technology_ltd",
+	"Alberta":                        "alberta",
+	"Alf04":                          "alf04",
+	"Aligning":                       "aligning",
+	"All":                            "all",
+	"Almaty":                         "almaty",
+	"AlpineSkiing":                   "alpine_skiing",
+	"AlternatingBarbellForwardLunge": "alternating_barbell_forward_lunge",
+	"AlternatingDumbbellBicepsCurl":  "alternating_dumbbell_biceps_curl",
+	"AlternatingDumbbellBicepsCurlOnSwissBall": "alternating_dumbbell_biceps_curl_on_swiss_ball",
+	"AlternatingDumbbellChestPress":            "alternating_dumbbell_chest_press",
+	"AlternatingDumbbellChestPressOnSwissBall": "alternating_dumbbell_chest_press_on_swiss_ball",
+	"AlternatingDumbbellLungeWithReach":        "alternating_dumbbell_lunge_with_reach",
+	"AlternatingDumbbellShoulderPress":         "alternating_dumbbell_shoulder_press",
+	"AlternatingHandsMedicineBallPushUp":       "alternating_hands_medicine_ball_push_up",
+	"AlternatingInclineDumbbellBicepsCurl":     "alternating_incline_dumbbell_biceps_curl",
+	"AlternatingJumpLunge":                     "alternating_jump_lunge",
+	"AlternatingLateralRaiseWithStaticHold":    "alternating_lateral_raise_with_static_hold",
+	"AlternatingPlateReach":                    "alternating_plate_reach",
+	"AlternatingSitUp":                         "alternating_sit_up",
+	"AlternatingSlideOut":                      "alternating_slide_out",
+	"AlternatingStaggeredPushUp":               "alternating_staggered_push_up",
+	"Altitude":                                 "altitude",
+	"AlwaysOn":                                 "always_on",
+	"AmbientPressure":                          "ambient_pressure",
+	"AmericanFootball":                         "american_football",
+	"Amsterdam":                                "amsterdam",
+	"Amx":                                      "amx",
+	"AnaerobicTrainingEffect":                  "anaerobic_training_effect",
+	"Analog":                                   "analog",
+	"AnalogLayout":                             "analog_layout",
+	"AnalogWatchfaceLayout":                    "analog_watchface_layout",
+	"AndroidAntplusPlugin":                     "android_antplus_plugin",
+	"AnkleCircles":                             "ankle_circles",
+	"AnkleDorsiflexionWithBand":                "ankle_dorsiflexion_with_band",
+	"AnkleInternalRotation":                    "ankle_internal_rotation",
+	"Ant":                                      "ant",
+	"AntChannelId":                             "ant_channel_id",
+	"AntDeviceNumber":                          "ant_device_number",
+	"AntDeviceType":                            "ant_device_type",
+	"AntEnabled":                               "ant_enabled",
+	"AntNetwork":                               "ant_network",
+	"AntRx":                                    "ant_rx",
+	"AntTransmissionType":                      "ant_transmission_type",
+	"AntTx":                                    "ant_tx",
+	"Antfs":                                    "antfs",
+	"Antplus":                                  "antplus",
+	"AntplusDeviceType":                        "antplus_device_type",
+	"ApneaCountdownEnabled":                    "apnea_countdown_enabled",
+	"ApneaCountdownTime":                       "apnea_countdown_time",
+	"ApneaDiving":                              "apnea_diving",
+	"ApneaHunting":                             "apnea_hunting",
+	"ApplicationId":                            "application_id",
+	"ApplicationVersion":                       "application_version",
+	"Approach":                                 "approach",
+	"ApproachG10":                              "approach_g10",
+	"ApproachG80":                              "approach_g80",
+	"ApproachS10":                              "approach_s10",
+	"ApproachS20":                              "approach_s20",
+	"ApproachS40":                              "approach_s40",
+	"ApproachS6":                               "approach_s6",
+	"ApproachS60":                              "approach_s60",
+	"ApproachX10":                              "approach_x10",
+	"ApproachX40":                              "approach_x40",
+	"ApproachZ80":                              "approach_z80",
+	"Arabic":                                   "arabic",
+	"Archinoetics":                             "archinoetics",
+	"ArcticOutflow":                            "arctic_outflow",
+	"ArealFlood":                               "areal_flood",
+	"ArmAndLegExtensionOnKnees":                "arm_and_leg_extension_on_knees",
+	"ArmCircles":                               "arm_circles",
+	"ArmRotations":                             "arm_rotations",
+	"ArnoldPress":                              "arnold_press",
+	"Array":                                    "array",
+	"ArrivingIdx":                              "arriving_idx",
+	"ArrivingLeftIdx":                          "arriving_left_idx",
+	"ArrivingRightIdx":                         "arriving_right_idx",
+	"ArrivingViaIdx":                           "arriving_via_idx",
+	"ArrivingViaLeftIdx":                       "arriving_via_left_idx",
+	"ArrivingViaRightIdx":                      "arriving_via_right_idx",
+	"Ascent":                                   "ascent",
+	"Ashfall":                                  "ashfall",
+	"AssiomaDuo":                               "assioma_duo",
+	"AssiomaUno":                               "assioma_uno",
+	"AtDepth":                                  "at_depth",
+	"Athens":                                   "athens",
+	"Athlete":                                  "athlete",
+	"AttitudeStage":                            "attitude_stage",
+	"AttitudeStageComplete":                    "attitude_stage_complete",
+	"AttitudeValidity":                         "attitude_validity",
+	"Atv":                                      "atv",
+	"Auckland":                                 "auckland",
+	"AudioPrompts":                             "audio_prompts",
+	"AustraliaLh":                              "australia_lh",
+	"AustrianGrid":                             "austrian_grid",
+	"Auto":                                     "auto",
+	"AutoActivityDetect":                       "auto_activity_detect",
+	"AutoActivityUploadEnabled":                "auto_activity_upload_enabled",
+	"AutoBrightness":                           "auto_brightness",
+	"AutoMultiSport":                           "auto_multi_sport",
+	"AutoPowerZero":                            "auto_power_zero",
+	"AutoSyncFrequency":                        "auto_sync_frequency",
+	"AutoWheelCal":                             "auto_wheel_cal",
+	"AutoWheelsize":                            "auto_wheelsize",
+	"AutolapTrigger":                           "autolap_trigger",
+	"Automatic":                                "automatic",
+	"Autoscroll":                               "autoscroll",
+	"AutosyncMinSteps":                         "autosync_min_steps",
+	"AutosyncMinTime":                          "autosync_min_time",
+	"Avalanche":                                "avalanche",
+	"Average":                                  "average",
+	"AverageLap":                               "average_lap",
+	"AvgAltitude":                              "avg_altitude",
+	"AvgBallSpeed":                             "avg_ball_speed",
+	"AvgCadence":                               "avg_cadence",
+	"AvgCadencePosition":                       "avg_cadence_position",
+	"AvgCombinedPedalSmoothness":               "avg_combined_pedal_smoothness",
+	"AvgDepth":                                 "avg_depth",
+	"AvgFlow":                                  "avg_flow",
+	"AvgFractionalCadence":                     "avg_fractional_cadence",
+	"AvgGrade":                                 "avg_grade",
+	"AvgGrit":                                  "avg_grit",
+	"AvgHeartRate":                             "avg_heart_rate",
+	"AvgLapTime":                               "avg_lap_time",
+	"AvgLeftPco":                               "avg_left_pco",
+	"AvgLeftPedalSmoothness":                   "avg_left_pedal_smoothness",
+	"AvgLeftPowerPhase":                        "avg_left_power_phase",
+	"AvgLeftPowerPhasePeak":                    "avg_left_power_phase_peak",
+	"AvgLeftTorqueEffectiveness":               "avg_left_torque_effectiveness",
+	"AvgLevMotorPower":                         "avg_lev_motor_power",
+	"AvgNegGrade":                              "avg_neg_grade",
+	"AvgNegVerticalSpeed":                      "avg_neg_vertical_speed",
+	"AvgPosGrade":                              "avg_pos_grade",
+	"AvgPosVerticalSpeed":                      "avg_pos_vertical_speed",
+	"AvgPower":                                 "avg_power",
+	"AvgPowerPosition":                         "avg_power_position",
+	"AvgRightPco":                              "avg_right_pco",
+	"AvgRightPedalSmoothness":                  "avg_right_pedal_smoothness",
+	"AvgRightPowerPhase":                       "avg_right_power_phase",
+	"AvgRightPowerPhasePeak":                   "avg_right_power_phase_peak",
+	"AvgRightTorqueEffectiveness":              "avg_right_torque_effectiveness",
+	"AvgRunningCadence":                        "avg_running_cadence",
+	"AvgSaturatedHemoglobinPercent":            "avg_saturated_hemoglobin_percent",
+	"AvgSpeed":                                 "avg_speed",
+	"AvgStanceTime":                            "avg_stance_time",
+	"AvgStanceTimeBalance":                     "avg_stance_time_balance",
+	"AvgStanceTimePercent":                     "avg_stance_time_percent",
+	"AvgStepLength":                            "avg_step_length",
+	"AvgStrokeCount":                           "avg_stroke_count",
+	"AvgStrokeDistance":                        "avg_stroke_distance",
+	"AvgSwimmingCadence":                       "avg_swimming_cadence",
+	"AvgTemperature":                           "avg_temperature",
+	"AvgTotalHemoglobinConc":                   "avg_total_hemoglobin_conc",
+	"AvgVam":                                   "avg_vam",
+	"AvgVerticalOscillation":                   "avg_vertical_oscillation",
+	"AvgVerticalRatio":                         "avg_vertical_ratio",
+	"AviationAttitude":                         "aviation_attitude",
+	"Axb01":                                    "axb01",
+	"Axb02":                                    "axb02",
+	"Axh01":                                    "axh01",
+	"Axs01":                                    "axs01",
+	"BackExtensionWithOppositeArmAndLegReach": "back_extension_with_opposite_arm_and_leg_reach",
+	"BackFootElevatedDumbbellSplitSquat":      "back_foot_elevated_dumbbell_split_squat",
+	"BackSquatWithBodyBar":                    "back_squat_with_body_bar",
+	"BackSquats":                              "back_squats",
+	"Backcountry":                             "backcountry",
+	"Backhand":                                "backhand",
+	"BacklightBrightness":                     "backlight_brightness",
+	"BacklightMode":                           "backlight_mode",
+	"BacklightTimeout":                        "backlight_timeout",
+	"Backstroke":                              "backstroke",
+	"BackupOnly":                              "backup_only",
+	"Balance":                                 "balance",
+	"BalancingSquat":                          "balancing_squat",
+	"BallSpeed":                               "ball_speed",
+	"BandAssistedChinUp":                      "band_assisted_chin_up",
+	"BandExternalRotation":                    "band_external_rotation",
+	"BandInternalRotation":                    "band_internal_rotation",
+	"BandSideLyingLegRaise":                   "band_side_lying_leg_raise",
+	"BandedPullUps":                           "banded_pull_ups",
+	"Bangkok":                                 "bangkok",
+	"Bar":                                     "bar",
+	"BarHolds":                                "bar_holds",
+	"BarMuscleUp":                             "bar_muscle_up",
+	"BarbellBackSquat":                        "barbell_back_squat",
+	"BarbellBenchPress":                       "barbell_bench_press",
+	"BarbellBicepsCurl":                       "barbell_biceps_curl",
+	"BarbellBoardBenchPress":                  "barbell_board_bench_press",
+	"BarbellBoxLunge":                         "barbell_box_lunge",
+	"BarbellBoxSquat":                         "barbell_box_squat",
+	"BarbellBulgarianSplitSquat":              "barbell_bulgarian_split_squat",
+	"BarbellCrossoverLunge":                   "barbell_crossover_lunge",
+	"BarbellDeadlift":                         "barbell_deadlift",
+	"BarbellFloorPress":                       "barbell_floor_press",
+	"BarbellFrontSplitSquat":                  "barbell_front_split_squat",
+	"BarbellFrontSquat":                       "barbell_front_squat",
+	"BarbellFrontSquatToPushPress":            "barbell_front_squat_to_push_press",
+	"BarbellHackSquat":                        "barbell_hack_squat",
+	"BarbellHangPowerClean":                   "barbell_hang_power_clean",
+	"BarbellHangPowerSnatch":                  "barbell_hang_power_snatch",
+	"BarbellHangPull":                         "barbell_hang_pull",
+	"BarbellHangSquatClean":                   "barbell_hang_squat_clean",
+	"BarbellHangSquatSnatch":                  "barbell_hang_squat_snatch",
+	"BarbellHighPull":                         "barbell_high_pull",
+	"BarbellHipThrustOnFloor":                 "barbell_hip_thrust_on_floor",
+	"BarbellHipThrustWithBench":               "barbell_hip_thrust_with_bench",
+	"BarbellJumpShrug":                        "barbell_jump_shrug",
+	"BarbellJumpSquat":                        "barbell_jump_squat",
+	"BarbellLateralStepUp":                    "barbell_lateral_step_up",
+	"BarbellLunge":                            "barbell_lunge",
+	"BarbellPowerClean":                       "barbell_power_clean",
+	"BarbellPowerSnatch":                      "barbell_power_snatch",
+	"BarbellPushPress":                        "barbell_push_press",
+	"BarbellQuarterSquat":                     "barbell_quarter_squat",
+	"BarbellReverseLunge":                     "barbell_reverse_lunge",
+	"BarbellReverseWristCurl":                 "barbell_reverse_wrist_curl",
+	"BarbellRollout":                          "barbell_rollout",
+	"BarbellShoulderPress":                    "barbell_shoulder_press",
+	"BarbellShrug":                            "barbell_shrug",
+	"BarbellSideLunge":                        "barbell_side_lunge",
+	"BarbellSiffSquat":                        "barbell_siff_squat",
+	"BarbellSnatch":                           "barbell_snatch",
+	"BarbellSplitJerk":                        "barbell_split_jerk",
+	"BarbellSplitSquat":                       "barbell_split_squat",
+	"BarbellSquatClean":                       "barbell_squat_clean",
+	"BarbellSquatSnatch":                      "barbell_squat_snatch",
+	"BarbellSquatWithHeelsRaised":             "barbell_squat_with_heels_raised",
+	"BarbellStepUp":                           "barbell_step_up",
+	"BarbellStepover":                         "barbell_stepover",
+	"BarbellStraightLegDeadlift":              "barbell_straight_leg_deadlift",
+	"BarbellStraightLegDeadliftToRow":         "barbell_straight_leg_deadlift_to_row",
+	"BarbellUprightRow":                       "barbell_upright_row",
+	"BarbellWristCurl":                        "barbell_wrist_curl",
+	"Barcelona":                               "barcelona",
+	"BaroCalFactor":                           "baro_cal_factor",
+	"BaroPres":                                "baro_pres",
+	"Barometer":                               "barometer",
+	"BarometerData":                           "barometer_data",
+	"BasalMet":                                "basal_met",
+	"BaseRotations":                           "base_rotations",
+	"Basketball":                              "basketball",
+	"BateryLevel":                             "batery_level",
+	"Battery":                                 "battery",
+	"BatteryLevel":                            "battery_level",
+	"BatteryLow":                              "battery_low",
+	"BatterySoc":                              "battery_soc",
+	"BatteryStatus":                           "battery_status",
+	"BatteryVoltage":                          "battery_voltage",
+	"Bcm":                                     "bcm",
+	"BeamAngleStatus":                         "beam_angle_status",
+	"BearCrawl":                               "bear_crawl",
+	"BearKeepLeftIdx":                         "bear_keep_left_idx",
+	"BearKeepRightIdx":                        "bear_keep_right_idx",
+	"BeginDepreciated":                        "begin_depreciated",
+	"BehindTheBackBarbellReverseWristCurl":    "behind_the_back_barbell_reverse_wrist_curl",
+	"BehindTheBackOneArmCableCurl":            "behind_the_back_one_arm_cable_curl",
+	"BehindTheBackSmithMachineShrug":          "behind_the_back_smith_machine_shrug",
+	"BenchDip":                                "bench_dip",
+	"BenchPress":                              "bench_press",
+	"BenchPressExerciseName":                  "bench_press_exercise_name",
+	"BenchSquatWithRotationalChop":            "bench_squat_with_rotational_chop",
+	"BentArmLateralRaiseAndExternalRotation":  "bent_arm_lateral_raise_and_external_rotation",
+	"BentKneeReverseHyperextension":           "bent_knee_reverse_hyperextension",
+	"BentKneeSwissBallReverseHipRaise":        "bent_knee_swiss_ball_reverse_hip_raise",
+	"BentKneeVUp":                             "bent_knee_v_up",
+	"BentOverLateralRaise":                    "bent_over_lateral_raise",
+	"BentOverReachToSky":                      "bent_over_reach_to_sky",
+	"Berlin":                                  "berlin",
+	"BestLapIndex":                            "best_lap_index",
+	"Beurer":                                  "beurer",
+	"Bf1systems":                              "bf1systems",
+	"BicepCurlWithLegExtension":               "bicep_curl_with_leg_extension",
+	"Bicycle":                                 "bicycle",
+	"BicycleCrunch":                           "bicycle_crunch",
+	"BikeAero":                                "bike_aero",
+	"BikeCadAntId":                            "bike_cad_ant_id",
+	"BikeCadAntIdTransType":                   "bike_cad_ant_id_trans_type",
+	"BikeCadence":                             "bike_cadence",
+	"BikeLightBatteryStatus":                  "bike_light_battery_status",
+	"BikeLightBeamAngleMode":                  "bike_light_beam_angle_mode",
+	"BikeLightMain":                           "bike_light_main",
+	"BikeLightNetworkConfigType":              "bike_light_network_config_type",
+	"BikeLightShared":                         "bike_light_shared",
+	"BikePower":                               "bike_power",
+	"BikePowerAntId":                          "bike_power_ant_id",
+	"BikePowerAntIdTransType":                 "bike_power_ant_id_trans_type",
+	"BikeProfile":                             "bike_profile",
+	"BikeRadar":                               "bike_radar",
+	"BikeSpdAntId":                            "bike_spd_ant_id",
+	"BikeSpdAntIdTransType":                   "bike_spd_ant_id_trans_type",
+	"BikeSpdcadAntId":                         "bike_spdcad_ant_id",
+	"BikeSpdcadAntIdTransType":                "bike_spdcad_ant_id_trans_type",
+	"BikeSpeed":                               "bike_speed",
+	"BikeSpeedCadence":                        "bike_speed_cadence",
+	"BikeToRunTransition":                     "bike_to_run_transition",
+	"BikeWeight":                              "bike_weight",
+	"Bikeway":                                 "bikeway",
+	"Bits":                                    "bits",
+	"Bkool":                                   "bkool",
+	"BleAutoUploadEnabled":                    "ble_auto_upload_enabled",
+	"Blizzard":                                "blizzard",
+	"BloodPressure":                           "blood_pressure",
+	"BlowingDust":                             "blowing_dust",
+	"BlowingSnow":                             "blowing_snow",
+	"Bluetooth":                               "bluetooth",
+	"BluetoothEnabled":                        "bluetooth_enabled",
+	"BluetoothLe":                             "bluetooth_le",
+	"BluetoothLeEnabled":                      "bluetooth_le_enabled",
+	"BluetoothLowEnergy":                      "bluetooth_low_energy",
+	"Bmx":                                     "bmx",
+	"Boating":                                 "boating",
+	"BobAndWeaveCircle":                       "bob_and_weave_circle",
+	"BodyBarObliqueTwist":                     "body_bar_oblique_twist",
+	"BodyBikeSmart":                           "body_bike_smart",
+	"BodyLocation":                            "body_location",
+	"BodyWeightDip":                           "body_weight_dip",
+	"BodyWeightJumpSquat":                     "body_weight_jump_squat",
+	"BodyWeightWallSquat":                     "body_weight_wall_squat",
+	"Boise":                                   "boise",
+	"Bold":                                    "bold",
+	"Bombay":                                  "bombay",
+	"BoneMass":                                "bone_mass",
+	"Bontrager":                               "bontrager",
+	"BorneoRso":                               "borneo_rso",
+	"Boston":                                  "boston",
+	"BosuBallPushUp":                          "bosu_ball_push_up",
+	"BottomDepth":                             "bottom_depth",
+	"BottomTime":                              "bottom_time",
+	"BoxStepSquat":                            "box_step_squat",
+	"Boxing":                                  "boxing",
+	"BpStatus":                                "bp_status",
+	"Bpm":                                     "bpm",
+	"BpmOffset":                               "bpm_offset",
+	"BracedSquat":                             "braced_squat",
+	"Bradians":                                "bradians",
+	"Brasilia":                                "brasilia",
+	"BrazilianPortuguese":                     "brazilian_portuguese",
+	"Breakaway":                               "breakaway",
+	"Breaststroke":                            "breaststroke",
+	"BridgeOneLegBridge":                      "bridge_one_leg_bridge",
+	"BridgeWithGluteLowerLift":                "bridge_with_glute_lower_lift",
+	"BridgeWithLegExtension":                  "bridge_with_leg_extension",
+	"BrimBrothers":                            "brim_brothers",
+	"Brisbane":                                "brisbane",
+	"BriskWind":                               "brisk_wind",
+	"BritishColumbia":                         "british_columbia",
+	"BritishGrid":                             "british_grid",
+	"Brussels":                                "brussels",
+	"Bryton":                                  "bryton",
+	"BrytonSensors":                           "bryton_sensors",
+	"Bsm":                                     "bsm",
+	"BsxAthletics":                            "bsx_athletics",
+	"Budapest":                                "budapest",
+	"Bulgarian":                               "bulgarian",
+	"Burmese":                                 "burmese",
+	"Burpee":                                  "burpee",
+	"BurpeeBoxJump":                           "burpee_box_jump",
+	"BurpeePullUp":                            "burpee_pull_up",
+	"Butterfly":                               "butterfly",
+	"ButterflySitUp":                          "butterfly_sit_up",
+	"Byte":                                    "byte",
+	"CableBicepsCurl":                         "cable_biceps_curl",
+	"CableCorePress":                          "cable_core_press",
+	"CableCrossover":                          "cable_crossover",
+	"CableCrunch":                             "cable_crunch",
+	"CableDiagonalRaise":                      "cable_diagonal_raise",
+	"CableExternalRotation":                   "cable_external_rotation",
+	"CableFrontRaise":                         "cable_front_raise",
+	"CableHammerCurl":                         "cable_hammer_curl",
+	"CableKickback":                           "cable_kickback",
+	"CableLyingTricepsExtension":              "cable_lying_triceps_extension",
+	"CableOverheadTricepsExtension":           "cable_overhead_triceps_extension",
+	"CablePullThrough":                        "cable_pull_through",
+	"CableRotationalLift":                     "cable_rotational_lift",
+	"CableRowStanding":                        "cable_row_standing",
+	"CableSideBend":                           "cable_side_bend",
+	"CableWoodchop":                           "cable_woodchop",
+	"CadEnabled":                              "cad_enabled",
+	"CadHighAlert":                            "cad_high_alert",
+	"CadLowAlert":                             "cad_low_alert",
+	"Cadence":                                 "cadence",
+	"Cadence256":                              "cadence256",
+	"CadenceZone":                             "cadence_zone",
+	"Cairo":                                   "cairo",
+	"Calculating":                             "calculating",
+	"CalfRaise":                               "calf_raise",
+	"CalfRaiseExerciseName":                   "calf_raise_exercise_name",
+	"CalibratedAccelX":                        "calibrated_accel_x",
+	"CalibratedAccelY":                        "calibrated_accel_y",
+	"CalibratedAccelZ":                        "calibrated_accel_z",
+	"CalibratedGyroX":                         "calibrated_gyro_x",
+	"CalibratedGyroY":                         "calibrated_gyro_y",
+	"CalibratedGyroZ":                         "calibrated_gyro_z",
+	"CalibratedMagX":                          "calibrated_mag_x",
+	"CalibratedMagY":                          "calibrated_mag_y",
+	"CalibratedMagZ":                          "calibrated_mag_z",
+	"Calibration":                             "calibration",
+	"CalibrationDivisor":                      "calibration_divisor",
+	"CalibrationFactor":                       "calibration_factor",
+	"CalorieDurationAlert":                    "calorie_duration_alert",
+	"CalorieRow":                              "calorie_row",
+	"Calories":                                "calories",
+	"CameraEvent":                             "camera_event",
+	"CameraEventType":                         "camera_event_type",
+	"CameraFileUuid":                          "camera_file_uuid",
+	"CameraOrientation":                       "camera_orientation",
+	"CameraOrientation0":                      "camera_orientation_0",
+	"CameraOrientation180":                    "camera_orientation_180",
+	"CameraOrientation270":                    "camera_orientation_270",
+	"CameraOrientation90":                     "camera_orientation_90",
+	"CameraOrientationType":                   "camera_orientation_type",
+	"CampagnoloSrl":                           "campagnolo_srl",
+	"Capabilities":                            "capabilities",
+	"CapeTown":                                "cape_town",
+	"CapeVerdeIs":                             "cape_verde_is",
+	"Cardio":                                  "cardio",
+	"CardioCoreCrawl":                         "cardio_core_crawl",
+	"CardioExerciseName":                      "cardio_exercise_name",
+	"CardioTraining":                          "cardio_training",
+	"Cardiosport":                             "cardiosport",
+	"Carry":                                   "carry",
+	"CarryExerciseName":                       "carry_exercise_name",
+	"CasualWalking":                           "casual_walking",
+	"CatCamel":                                "cat_camel",
+	"CatCow":                                  "cat_cow",
+	"Category":                                "category",
+	"CategorySubtype":                         "category_subtype",
+	"Cateye":                                  "cateye",
+	"Centimeter":                              "centimeter",
+	"Challenge":                               "challenge",
+	"Challenger":                              "challenger",
+	"ChannelNumber":                           "channel_number",
+	"Charging":                                "charging",
+	"CheatingBarbellBicepsCurl":               "cheating_barbell_biceps_curl",
+	"Checksum":                                "checksum",
+	"ChestPressWithBand":                      "chest_press_with_band",
+	"Chicago":                                 "chicago",
+	"Chileaf":                                 "chileaf",
+	"Chinese":                                 "chinese",
+	"Chirp":                                   "chirp",
+	"Chita":                                   "chita",
+	"Chop":                                    "chop",
+	"ChopExerciseName":                        "chop_exercise_name",
+	"Ciclosport":                              "ciclosport",
+	"CircleGraph":                             "circle_graph",
+	"CircularArmCrunch":                       "circular_arm_crunch",
+	"CitizenSystems":                          "citizen_systems",
+	"ClamBridge":                              "clam_bridge",
+	"Clams":                                   "clams",
+	"ClappingPushUp":                          "clapping_push_up",
+	"Clean":                                   "clean",
+	"CleanAndJerk":                            "clean_and_jerk",
+	"CleanMobile":                             "clean_mobile",
+	"Clear":                                   "clear",
+	"ClimbCategory":                           "climb_category",
+	"ClimbNumber":                             "climb_number",
+	"ClimbPro":                                "climb_pro",
+	"ClimbProEvent":                           "climb_pro_event",
+	"ClipEnd":                                 "clip_end",
+	"ClipNumber":                              "clip_number",
+	"ClipStart":                               "clip_start",
+	"ClockTime":                               "clock_time",
+	"CloseGripBarbellBenchPress":              "close_grip_barbell_bench_press",
+	"CloseGripChinUp":                         "close_grip_chin_up",
+	"CloseGripEzBarBicepsCurl":                "close_grip_ez_bar_biceps_curl",
+	"CloseGripLatPulldown":                    "close_grip_lat_pulldown",
+	"CloseGripMedicineBallPushUp":             "close_grip_medicine_ball_push_up",
+	"CloseHandsPushUp":                        "close_hands_push_up",
+	"Cloudy":                                  "cloudy",
+	"ClubLeader":                              "club_leader",
+	"CnsLoad":                                 "cns_load",
+	"CoastalFlood":                            "coastal_flood",
+	"Cobi":                                    "cobi",
+	"Cobra":                                   "cobra",
+	"ColdWave":                                "cold_wave",
+	"CombinedPedalSmoothness":                 "combined_pedal_smoothness",
+	"ComboShoulderRaise":                      "combo_shoulder_raise",
+	"CommTimeout":                             "comm_timeout",
+	"CommTimeoutType":                         "comm_timeout_type",
+	"Community":                               "community",
+	"Commuting":                               "commuting",
+	"ComparedToVirtualPartner":                "compared_to_virtual_partner",
+	"Compass":                                 "compass",
+	"CompassHeading":                          "compass_heading",
+	"Complete":                                "complete",
+	"Completed":                               "completed",
+	"Components":                              "components",
+	"CompressedAccumulatedPower":              "compressed_accumulated_power",
+	"CompressedCalibratedAccelX":              "compressed_calibrated_accel_x",
+	"CompressedCalibratedAccelY":              "compressed_calibrated_accel_y",
+	"CompressedCalibratedAccelZ":              "compressed_calibrated_accel_z",
+	"CompressedSpeedDistance":                 "compressed_speed_distance",
+	"Concept2":                                "concept2",
+	"ConceptCount":                            "concept_count",
+	"ConceptField":                            "concept_field",
+	"ConceptIndex":                            "concept_index",
+	"ConceptKey":                              "concept_key",
+	"Condition":                               "condition",
+	"Connect":                                 "connect",
+	"ConnectIq":                               "connect_iq",
+	"ConnectIqAppDownload":                    "connect_iq_app_download",
+	"ConnectIqAppManagment":                   "connect_iq_app_managment",
+	"ConnectIqDataFieldDownload":              "connect_iq_data_field_download",
+	"ConnectIqWatchAppDownload":               "connect_iq_watch_app_download",
+	"ConnectIqWatchFaceDownload":              "connect_iq_watch_face_download",
+	"ConnectIqWidgetDownload":                 "connect_iq_widget_download",
+	"ConnectionLost":                          "connection_lost",
+	"ConnectionTimeout":                       "connection_timeout",
+	"Connections":                             "connections",
+	"ConnectiqSimulator":                      "connectiq_simulator",
+	"Connectivity":                            "connectivity",
+	"ConnectivityCapabilities":                "connectivity_capabilities",
+	"ConnectivitySupported":                   "connectivity_supported",
+	"ConsecutiveDepreciated":                  "consecutive_depreciated",
+	"ContinueIdx":                             "continue_idx",
+	"ContinueSyncAfterSoftwareUpdate":         "continue_sync_after_software_update",
+	"Control":                                 "control",
+	"ControlHub":                              "control_hub",
+	"Cooldown":                                "cooldown",
+	"Copenhagen":                              "copenhagen",
+	"Core":                                    "core",
+	"CoreControlRearLunge":                    "core_control_rear_lunge",
+	"CoreExerciseName":                        "core_exercise_name",
+	"Corkscrew":                               "corkscrew",
+	"Coros":                                   "coros",
+	"CorosByte":                               "coros_byte",
+	"Cosinuss":                                "cosinuss",
+	"Count":                                   "count",
+	"CountType":                               "count_type",
+	"Course":                                  "course",
+	"CourseCapabilities":                      "course_capabilities",
+	"CourseDistance":                          "course_distance",
+	"CourseDownload":                          "course_download",
+	"CourseDownloadEnabled":                   "course_download_enabled",
+	"CourseEstimatedTimeOfArrival":            "course_estimated_time_of_arrival",
+	"CourseHeading":                           "course_heading",
+	"CourseLocation":                          "course_location",
+	"CoursePoint":                             "course_point",
+	"CoursePointIndex":                        "course_point_index",
+	"CourseTime":                              "course_time",
+	"CourseType":                              "course_type",
+	"CrankLength":                             "crank_length",
+	"Creator":                                 "creator",
+	"CrescentCircle":                          "crescent_circle",
+	"CrissCross":                              "criss_cross",
+	"CrissCrossWithBall":                      "criss_cross_with_ball",
+	"Critical":                                "critical",
+	"Croatian":                                "croatian",
+	"CrossBodyDumbbellHammerCurl":             "cross_body_dumbbell_hammer_curl",
+	"CrossBodyMountainClimber":                "cross_body_mountain_climber",
+	"CrossChopToKnee":                         "cross_chop_to_knee",
+	"CrossCountrySkiing":                      "cross_country_skiing",
+	"CrossKneeStrike":                         "cross_knee_strike",
+	"CrossLegReverseCrunch":                   "cross_leg_reverse_crunch",
+	"CrossPunchRollUp":                        "cross_punch_roll_up",
+	"CrossedArmBarbellFrontSquat":             "crossed_arm_barbell_front_squat",
+	"CrossedArmsCrunch":                       "crossed_arms_crunch",
+	"CrossedArmsSitUp":                        "crossed_arms_sit_up",
+	"CrossoverChinUp":                         "crossover_chin_up",
+	"CrossoverDumbbellStepUp":                 "crossover_dumbbell_step_up",
+	"Crunch":                                  "crunch",
+	"CrunchChop":                              "crunch_chop",
+	"CrunchExerciseName":                      "crunch_exercise_name",
+	"CumOperatingTime":                        "cum_operating_time",
+	"Curl":                                    "curl",
+	"CurlExerciseName":                        "curl_exercise_name",
+	"Current":                                 "current",
+	"CurrentActivityTypeIntensity":            "current_activity_type_intensity",
+	"CurrentDist":                             "current_dist",
+	"Custom":                                  "custom",
+	"CustomTargetCadenceHigh":                 "custom_target_cadence_high",
+	"CustomTargetCadenceLow":                  "custom_target_cadence_low",
+	"CustomTargetHeartRateHigh":               "custom_target_heart_rate_high",
+	"CustomTargetHeartRateLow":                "custom_target_heart_rate_low",
+	"CustomTargetPowerHigh":                   "custom_target_power_high",
+	"CustomTargetPowerLow":                    "custom_target_power_low",
+	"CustomTargetSpeedHigh":                   "custom_target_speed_high",
+	"CustomTargetSpeedLow":                    "custom_target_speed_low",
+	"CustomTargetValueHigh":                   "custom_target_value_high",
+	"CustomTargetValueLow":                    "custom_target_value_low",
+	"CustomWheelsize":                         "custom_wheelsize",
+	"CycleLength":                             "cycle_length",
+	"Cycles":                                  "cycles",
+	"Cycles16":                                "cycles_16",
+	"CyclesToCalories":                        "cycles_to_calories",
+	"CyclesToDistance":                        "cycles_to_distance",
+	"Cycligentinc":                            "cycligentinc",
+	"Cycling":                                 "cycling",
+	"CyclingRussianTwist":                     "cycling_russian_twist",
+	"Cycliq":                                  "cycliq",
+	"Cyclocross":                              "cyclocross",
+	"Cycplus":                                 "cycplus",
+	"Czech":                                   "czech",
+	"D2Bravo":                                 "d2_bravo",
+	"D2BravoTitanium":                         "d2_bravo_titanium",
+	"D2airvenu":                               "d2airvenu",
+	"D2charlie":                               "d2charlie",
+	"Dabuziduo":                               "dabuziduo",
+	"Daily":                                   "daily",
+	"DailyForecast":                           "daily_forecast",
+	"Dallas":                                  "dallas",
+	"Danger":                                  "danger",
+	"Danish":                                  "danish",
+	"Darwin":                                  "darwin",
+	"Data":                                    "data",
+	"Data16":                                  "data16",
+	"DataPage":                                "data_page",
+	"DataUnits":                               "data_units",
+	"DateMode":                                "date_mode",
+	"DayMonth":                                "day_month",
+	"DayOfWeek":                               "day_of_week",
+	"Dayton":                                  "dayton",
+	"DeadBug":                                 "dead_bug",
+	"DeadCurlPress":                           "dead_curl_press",
+	"DeadHangBicepsCurl":                      "dead_hang_biceps_curl",
+	"Deadlift":                                "deadlift",
+	"DeadliftExerciseName":                    "deadlift_exercise_name",
+	"DebrisFlow":                              "debris_flow",
+	"DeclineDumbbellBenchPress":               "decline_dumbbell_bench_press",
+	"DeclineDumbbellFlye":                     "decline_dumbbell_flye",
+	"DeclineHammerCurl":                       "decline_hammer_curl",
+	"DeclinePushUp":                           "decline_push_up",
+	"DefaultMaxBikingHeartRate":               "default_max_biking_heart_rate",
+	"DefaultMaxHeartRate":                     "default_max_heart_rate",
+	"DefaultMaxRunningHeartRate":              "default_max_running_heart_rate",
+	"DefaultPage":                             "default_page",
+	"DefaultRaceLeader":                       "default_race_leader",
+	"Degraded":                                "degraded",
+	"Degree":                                  "degree",
+	"DegreeMinute":                            "degree_minute",
+	"DegreeMinuteSecond":                      "degree_minute_second",
+	"Degrees":                                 "degrees",
+	"DegreesCelsius":                          "degrees_celsius",
+	"DegreesFarenheit":                        "degrees_farenheit",
+	"DeleteAll":                               "delete_all",
+	"DeleteOne":                               "delete_one",
+	"DeleteStatus":                            "delete_status",
+	"DenseFog":                                "dense_fog",
+	"DenseSmoke":                              "dense_smoke",
+	"Denver":                                  "denver",
+	"Depth":                                   "depth",
+	"DepthJump":                               "depth_jump",
+	"DepthSetting":                            "depth_setting",
+	"Descent":                                 "descent",
+	"Descriptor":                              "descriptor",
+	"DeveloperDataId":                         "developer_data_id",
+	"DeveloperDataIndex":                      "developer_data_index",
+	"DeveloperId":                             "developer_id",
+	"Development":                             "development",
+	"Device":                                  "device",
+	"DeviceAuxBatteryInfo":                    "device_aux_battery_info",
+	"DeviceId":                                "device_id",
+	"DeviceIndex":                             "device_index",
+	"DeviceInfo":                              "device_info",
+	"DeviceInitiatesSync":                     "device_initiates_sync",
+	"DeviceNumber":                            "device_number",
+	"DeviceSettings":                          "device_settings",
+	"DeviceType":                              "device_type",
+	"Dexcom":                                  "dexcom",
+	"Di2BatteryLevel":                         "di2_battery_level",
+	"DiagonalLunge":                           "diagonal_lunge",
+	"DiamondPushUp":                           "diamond_push_up",
+	"DiastolicPressure":                       "diastolic_pressure",
+	"Digital":                                 "digital",
+	"DigitalLayout":                           "digital_layout",
+	"DigitalWatchfaceLayout":                  "digital_watchface_layout",
+	"DirectionTechnology":                     "direction_technology",
+	"Directory":                               "directory",
+	"Disabled":                                "disabled",
+	"DisplayHeart":                            "display_heart",
+	"DisplayMeasure":                          "display_measure",
+	"DisplayOrientation":                      "display_orientation",
+	"DisplayPosition":                         "display_position",
+	"DisplayPower":                            "display_power",
+	"DisplayType":                             "display_type",
+	"DistSetting":                             "dist_setting",
+	"Distance":                                "distance",
+	"Distance16":                              "distance_16",
+	"DistanceDurationAlert":                   "distance_duration_alert",
+	"DiveAlarm":                               "dive_alarm",
+	"DiveAlarmType":                           "dive_alarm_type",
+	"DiveBacklightMode":                       "dive_backlight_mode",
+	"DiveCount":                               "dive_count",
+	"DiveGas":                                 "dive_gas",
+	"DiveGasStatus":                           "dive_gas_status",
+	"DiveNumber":                              "dive_number",
+	"DiveSettings":                            "dive_settings",
+	"DiveSummary":                             "dive_summary",
+	"DiveTypes":                               "dive_types",
+	"Diving":                                  "diving",
+	"DkCity":                                  "dk_city",
+	"DoNotDelete":                             "do_not_delete",
+	"DonkeyCalfRaise":                         "donkey_calf_raise",
+	"DoubleCrunch":                            "double_crunch",
+	"DoubleLegStretch":                        "double_leg_stretch",
+	"DoubleUnder":                             "double_under",
+	"Downhill":                                "downhill",
+	"Drill":                                   "drill",
+	"Driving":                                 "driving",
+	"DropLunge":                               "drop_lunge",
+	"DsiAlf01":                                "dsi_alf01",
+	"DsiAlf02":                                "dsi_alf02",
+	"Dublin":                                  "dublin",
+	"DumbbellAlternatingShoulderPressAndTwist": "dumbbell_alternating_shoulder_press_and_twist",
+	"DumbbellBenchPress":                       "dumbbell_bench_press",
+	"DumbbellBicepsCurlWithStaticHold":         "dumbbell_biceps_curl_with_static_hold",
+	"DumbbellBoxLunge":                         "dumbbell_box_lunge",
+	"DumbbellBulgarianSplitSquat":              "dumbbell_bulgarian_split_squat",
+	"DumbbellChop":                             "dumbbell_chop",
+	"DumbbellClean":                            "dumbbell_clean",
+	"DumbbellCrossoverLunge":                   "dumbbell_crossover_lunge",
+	"DumbbellDeadlift":                         "dumbbell_deadlift",
+	"DumbbellDiagonalLunge":                    "dumbbell_diagonal_lunge",
+	"DumbbellDiagonalRaise":                    "dumbbell_diagonal_raise",
+	"DumbbellFacePullWithExternalRotation":     "dumbbell_face_pull_with_external_rotation",
+	"DumbbellFloorPress":                       "dumbbell_floor_press",
+	"DumbbellFlye":                             "dumbbell_flye",
+	"DumbbellFrontSquat":                       "dumbbell_front_squat",
+	"DumbbellHammerCurl":                       "dumbbell_hammer_curl",
+	"DumbbellHammerCurlToLungeToPress":         "dumbbell_hammer_curl_to_lunge_to_press",
+	"DumbbellHangPull":                         "dumbbell_hang_pull",
+	"DumbbellJumpShrug":                        "dumbbell_jump_shrug",
+	"DumbbellJumpSquat":                        "dumbbell_jump_squat",
+	"DumbbellKickback":                         "dumbbell_kickback",
+	"DumbbellLunge":                            "dumbbell_lunge",
+	"DumbbellLungeAndRotation":                 "dumbbell_lunge_and_rotation",
+	"DumbbellLyingTricepsExtension":            "dumbbell_lying_triceps_extension",
+	"DumbbellOverheadBulgarianSplitSquat":      "dumbbell_overhead_bulgarian_split_squat",
+	"DumbbellPushPress":                        "dumbbell_push_press",
+	"DumbbellReverseLungeToHighKneeAndPress":   "dumbbell_reverse_lunge_to_high_knee_and_press",
+	"DumbbellReverseWristCurl":                 "dumbbell_reverse_wrist_curl",
+	"DumbbellRow":                              "dumbbell_row",
+	"DumbbellShrug":                            "dumbbell_shrug",
+	"DumbbellSideLunge":                        "dumbbell_side_lunge",
+	"DumbbellSingleLegDeadliftToRow":           "dumbbell_single_leg_deadlift_to_row",
+	"DumbbellSplitJump":                        "dumbbell_split_jump",
+	"DumbbellSplitSquat":                       "dumbbell_split_squat",
+	"DumbbellSquat":                            "dumbbell_squat",
+	"DumbbellSquatClean":                       "dumbbell_squat_clean",
+	"DumbbellStepUp":                           "dumbbell_step_up",
+	"DumbbellStepover":                         "dumbbell_stepover",
+	"DumbbellStraightLegDeadlift":              "dumbbell_straight_leg_deadlift",
+	"DumbbellUprightRow":                       "dumbbell_upright_row",
+	"DumbbellVRaise":                           "dumbbell_v_raise",
+	"DumbbellWristCurl":                        "dumbbell_wrist_curl",
+	"Duration":                                 "duration",
+	"DurationCalories":                         "duration_calories",
+	"DurationDistance":                         "duration_distance",
+	"DurationHr":                               "duration_hr",
+	"DurationMin":                              "duration_min",
+	"DurationPower":                            "duration_power",
+	"DurationReps":                             "duration_reps",
+	"DurationStep":                             "duration_step",
+	"DurationTime":                             "duration_time",
+	"DurationType":                             "duration_type",
+	"DurationValue":                            "duration_value",
+	"DustStorm":                                "dust_storm",
+	"Dutch":                                    "dutch",
+	"DutchGrid":                                "dutch_grid",
+	"Dynastream":                               "dynastream",
+	"DynastreamOem":                            "dynastream_oem",
+	"Dynovelo":                                 "dynovelo",
+	"EBikeFitness":                             "e_bike_fitness",
+	"EBikeMountain":                            "e_bike_mountain",
+	"EBiking":                                  "e_biking",
+	"EbikeAssistLevelPercent":                  "ebike_assist_level_percent",
+	"EbikeAssistMode":                          "ebike_assist_mode",
+	"EbikeBatteryLevel":                        "ebike_battery_level",
+	"EbikeTravelRange":                         "ebike_travel_range",
+	"Echowell":                                 "echowell",
+	"Edge1000":                                 "edge1000",
+	"Edge1000China":                            "edge1000_china",
+	"Edge1000Japan":                            "edge1000_japan",
+	"Edge1000Korea":                            "edge1000_korea",
+	"Edge1000Taiwan":                           "edge1000_taiwan",
+	"Edge1000Thai":                             "edge1000_thai",
+	"Edge1030":                                 "edge_1030",
+	"Edge1030Asia":                             "edge_1030_asia",
+	"Edge1030Bontrager":                        "edge_1030_bontrager",
+	"Edge1030Plus":                             "edge_1030_plus",
+	"Edge1030PlusAsia":                         "edge_1030_plus_asia",
+	"Edge130Asia":                              "edge_130_asia",
+	"Edge130Plus":                              "edge_130_plus",
+	"Edge130PlusAsia":                          "edge_130_plus_asia",
+	"Edge20":                                   "edge20",
+	"Edge200":                                  "edge200",
+	"Edge200Taiwan":                            "edge200_taiwan",
+	"Edge20Asia":                               "edge20_asia",
+	"Edge25":                                   "edge25",
+	"Edge25Asia":                               "edge25_asia",
+	"Edge25Jpn":                                "edge25_jpn",
+	"Edge500":                                  "edge500",
+	"Edge500China":                             "edge500_china",
+	"Edge500Japan":                             "edge500_japan",
+	"Edge500Korea":                             "edge500_korea",
+	"Edge500Taiwan":                            "edge500_taiwan",
+	"Edge510":                                  "edge510",
+	"Edge510Asia":                              "edge510_asia",
+	"Edge510Japan":                             "edge510_japan",
+	"Edge510Korea":                             "edge510_korea",
+	"Edge520":                                  "edge520",
+	"Edge520Asia":                              "edge520_asia",
+	"Edge520Japan":                             "edge520_japan",
+	"Edge520Plus":                              "edge_520_plus",
+	"Edge520PlusApac":                          "edge_520_plus_apac",
+	"Edge530":                                  "edge_530",
+	"Edge530Apac":                              "edge_530_apac",
+	"Edge800":                                  "edge800",
+	"Edge800China":                             "edge800_china",
+	"Edge800Japan":                             "edge800_japan",
+	"Edge800Korea":                             "edge800_korea",
+	"Edge800Taiwan":                            "edge800_taiwan",
+	"Edge810":                                  "edge810",
+	"Edge810China":                             "edge810_china",
+	"Edge810Japan":                             "edge810_japan",
+	"Edge810Taiwan":                            "edge810_taiwan",
+	"Edge820":                                  "edge_820",
+	"Edge820China":                             "edge_820_china",
+	"Edge820Japan":                             "edge_820_japan",
+	"Edge820Korea":                             "edge_820_korea",
+	"Edge820Sea":                               "edge_820_sea",
+	"Edge820Taiwan":                            "edge_820_taiwan",
+	"Edge830":                                  "edge_830",
+	"Edge830Apac":                              "edge_830_apac",
+	"EdgeExplore":                              "edge_explore",
+	"EdgeExplore1000":                          "edge_explore_1000",
+	"EdgeExplore820":                           "edge_explore_820",
+	"EdgeRemote":                               "edge_remote",
+	"EdgeTouring":                              "edge_touring",
+	"EightCardinal":                            "eight_cardinal",
+	"Ekaterinburg":                             "ekaterinburg",
+	"Elapsed":                                  "elapsed",
+	"ElapsedTime":                              "elapsed_time",
+	"ElbowPlankPikeJacks":                      "elbow_plank_pike_jacks",
+	"ElbowToFootLunge":                         "elbow_to_foot_lunge",
+	"ElbowToKneeCrunch":                        "elbow_to_knee_crunch",
+	"ElevHighAlert":                            "elev_high_alert",
+	"ElevLowAlert":                             "elev_low_alert",
+	"ElevSetting":                              "elev_setting",
+	"ElevatedFeetInvertedRow":                  "elevated_feet_inverted_row",
+	"ElevatedFeetPlank":                        "elevated_feet_plank",
+	"ElevatedFeetRussianTwist":                 "elevated_feet_russian_twist",
+	"ElevatedFrontFootBarbellSplitSquat":       "elevated_front_foot_barbell_split_squat",
+	"ElevatedSingleLegSquat":                   "elevated_single_leg_squat",
+	"Elevation":                                "elevation",
+	"ElevatorAbs":                              "elevator_abs",
+	"Elite":                                    "elite",
+	"Elliptical":                               "elliptical",
+	"En13319":                                  "en13319",
+	"Enabled":                                  "enabled",
+	"End":                                      "end",
+	"EndAllDepreciated":                        "end_all_depreciated",
+	"EndCns":                                   "end_cns",
+	"EndDate":                                  "end_date",
+	"EndDepreciated":                           "end_depreciated",
+	"EndN2":                                    "end_n2",
+	"EndPositionLat":                           "end_position_lat",
+	"EndPositionLong":                          "end_position_long",
+	"EndTimestamp":                             "end_timestamp",
+	"EndTimestampMs":                           "end_timestamp_ms",
+	"English":                                  "english",
+	"EnhancedAltitude":                         "enhanced_altitude",
+	"EnhancedAvgAltitude":                      "enhanced_avg_altitude",
+	"EnhancedAvgSpeed":                         "enhanced_avg_speed",
+	"EnhancedMaxAltitude":                      "enhanced_max_altitude",
+	"EnhancedMaxSpeed":                         "enhanced_max_speed",
+	"EnhancedMinAltitude":                      "enhanced_min_altitude",
+	"EnhancedSpeed":                            "enhanced_speed",
+	"Eniwetok":                                 "eniwetok",
+	"Enum":                                     "enum",
+	"EnumBatteryStatus":                        "enum_battery_status",
+	"EnumBikeLightBatteryStatus":               "enum_bike_light_battery_status",
+	"EnumBikeLightBeamAngleMode":               "enum_bike_light_beam_angle_mode",
+	"EnumBikeLightNetworkConfigType":           "enum_bike_light_network_config_type",
+	"EnumCoursePoint":                          "enum_course_point",
+	"EnumSport":                                "enum_sport",
+	"EnumTurnType":                             "enum_turn_type",
+	"EnvSensor":                                "env_sensor",
+	"EnvironmentSensorLegacy":                  "environment_sensor_legacy",
+	"Epix":                                     "epix",
+	"EpixJapan":                                "epix_japan",
+	"EpixKorea":                                "epix_korea",
+	"Equipment":                                "equipment",
+	"Erase":                                    "erase",
+	"ErrorDataOutOfRange":                      "error_data_out_of_range",
+	"ErrorIncompleteData":                      "error_incomplete_data",
+	"ErrorIrregularHeartRate":                  "error_irregular_heart_rate",
+	"ErrorNoMeasurement":                       "error_no_measurement",
+	"EstimatedTimeOfArrival":                   "estimated_time_of_arrival",
+	"EstimatedTotal":                           "estimated_total",
+	"EstonianGrid":                             "estonian_grid",
+	"EtrexTouch":                               "etrex_touch",
+	"EuropeCentralCet":                         "europe_central_cet",
+	"EuropeEasternEet":                         "europe_eastern_eet",
+	"EuropeWesternWet":                         "europe_western_wet",
+	"Event":                                    "event",
+	"EventGroup":                               "event_group",
+	"EventTimestamp":                           "event_timestamp",
+	"EventTimestamp12":                         "event_timestamp_12",
+	"EventType":                                "event_type",
+	"Evesports":                                "evesports",
+	"ExcessiveHeat":                            "excessive_heat",
+	"Exd":                                      "exd",
+	"ExdConfiguration":                         "exd_configuration",
+	"ExdDataConceptConfiguration":              "exd_data_concept_configuration",
+	"ExdDataFieldConfiguration":                "exd_data_field_configuration",
+	"ExdDataUnits":                             "exd_data_units",
+	"ExdDescriptors":                           "exd_descriptors",
+	"ExdDisplayType":                           "exd_display_type",
+	"ExdLayout":                                "exd_layout",
+	"ExdQualifiers":                            "exd_qualifiers",
+	"ExdScreenConfiguration":                   "exd_screen_configuration",
+	"Exercise":                                 "exercise",
+	"ExerciseCategory":                         "exercise_category",
+	"ExerciseName":                             "exercise_name",
+	"ExerciseTitle":                            "exercise_title",
+	"ExerciseWeight":                           "exercise_weight",
+	"ExitLeftIdx":                              "exit_left_idx",
+	"ExitRightIdx":                             "exit_right_idx",
+	"ExpireTime":                               "expire_time",
+	"ExplicitArchive":                          "explicit_archive",
+	"ExplosiveCrossoverPushUp":                 "explosive_crossover_push_up",
+	"ExplosivePushUp":                          "explosive_push_up",
+	"ExtendedPlank":                            "extended_plank",
+	"ExternalHipRaise":                         "external_hip_raise",
+	"ExtremeCold":                              "extreme_cold",
+	"ExtremeWind":                              "extreme_wind",
+	"EzBarOverheadTricepsExtension":            "ez_bar_overhead_triceps_extension",
+	"EzBarPreacherCurl":                        "ez_bar_preacher_curl",
+	"EzBarPullover":                            "ez_bar_pullover",
+	"FacePull":                                 "face_pull",
+	"FacePullWithExternalRotation":             "face_pull_with_external_rotation",
+	"Fail":                                     "fail",
+	"Failed":                                   "failed",
+	"FalcoEMotors":                             "falco_e_motors",
+	"FarmersWalk":                              "farmers_walk",
+	"FarmersWalkOnToes":                        "farmers_walk_on_toes",
+	"Farsi":                                    "farsi",
+	"Fast":                                     "fast",
+	"FatCalories":                              "fat_calories",
+	"FaveroElectronics":                        "favero_electronics",
+	"FaveroProduct":                            "favero_product",
+	"Favorite":                                 "favorite",
+	"Feedbacksports":                           "feedbacksports",
+	"Feet":                                     "feet",
+	"FeetElevatedSideToSidePushUp":             "feet_elevated_side_to_side_push_up",
+	"FeetPerHour":                              "feet_per_hour",
+	"FeetPerMin":                               "feet_per_min",
+	"Female":                                   "female",
+	"Fenix":                                    "fenix",
+	"Fenix2":                                   "fenix2",
+	"Fenix3":                                   "fenix3",
+	"Fenix3China":                              "fenix3_china",
+	"Fenix3Chronos":                            "fenix3_chronos",
+	"Fenix3ChronosAsia":                        "fenix3_chronos_asia",
+	"Fenix3Hr":                                 "fenix3_hr",
+	"Fenix3HrChn":                              "fenix3_hr_chn",
+	"Fenix3HrJpn":                              "fenix3_hr_jpn",
+	"Fenix3HrKor":                              "fenix3_hr_kor",
+	"Fenix3HrSea":                              "fenix3_hr_sea",
+	"Fenix3HrTwn":                              "fenix3_hr_twn",
+	"Fenix3Japan":                              "fenix3_japan",
+	"Fenix3Korea":                              "fenix3_korea",
+	"Fenix3Sea":                                "fenix3_sea",
+	"Fenix3Twn":                                "fenix3_twn",
+	"Fenix5":                                   "fenix5",
+	"Fenix5Asia":                               "fenix5_asia",
+	"Fenix5Plus":                               "fenix5_plus",
+	"Fenix5s":                                  "fenix5s",
+	"Fenix5sAsia":                              "fenix5s_asia",
+	"Fenix5sPlus":                              "fenix5s_plus",
+	"Fenix5sPlusApac":                          "fenix5s_plus_apac",
+	"Fenix5x":                                  "fenix5x",
+	"Fenix5xAsia":                              "fenix5x_asia",
+	"Fenix5xPlus":                              "fenix5x_plus",
+	"Fenix5xPlusApac":                          "fenix5x_plus_apac",
+	"Fenix6":                                   "fenix6",
+	"Fenix6Asia":                               "fenix6_asia",
+	"Fenix6Sport":                              "fenix6_sport",
+	"Fenix6SportAsia":                          "fenix6_sport_asia",
+	"Fenix6sAsia":                              "fenix6s_asia",
+	"Fenix6sSportAsia":                         "fenix6s_sport_asia",
+	"Fenix6x":                                  "fenix6x",
+	"Fenix6xAsia":                              "fenix6x_asia",
+	"FerryIdx":                                 "ferry_idx",
+	"FieldCapabilities":                        "field_capabilities",
+	"FieldCount":                               "field_count",
+	"FieldDefinitionNumber":                    "field_definition_number",
+	"FieldDescription":                         "field_description",
+	"FieldId":                                  "field_id",
+	"FieldName":                                "field_name",
+	"FieldNum":                                 "field_num",
+	"FigureFourSquats":                         "figure_four_squats",
+	"Fiji":                                     "fiji",
+	"File":                                     "file",
+	"FileCapabilities":                         "file_capabilities",
+	"FileCreator":                              "file_creator",
+	"FileFlags":                                "file_flags",
+	"FileId":                                   "file_id",
+	"FileUuid":                                 "file_uuid",
+	"FilteredBpm":                              "filtered_bpm",
+	"FindMyWatch":                              "find_my_watch",
+	"Finnish":                                  "finnish",
+	"FinnishGrid":                              "finnish_grid",
+	"FireHydrantKicks":                         "fire_hydrant_kicks",
+	"FireWeather":                              "fire_weather",
+	"First":                                    "first",
+	"FirstAid":                                 "first_aid",
+	"FirstCategory":                            "first_category",
+	"FirstLapIndex":                            "first_lap_index",
+	"FirstLengthIndex":                         "first_length_index",
+	"FirstStepIndex":                           "first_step_index",
+	"Firstbeat":                                "firstbeat",
+	"Fishing":                                  "fishing",
+	"FitBaseType":                              "fit_base_type",
+	"FitBaseTypeId":                            "fit_base_type_id",
+	"FitBaseUnit":                              "fit_base_unit",
+	"FitBaseUnitId":                            "fit_base_unit_id",
+	"Fitcare":                                  "fitcare",
+	"Fitness":                                  "fitness",
+	"FitnessEquipment":                         "fitness_equipment",
+	"FitnessEquipmentState":                    "fitness_equipment_state",
+	"Flags":                                    "flags",
+	"FlashFlood":                               "flash_flood",
+	"FlashFreeze":                              "flash_freeze",
+	"FlexibilityTraining":                      "flexibility_training",
+	"Float32":                                  "float32",
+	"Float64":                                  "float64",
+	"Flood":                                    "flood",
+	"FloorClimbing":                            "floor_climbing",
+	"FloorIRaise":                              "floor_i_raise",
+	"FloorInvertedShoulderPress":               "floor_inverted_shoulder_press",
+	"FloorTRaise":                              "floor_t_raise",
+	"FloorYRaise":                              "floor_y_raise",
+	"Flow":                                     "flow",
+	"FlutterKicks":                             "flutter_kicks",
+	"Flye":                                     "flye",
+	"FlyeExerciseName":                         "flye_exercise_name",
+	"Flying":                                   "flying",
+	"FoamRollerReverseCrunchOnBench":           "foam_roller_reverse_crunch_on_bench",
+	"FoamRollerReverseCrunchWithDumbbell":      "foam_roller_reverse_crunch_with_dumbbell",
+	"FoamRollerReverseCrunchWithMedicineBall": "foam_roller_reverse_crunch_with_medicine_ball",
+	"Fog":                                   "fog",
+	"Food":                                  "food",
+	"Forecast":                              "forecast",
+	"Forehand":                              "forehand",
+	"Foretrex601701":                        "foretrex_601_701",
+	"Form":                                  "form",
+	"ForwardAndBackwardLegSwings":           "forward_and_backward_leg_swings",
+	"ForwardBendBicepsCurl":                 "forward_bend_biceps_curl",
+	"FourthCategory":                        "fourth_category",
+	"Fr10":                                  "fr10",
+	"Fr10Japan":                             "fr10_japan",
+	"Fr110":                                 "fr110",
+	"Fr110Japan":                            "fr110_japan",
+	"Fr15":                                  "fr15",
+	"Fr15Japan":                             "fr15_japan",
+	"Fr210Japan":                            "fr210_japan",
+	"Fr220":                                 "fr220",
+	"Fr220China":                            "fr220_china",
+	"Fr220Japan":                            "fr220_japan",
+	"Fr220Russia":                           "fr220_russia",
+	"Fr220Taiwan":                           "fr220_taiwan",
+	"Fr225":                                 "fr225",
+	"Fr225Asia":                             "fr225_asia",
+	"Fr225SingleByteProductId":              "fr225_single_byte_product_id",
+	"Fr230":                                 "fr230",
+	"Fr230Jpn":                              "fr230_jpn",
+	"Fr235":                                 "fr235",
+	"Fr235ChinaNfc":                         "fr235_china_nfc",
+	"Fr235Japan":                            "fr235_japan",
+	"Fr235lAsia":                            "fr235l_asia",
+	"Fr245Asia":                             "fr245_asia",
+	"Fr245mAsia":                            "fr245m_asia",
+	"Fr25":                                  "fr25",
+	"Fr30":                                  "fr30",
+	"Fr301China":                            "fr301_china",
+	"Fr301Japan":                            "fr301_japan",
+	"Fr301Korea":                            "fr301_korea",
+	"Fr301Taiwan":                           "fr301_taiwan",
+	"Fr30Asia":                              "fr30_asia",
+	"Fr310xt":                               "fr310xt",
+	"Fr310xt4t":                             "fr310xt_4t",
+	"Fr35Apac":                              "fr35_apac",
+	"Fr35Hebrew":                            "fr35_hebrew",
+	"Fr35Japan":                             "fr35_japan",
+	"Fr35Korea":                             "fr35_korea",
+	"Fr405":                                 "fr405",
+	"Fr405Japan":                            "fr405_japan",
+	"Fr45Asia":                              "fr45_asia",
+	"Fr50":                                  "fr50",
+	"Fr60":                                  "fr60",
+	"Fr610":                                 "fr610",
+	"Fr610Japan":                            "fr610_japan",
+	"Fr620":                                 "fr620",
+	"Fr620China":                            "fr620_china",
+	"Fr620Japan":                            "fr620_japan",
+	"Fr620Russia":                           "fr620_russia",
+	"Fr620Taiwan":                           "fr620_taiwan",
+	"Fr630":                                 "fr630",
+	"Fr630Asia":                             "fr630_asia",
+	"Fr630Jpn":                              "fr630_jpn",
+	"Fr645":                                 "fr645",
+	"Fr645Asia":                             "fr645_asia",
+	"Fr645m":                                "fr645m",
+	"Fr645mAsia":                            "fr645m_asia",
+	"Fr70":                                  "fr70",
+	"Fr735xt":                               "fr735xt",
+	"Fr735xtApac":                           "fr735xt_apac",
+	"Fr735xtJapan":                          "fr735xt_japan",
+	"Fr745":                                 "fr745",
+	"Fr745Asia":                             "fr745_asia",
+	"Fr910xt":                               "fr910xt",
+	"Fr910xtChina":                          "fr910xt_china",
+	"Fr910xtJapan":                          "fr910xt_japan",
+	"Fr910xtKorea":                          "fr910xt_korea",
+	"Fr920xt":                               "fr920xt",
+	"Fr920xtChina":                          "fr920xt_china",
+	"Fr920xtJapan":                          "fr920xt_japan",
+	"Fr920xtTaiwan":                         "fr920xt_taiwan",
+	"Fr935":                                 "fr935",
+	"Fr935Asia":                             "fr935_asia",
+	"Fr945":                                 "fr945",
+	"Fr945Asia":                             "fr945_asia",
+	"FractionalCadence":                     "fractional_cadence",
+	"FractionalSystemTimestamp":             "fractional_system_timestamp",
+	"FractionalTimestamp":                   "fractional_timestamp",
+	"FrameNumber":                           "frame_number",
+	"Freestyle":                             "freestyle",
+	"Freeze":                                "freeze",
+	"FreezingDrizzle":                       "freezing_drizzle",
+	"FreezingFog":                           "freezing_fog",
+	"FreezingRain":                          "freezing_rain",
+	"FreezingSpray":                         "freezing_spray",
+	"French":                                "french",
+	"Frequency":                             "frequency",
+	"Frequent":                              "frequent",
+	"Fresh":                                 "fresh",
+	"Friday":                                "friday",
+	"FriendlyName":                          "friendly_name",
+	"FrogPress":                             "frog_press",
+	"FrontFootElevatedDumbbellSplitSquat":   "front_foot_elevated_dumbbell_split_squat",
+	"FrontGear":                             "front_gear",
+	"FrontGearChange":                       "front_gear_change",
+	"FrontGearNum":                          "front_gear_num",
+	"FrontGearShiftCount":                   "front_gear_shift_count",
+	"FrontKickTabletop":                     "front_kick_tabletop",
+	"FrontKneeStrike":                       "front_knee_strike",
+	"FrontRaise":                            "front_raise",
+	"Frost":                                 "frost",
+	"FullPlankPasseTwist":                   "full_plank_passe_twist",
+	"FullQuarterSplit":                      "full_quarter_split",
+	"FullScreen":                            "full_screen",
+	"Fullspeedahead":                        "fullspeedahead",
+	"FunctionalThresholdPower":              "functional_threshold_power",
+	"Gale":                                  "gale",
+	"Garmin":                                "garmin",
+	"GarminFr405Antfs":                      "garmin_fr405_antfs",
+	"GarminProduct":                         "garmin_product",
+	"Gauge":                                 "gauge",
+	"GaugeDiving":                           "gauge_diving",
+	"Gear":                                  "gear",
+	"GearChangeData":                        "gear_change_data",
+	"GearCombo":                             "gear_combo",
+	"GearRatio":                             "gear_ratio",
+	"Gears":                                 "gears",
+	"Gen3Bcm":                               "gen3_bcm",
+	"Gen3BcmSingleByteProductId":            "gen3_bcm_single_byte_product_id",
+	"Gen3Bsm":                               "gen3_bsm",
+	"Gen3BsmSingleByteProductId":            "gen3_bsm_single_byte_product_id",
+	"Gender":                                "gender",
+	"Generic":                               "generic",
+	"GeocacheNode":                          "geocache_node",
+	"Geocaching":                            "geocaching",
+	"Geonaute":                              "geonaute",
+	"German":                                "german",
+	"GermanGrid":                            "german_grid",
+	"GetUpSitUp":                            "get_up_sit_up",
+	"GfHigh":                                "gf_high",
+	"GfLow":                                 "gf_low",
+	"GhdBackExtensions":                     "ghd_back_extensions",
+	"GiantManufacturingCo":                  "giant_manufacturing_co",
+	"GlideRatio":                            "glide_ratio",
+	"GlobalId":                              "global_id",
+	"Goal":                                  "goal",
+	"GoalRecurrence":                        "goal_recurrence",
+	"GoalSource":                            "goal_source",
+	"Goals":                                 "goals",
+	"GobletSquat":                           "goblet_squat",
+	"Golf":                                  "golf",
+	"GolfCourseDownload":                    "golf_course_download",
+	"Good":                                  "good",
+	"GoodMorning":                           "good_morning",
+	"GopherSport":                           "gopher_sport",
+	"GpsAccuracy":                           "gps_accuracy",
+	"GpsElevation":                          "gps_elevation",
+	"GpsEphemerisDownload":                  "gps_ephemeris_download",
+	"GpsEphemerisDownloadEnabled":           "gps_ephemeris_download_enabled",
+	"GpsHeading":                            "gps_heading",
+	"GpsInvalid":                            "gps_invalid",
+	"GpsMetadata":                           "gps_metadata",
+	"GpsSignalStrength":                     "gps_signal_strength",
+	"Gpsmap66":                              "gpsmap66",
+	"Gpulse":                                "gpulse",
+	"Grade":                                 "grade",
+	"Graph":                                 "graph",
+	"Gravaa":                                "gravaa",
+	"GravaaByte":                            "gravaa_byte",
+	"GravelCycling":                         "gravel_cycling",
+	"Greek":                                 "greek",
+	"Greenteg":                              "greenteg",
+	"Grit":                                  "grit",
+	"Groiners":                              "groiners",
+	"GroundContactTime":                     "ground_contact_time",
+	"Group":                                 "group",
+	"GroupPrimaryKey":                       "group_primary_key",
+	"GrouptrackEnabled":                     "grouptrack_enabled",
+	"GunslingerLunge":                       "gunslinger_lunge",
+	"GyroCalFactor":                         "gyro_cal_factor",
+	"GyroX":                                 "gyro_x",
+	"GyroY":                                 "gyro_y",
+	"GyroZ":                                 "gyro_z",
+	"Gyroscope":                             "gyroscope",
+	"GyroscopeData":                         "gyroscope_data",
+	"Hail":                                  "hail",
+	"HalfHorizontal":                        "half_horizontal",
+	"HalfHorizontalBottomSplit":             "half_horizontal_bottom_split",
+	"HalfHorizontalTopSplit":                "half_horizontal_top_split",
+	"HalfKneelingRotation":                  "half_kneeling_rotation",
+	"HalfKneelingRotationalChop":            "half_kneeling_rotational_chop",
+	"HalfKneelingRotationalReverseChop":     "half_kneeling_rotational_reverse_chop",
+	"HalfKneelingStabilityChop":             "half_kneeling_stability_chop",
+	"HalfKneelingStabilityReverseChop":      "half_kneeling_stability_reverse_chop",
+	"HalfTurkishGetUp":                      "half_turkish_get_up",
+	"HalfVertical":                          "half_vertical",
+	"HalfVerticalLeftSplit":                 "half_vertical_left_split",
+	"HalfVerticalRightSplit":                "half_vertical_right_split",
+	"HammerCurlToPress":                     "hammer_curl_to_press",
+	"Hammerhead":                            "hammerhead",
+	"HandCycling":                           "hand_cycling",
+	"HandReleasePushUp":                     "hand_release_push_up",
+	"HandstandPushUp":                       "handstand_push_up",
+	"HangGliding":                           "hang_gliding",
+	"HangTime":                              "hang_time",
+	"HangingHurdle":                         "hanging_hurdle",
+	"HangingKneeRaise":                      "hanging_knee_raise",
+	"HangingKneeRaiseObliqueCrunch":         "hanging_knee_raise_oblique_crunch",
+	"HangingLegRaise":                       "hanging_leg_raise",
+	"HangingSingleLegRaise":                 "hanging_single_leg_raise",
+	"HardFreeze":                            "hard_freeze",
+	"HardwareVersion":                       "hardware_version",
+	"HazardousSeas":                         "hazardous_seas",
+	"Hazy":                                  "hazy",
+	"Heading":                               "heading",
+	"Healthandlife":                         "healthandlife",
+	"HeartRate":                             "heart_rate",
+	"HeartRateAntplusDeviceType":            "heart_rate_antplus_device_type",
+	"HeartRateLap":                          "heart_rate_lap",
+	"HeartRateLocalDeviceType":              "heart_rate_local_device_type",
+	"HeartRateReserve":                      "heart_rate_reserve",
+	"HeartRateSource":                       "heart_rate_source",
+	"HeartRateSourceType":                   "heart_rate_source_type",
+	"HeartRateType":                         "heart_rate_type",
+	"HeartRateZone":                         "heart_rate_zone",
+	"Heat":                                  "heat",
+	"HeavyFreezingSpray":                    "heavy_freezing_spray",
+	"HeavyRain":                             "heavy_rain",
+	"HeavyRainSnow":                         "heavy_rain_snow",
+	"HeavySnow":                             "heavy_snow",
+	"HeavySnowAlert":                        "heavy_snow_alert",
+	"Hebrew":                                "hebrew",
+	"HectoPascals":                          "hecto_pascals",
+	"Height":                                "height",
+	"HeightSetting":                         "height_setting",
+	"HeliumContent":                         "helium_content",
+	"Helsinki":                              "helsinki",
+	"HexDumbbellHold":                       "hex_dumbbell_hold",
+	"High":                                  "high",
+	"HighBoxJump":                           "high_box_jump",
+	"HighBpm":                               "high_bpm",
+	"HighHeatAndHumidity":                   "high_heat_and_humidity",
+	"HighPullBurpee":                        "high_pull_burpee",
+	"HighSurf":                              "high_surf",
+	"HighTemperature":                       "high_temperature",
+	"HighValue":                             "high_value",
+	"HighVisibility":                        "high_visibility",
+	"HighWaterLevel":                        "high_water_level",
+	"HighWind":                              "high_wind",
+	"Hiking":                                "hiking",
+	"HipCircles":                            "hip_circles",
+	"HipCrossover":                          "hip_crossover",
+	"HipExtensionAndCross":                  "hip_extension_and_cross",
+	"HipRaise":                              "hip_raise",
+	"HipRaiseExerciseName":                  "hip_raise_exercise_name",
+	"HipRaiseWithFeetOnSwissBall":           "hip_raise_with_feet_on_swiss_ball",
+	"HipRaiseWithHeadOnBosuBall":            "hip_raise_with_head_on_bosu_ball",
+	"HipRaiseWithHeadOnSwissBall":           "hip_raise_with_head_on_swiss_ball",
+	"HipRaiseWithKneeSqueeze":               "hip_raise_with_knee_squeeze",
+	"HipStability":                          "hip_stability",
+	"HipStabilityExerciseName":              "hip_stability_exercise_name",
+	"HipSwing":                              "hip_swing",
+	"HipSwingExerciseName":                  "hip_swing_exercise_name",
+	"Hmm":                                   "hmm",
+	"HollowHoldAndRoll":                     "hollow_hold_and_roll",
+	"HollowRock":                            "hollow_rock",
+	"Holux":                                 "holux",
+	"HongKong":                              "hong_kong",
+	"HorsCategory":                          "hors_category",
+	"HorsebackRiding":                       "horseback_riding",
+	"HostingProvider":                       "hosting_provider",
+	"Hour12":                                "hour12",
+	"Hour12WithSeconds":                     "hour_12_with_seconds",
+	"Hour24":                                "hour24",
+	"Hour24WithSeconds":                     "hour_24_with_seconds",
+	"HourlyForecast":                        "hourly_forecast",
+	"Hours":                                 "hours",
+	"HoveringSitUp":                         "hovering_sit_up",
+	"Hr":                                    "hr",
+	"HrCalcType":                            "hr_calc_type",
+	"HrGreaterThan":                         "hr_greater_than",
+	"HrHighAlert":                           "hr_high_alert",
+	"HrLessThan":                            "hr_less_than",
+	"HrLowAlert":                            "hr_low_alert",
+	"HrSetting":                             "hr_setting",
+	"HrType":                                "hr_type",
+	"HrZone":                                "hr_zone",
+	"HrZoneCalc":                            "hr_zone_calc",
+	"Hrm1":                                  "hrm1",
+	"Hrm2ss":                                "hrm2ss",
+	"Hrm3ss":                                "hrm3ss",
+	"Hrm4Run":                               "hrm4_run",
+	"Hrm4RunSingleByteProductId":            "hrm4_run_single_byte_product_id",
+	"HrmAntId":                              "hrm_ant_id",
+	"HrmAntIdTransType":                     "hrm_ant_id_trans_type",
+	"HrmDual":                               "hrm_dual",
+	"HrmProfile":                            "hrm_profile",
+	"HrmRun":                                "hrm_run",
+	"HrmRunSingleByteProductId":             "hrm_run_single_byte_product_id",
+	"HrmTri":                                "hrm_tri",
+	"HrmTriSingleByteProductId":             "hrm_tri_single_byte_product_id",
+	"Hrv":                                   "hrv",
+	"HugATree":                              "hug_a_tree",
+	"Humidex":                               "humidex",
+	"HumidexAndHealth":                      "humidex_and_health",
+	"Hungarian":                             "hungarian",
+	"HungarianGrid":                         "hungarian_grid",
+	"Hunting":                               "hunting",
+	"Hurricane":                             "hurricane",
+	"HurricaneForceWind":                    "hurricane_force_wind",
+	"HwFail":                                "hw_fail",
+	"Hydrological":                          "hydrological",
+	"Hyperextension":                        "hyperextension",
+	"HyperextensionExerciseName":            "hyperextension_exercise_name",
+	"Ibike":                                 "ibike",
+	"IceSkating":                            "ice_skating",
+	"IceStorm":                              "ice_storm",
+	"Iceland":                               "iceland",
+	"IcelandicGrid":                         "icelandic_grid",
+	"Icg":                                   "icg",
+	"Icon":                                  "icon",
+	"IconIdxCnt":                            "icon_idx_cnt",
+	"IconInvIdx":                            "icon_inv_idx",
+	"Id":                                    "id",
+	"IdBike":                                "id_bike",
+	"Idle":                                  "idle",
+	"Idt":                                   "idt",
+	"IfitCom":                               "ifit_com",
+	"IforPowell":                            "ifor_powell",
+	"Igpsport":                              "igpsport",
+	"Im":                                    "im",
+	"InUse":                                 "in_use",
+	"InchesHg":                              "inches_hg",
+	"InchingElbowPlank":                     "inching_elbow_plank",
+	"Inchworm":                              "inchworm",
+	"InchwormToSidePlank":                   "inchworm_to_side_plank",
+	"IncidentDetection":                     "incident_detection",
+	"IncidentDetectionEnabled":              "incident_detection_enabled",
+	"InclineBarbellBenchPress":              "incline_barbell_bench_press",
+	"InclineDip":                            "incline_dip",
+	"InclineDumbbellBenchPress":             "incline_dumbbell_bench_press",
+	"InclineDumbbellBicepsCurl":             "incline_dumbbell_biceps_curl",
+	"InclineDumbbellFlye":                   "incline_dumbbell_flye",
+	"InclineDumbbellShrug":                  "incline_dumbbell_shrug",
+	"InclineEzBarLyingTricepsExtension":     "incline_ez_bar_lying_triceps_extension",
+	"InclineIRaise":                         "incline_i_raise",
+	"InclineLRaise":                         "incline_l_raise",
+	"InclineOffsetThumbDumbbellCurl":        "incline_offset_thumb_dumbbell_curl",
+	"InclinePushUp":                         "incline_push_up",
+	"InclineRearLegExtension":               "incline_rear_leg_extension",
+	"InclineReverseCrunch":                  "incline_reverse_crunch",
+	"InclineSmithMachineBenchPress":         "incline_smith_machine_bench_press",
+	"InclineTRaise":                         "incline_t_raise",
+	"InclineWRaise":                         "incline_w_raise",
+	"InclineYRaise":                         "incline_y_raise",
+	"IndexSmartScale":                       "index_smart_scale",
+	"IndiaZone0":                            "india_zone_0",
+	"Individual":                            "individual",
+	"Indonesian":                            "indonesian",
+	"IndonesianEquatorial":                  "indonesian_equatorial",
+	"IndonesianIrian":                       "indonesian_irian",
+	"IndonesianSouthern":                    "indonesian_southern",
+	"IndoorCycling":                         "indoor_cycling",
+	"IndoorRowing":                          "indoor_rowing",
+	"IndoorRunning":                         "indoor_running",
+	"IndoorSkiing":                          "indoor_skiing",
+	"IndoorWalking":                         "indoor_walking",
+	"Infinite":                              "infinite",
+	"InlandHurricane":                       "inland_hurricane",
+	"InlandTropicalStorm":                   "inland_tropical_storm",
+	"InlineSkating":                         "inline_skating",
+	"InnerThighCircles":                     "inner_thigh_circles",
+	"InnerThighLift":                        "inner_thigh_lift",
+	"InnerThighSideLift":                    "inner_thigh_side_lift",
+	"Inpeak":                                "inpeak",
+	"InsideRideTechnologies":                "inside_ride_technologies",
+	"InstantInput":                          "instant_input",
+	"Instantaneous":                         "instantaneous",
+	"Intensity":                             "intensity",
+	"IntensityFactor":                       "intensity_factor",
+	"Interval":                              "interval",
+	"InvertedHamstringStretch":              "inverted_hamstring_stretch",
+	"InvertedRowWithFeetOnSwissBall":        "inverted_row_with_feet_on_swiss_ball",
+	"InvertedShoulderPress":                 "inverted_shoulder_press",
+	"Iqsquare":                              "iqsquare",
+	"IrishGrid":                             "irish_grid",
+	"IrishTransverse":                       "irish_transverse",
+	"Irkutsk":                               "irkutsk",
+	"Irregular":                             "irregular",
+	"IsSigned":                              "is_signed",
+	"Islamabad":                             "islamabad",
+	"IsometricBarbellBenchPress":            "isometric_barbell_bench_press",
+	"IsometricExplosiveBodyWeightJumpSquat": "isometric_explosive_body_weight_jump_squat",
+	"IsometricExplosivePushUp":              "isometric_explosive_push_up",
+	"IssueTime":                             "issue_time",
+	"Italian":                               "italian",
+	"Jakarta":                               "jakarta",
+	"Japanese":                              "japanese",
+	"Jetblack":                              "jetblack",
+	"Jog":                                   "jog",
+	"JohnsonHealthTech":                     "johnson_health_tech",
+	"JudoPushUp":                            "judo_push_up",
+	"Jump":                                  "jump",
+	"JumpCount":                             "jump_count",
+	"JumpRope":                              "jump_rope",
+	"JumpRopeCrossover":                     "jump_rope_crossover",
+	"JumpRopeJog":                           "jump_rope_jog",
+	"JumpingJacks":                          "jumping_jacks",
+	"JumpingPullUps":                        "jumping_pull_ups",
+	"Jumpmaster":                            "jumpmaster",
+	"Kabul":                                 "kabul",
+	"Kaliningrad":                           "kaliningrad",
+	"KansasCity":                            "kansas_city",
+	"Kathmandu":                             "kathmandu",
+	"Kayaking":                              "kayaking",
+	"KbsOverhead":                           "kbs_overhead",
+	"KettlebellBicepsCurl":                  "kettlebell_biceps_curl",
+	"KettlebellChestPress":                  "kettlebell_chest_press",
+	"KettlebellFloorToShelf":                "kettlebell_floor_to_shelf",
+	"KettlebellFlye":                        "kettlebell_flye",
+	"KettlebellLegRaises":                   "kettlebell_leg_raises",
+	"KettlebellRow":                         "kettlebell_row",
+	"KettlebellSitUp":                       "kettlebell_sit_up",
+	"KettlebellSquat":                       "kettlebell_squat",
+	"KettlebellSwing":                       "kettlebell_swing",
+	"KettlebellSwingOverhead":               "kettlebell_swing_overhead",
+	"KettlebellSwingWithFlipToSquat":        "kettlebell_swing_with_flip_to_squat",
+	"KettlebellWindmill":                    "kettlebell_windmill",
+	"KeyAndMessages":                        "key_and_messages",
+	"KeyAndMessagesAndSmartNotifications":   "key_and_messages_and_smart_notifications",
+	"KeyAndMessagesNight":                   "key_and_messages_night",
+	"Kicks":                                 "kicks",
+	"Kilofeet":                              "kilofeet",
+	"Kilogram":                              "kilogram",
+	"Kilojoules":                            "kilojoules",
+	"Kilometers":                            "kilometers",
+	"KilometersPerHour":                     "kilometers_per_hour",
+	"Kinetic":                               "kinetic",
+	"KineticByKurt":                         "kinetic_by_kurt",
+	"KippingPullUp":                         "kipping_pull_up",
+	"Kitesurfing":                           "kitesurfing",
+	"KneeFolds":                             "knee_folds",
+	"KneeRaises":                            "knee_raises",
+	"KneelingAbWheel":                       "kneeling_ab_wheel",
+	"KneelingCableCrunch":                   "kneeling_cable_crunch",
+	"KneelingCrossCrunch":                   "kneeling_cross_crunch",
+	"KneelingLatPulldown":                   "kneeling_lat_pulldown",
+	"KneelingObliqueCableCrunch":            "kneeling_oblique_cable_crunch",
+	"KneelingPlank":                         "kneeling_plank",
+	"KneelingPushUp":                        "kneeling_push_up",
+	"KneelingRearFlye":                      "kneeling_rear_flye",
+	"KneelingRotationalChop":                "kneeling_rotational_chop",
+	"KneelingRotationalReverseChop":         "kneeling_rotational_reverse_chop",
+	"KneelingSidePlankWithLegLift":          "kneeling_side_plank_with_leg_lift",
+	"KneelingStabilityChop":                 "kneeling_stability_chop",
+	"KneelingSuperman":                      "kneeling_superman",
+	"KneelingUnderhandGripLatPulldown":      "kneeling_underhand_grip_lat_pulldown",
+	"KneelingWoodchopper":                   "kneeling_woodchopper",
+	"KneesToElbow":                          "knees_to_elbow",
+	"Kom":                                   "kom",
+	"Korean":                                "korean",
+	"Krasnoyarsk":                           "krasnoyarsk",
+	"LPullUp":                               "l_pull_up",
+	"LactateThresholdAutodetectEnabled":     "lactate_threshold_autodetect_enabled",
+	"Lagos":                                 "lagos",
+	"LakeEffectBlowingSnow":                 "lake_effect_blowing_snow",
+	"LakeEffectSnow":                        "lake_effect_snow",
+	"LakeWind":                              "lake_wind",
+	"LakeshoreFlood":                        "lakeshore_flood",
+	"Landscape":                             "landscape",
+	"LandscapeFlipped":                      "landscape_flipped",
+	"Language":                              "language",
+	"LanguageBits0":                         "language_bits_0",
+	"LanguageBits1":                         "language_bits_1",
+	"LanguageBits2":                         "language_bits_2",
+	"LanguageBits3":                         "language_bits_3",
+	"LanguageBits4":                         "language_bits_4",
+	"Languages":                             "languages",
+	"Lap":                                   "lap",
+	"LapPercentMaximum":                     "lap_percent_maximum",
+	"LapSwimming":                           "lap_swimming",
+	"LapTrigger":                            "lap_trigger",
+	"Laps":                                  "laps",
+	"LasVegas":                              "las_vegas",
+	"LastLap":                               "last_lap",
+	"LastSport":                             "last_sport",
+	"LatPullDownWithRow":                    "lat_pull_down_with_row",
+	"LatPulldown":                           "lat_pulldown",
+	"LateralBodyAccelValid":                 "lateral_body_accel_valid",
+	"LateralDuckUnder":                      "lateral_duck_under",
+	"LateralDumbbellStepUp":                 "lateral_dumbbell_step_up",
+	"LateralLeapAndHop":                     "lateral_leap_and_hop",
+	"LateralPlyoSquats":                     "lateral_plyo_squats",
+	"LateralRaise":                          "lateral_raise",
+	"LateralRaiseExerciseName":              "lateral_raise_exercise_name",
+	"LateralRoll":                           "lateral_roll",
+	"LateralSlide":                          "lateral_slide",
+	"LateralStepover":                       "lateral_stepover",
+	"LateralWalksWithBandAtAnkles":          "lateral_walks_with_band_at_ankles",
+	"LatitudeLimited":                       "latitude_limited",
+	"Latvian":                               "latvian",
+	"LatvianGrid":                           "latvian_grid",
+	"LawnmowerLunge":                        "lawnmower_lunge",
+	"Layout":                                "layout",
+	"LeaderActivityId":                      "leader_activity_id",
+	"LeaderActivityIdString":                "leader_activity_id_string",
+	"LeaderGroupPrimaryKey":                 "leader_group_primary_key",
+	"LeaderTime":                            "leader_time",
+	"LeaderType":                            "leader_type",
+	"LeaningDumbbellLateralRaise":           "leaning_dumbbell_lateral_raise",
+	"Left":                                  "left",
+	"LeftAbdomen":                           "left_abdomen",
+	"LeftArm":                               "left_arm",
+	"LeftBicep":                             "left_bicep",
+	"LeftBrachioradialis":                   "left_brachioradialis",
+	"LeftCalf":                              "left_calf",
+	"LeftChest":                             "left_chest",
+	"LeftForearmExtensors":                  "left_forearm_extensors",
+	"LeftFork":                              "left_fork",
+	"LeftGlute":                             "left_glute",
+	"LeftGroundContactTimeBalance":          "left_ground_contact_time_balance",
+	"LeftHamstring":                         "left_hamstring",
+	"LeftLeg":                               "left_leg",
+	"LeftLowerBack":                         "left_lower_back",
+	"LeftPco":                               "left_pco",
+	"LeftPedalSmoothness":                   "left_pedal_smoothness",
+	"LeftPlatformCenterOffset":              "left_platform_center_offset",
+	"LeftPowerPhase":                        "left_power_phase",
+	"LeftPowerPhaseFinishAngle":             "left_power_phase_finish_angle",
+	"LeftPowerPhasePeak":                    "left_power_phase_peak",
+	"LeftPowerPhaseStartAngle":              "left_power_phase_start_angle",
+	"LeftQuad":                              "left_quad",
+	"LeftRightBalance":                      "left_right_balance",
+	"LeftRightBalance100":                   "left_right_balance_100",
+	"LeftShin":                              "left_shin",
+	"LeftShoulder":                          "left_shoulder",
+	"LeftTorqueEffectiveness":               "left_torque_effectiveness",
+	"LeftTricep":                            "left_tricep",
+	"LeftUpperBack":                         "left_upper_back",
+	"LegCircles":                            "leg_circles",
+	"LegCurl":                               "leg_curl",
+	"LegCurlExerciseName":                   "leg_curl_exercise_name",
+	"LegExtensions":                         "leg_extensions",
+	"LegLevers":                             "leg_levers",
+	"LegLift":                               "leg_lift",
+	"LegLiftInExternalRotation":             "leg_lift_in_external_rotation",
+	"LegLoweringDrill":                      "leg_lowering_drill",
+	"LegPress":                              "leg_press",
+	"LegRaise":                              "leg_raise",
+	"LegRaiseExerciseName":                  "leg_raise_exercise_name",
+	"LemondFitness":                         "lemond_fitness",
+	"Length":                                "length",
+	"LengthType":                            "length_type",
+	"Leomo":                                 "leomo",
+	"LesSuetesWind":                         "les_suetes_wind",
+	"LevBatteryConsumption":                 "lev_battery_consumption",
+	"Level":                                 "level",
+	"LevelMax":                              "level_max",
+	"LevelShift":                            "level_shift",
+	"Lezyne":                                "lezyne",
+	"LifeTimeFitness":                       "life_time_fitness",
+	"Lifebeam":                              "lifebeam",
+	"LightElectricVehicle":                  "light_electric_vehicle",
+	"LightNetworkMode":                      "light_network_mode",
+	"LightRain":                             "light_rain",
+	"LightRainSnow":                         "light_rain_snow",
+	"LightSnow":                             "light_snow",
+	"Lights":                                "lights",
+	"LimitsTechnology":                      "limits_technology",
+	"Lisbon":                                "lisbon",
+	"LiveTrack":                             "live_track",
+	"LiveTrackAutoStart":                    "live_track_auto_start",
+	"LiveTrackMessaging":                    "live_track_messaging",
+	"LiveTrackingEnabled":                   "live_tracking_enabled",
+	"Local":                                 "local",
+	"LocalDeviceType":                       "local_device_type",
+	"LocalId":                               "local_id",
+	"LocalMax":                              "local_max",
+	"LocalMin":                              "local_min",
+	"LocalTimestamp":                        "local_timestamp",
+	"LocaltimeIntoDay":                      "localtime_into_day",
+	"Location":                              "location",
+	"LogHrv":                                "log_hrv",
+	"London":                                "london",
+	"Look":                                  "look",
+	"Loran":                                 "loran",
+	"LosAngeles":                            "los_angeles",
+	"Low":                                   "low",
+	"LowLungeWithIsometricAdduction":        "low_lunge_with_isometric_adduction",
+	"LowSideToSideLunge":                    "low_side_to_side_lunge",
+	"LowTemperature":                        "low_temperature",
+	"LowWater":                              "low_water",
+	"LowerLift":                             "lower_lift",
+	"Lunge":                                 "lunge",
+	"LungeExerciseName":                     "lunge_exercise_name",
+	"LungeMatrix":                           "lunge_matrix",
+	"LungeWithArmReach":                     "lunge_with_arm_reach",
+	"LungeWithDiagonalReach":                "lunge_with_diagonal_reach",
+	"LungeWithSideBend":                     "lunge_with_side_bend",
+	"Luxottica":                             "luxottica",
+	"LyingConcentrationCableCurl":           "lying_concentration_cable_curl",
+	"LyingDumbbellPulloverToExtension":      "lying_dumbbell_pullover_to_extension",
+	"LyingDumbbellRaise":                    "lying_dumbbell_raise",
+	"LyingExternalRotation":                 "lying_external_rotation",
+	"LyingEzBarTricepsExtension":            "lying_ez_bar_triceps_extension",
+	"LyingReversePlank":                     "lying_reverse_plank",
+	"LyingStraightLegRaise":                 "lying_straight_leg_raise",
+	"LyingTricepsExtensionToCloseGripBenchPress": "lying_triceps_extension_to_close_grip_bench_press",
+	"Madrid":                               "madrid",
+	"MagInvalid":                           "mag_invalid",
+	"MagX":                                 "mag_x",
+	"MagY":                                 "mag_y",
+	"MagZ":                                 "mag_z",
+	"Magadan":                              "magadan",
+	"Magellan":                             "magellan",
+	"Magene":                               "magene",
+	"MagneticHeading":                      "magnetic_heading",
+	"Magneticdays":                         "magneticdays",
+	"MagnetometerData":                     "magnetometer_data",
+	"Magtonic":                             "magtonic",
+	"Magura":                               "magura",
+	"MahleEbikemotion":                     "mahle_ebikemotion",
+	"MaidenheadGrid":                       "maidenhead_grid",
+	"Malaysian":                            "malaysian",
+	"Male":                                 "male",
+	"ManMakers":                            "man_makers",
+	"Manitoba":                             "manitoba",
+	"Manual":                               "manual",
+	"Manufacturer":                         "manufacturer",
+	"ManufacturerId":                       "manufacturer_id",
+	"Map":                                  "map",
+	"Map3SampleMean":                       "map_3_sample_mean",
+	"MapEveningValues":                     "map_evening_values",
+	"MapMorningValues":                     "map_morning_values",
+	"MarchingHipRaise":                     "marching_hip_raise",
+	"MarchingHipRaiseWithFeetOnASwissBall": "marching_hip_raise_with_feet_on_a_swiss_ball",
+	"MarineWeather":                        "marine_weather",
+	"Marker":                               "marker",
+	"MarqAdventurer":                       "marq_adventurer",
+	"MarqAdventurerAsia":                   "marq_adventurer_asia",
+	"MarqAthlete":                          "marq_athlete",
+	"MarqAthleteAsia":                      "marq_athlete_asia",
+	"MarqAviator":                          "marq_aviator",
+	"MarqAviatorAsia":                      "marq_aviator_asia",
+	"MarqCaptain":                          "marq_captain",
+	"MarqCaptainAsia":                      "marq_captain_asia",
+	"MarqCommander":                        "marq_commander",
+	"MarqCommanderAsia":                    "marq_commander_asia",
+	"MarqDriver":                           "marq_driver",
+	"MarqDriverAsia":                       "marq_driver_asia",
+	"MarqExpedition":                       "marq_expedition",
+	"MarqExpeditionAsia":                   "marq_expedition_asia",
+	"MarqGolfer":                           "marq_golfer",
+	"MarqGolferAsia":                       "marq_golfer_asia",
+	"Mask":                                 "mask",
+	"Match":                                "match",
+	"Max":                                  "max",
+	"MaxAltitude":                          "max_altitude",
+	"MaxBallSpeed":                         "max_ball_speed",
+	"MaxCadence":                           "max_cadence",
+	"MaxCadencePosition":                   "max_cadence_position",
+	"MaxCount":                             "max_count",
+	"MaxDepth":                             "max_depth",
+	"MaxFractionalCadence":                 "max_fractional_cadence",
+	"MaxHeartRate":                         "max_heart_rate",
+	"MaxLevMotorPower":                     "max_lev_motor_power",
+	"MaxNegGrade":                          "max_neg_grade",
+	"MaxNegVerticalSpeed":                  "max_neg_vertical_speed",
+	"MaxPerFile":                           "max_per_file",
+	"MaxPerFileType":                       "max_per_file_type",
+	"MaxPosGrade":                          "max_pos_grade",
+	"MaxPosVerticalSpeed":                  "max_pos_vertical_speed",
+	"MaxPower":                             "max_power",
+	"MaxPowerPosition":                     "max_power_position",
+	"MaxRunningCadence":                    "max_running_cadence",
+	"MaxSaturatedHemoglobinPercent":        "max_saturated_hemoglobin_percent",
+	"MaxSize":                              "max_size",
+	"MaxSpeed":                             "max_speed",
+	"MaxTemperature":                       "max_temperature",
+	"MaxTotalHemoglobinConc":               "max_total_hemoglobin_conc",
+	"Maximum":                              "maximum",
+	"Maximum24h":                           "maximum_24h",
+	"MaximumAverage":                       "maximum_average",
+	"MaximumLap":                           "maximum_lap",
+	"MaxwellGuider":                        "maxwell_guider",
+	"Mbars":                                "mbars",
+	"McgillCurlUp":                         "mcgill_curl_up",
+	"MeanArterialPressure":                 "mean_arterial_pressure",
+	"MedicineBallAlternatingVUp":           "medicine_ball_alternating_v_up",
+	"MedicineBallChestPass":                "medicine_ball_chest_pass",
+	"MedicineBallDeadliftToReach":          "medicine_ball_deadlift_to_reach",
+	"MedicineBallLegDrops":                 "medicine_ball_leg_drops",
+	"MedicineBallMountainClimber":          "medicine_ball_mountain_climber",
+	"MedicineBallOverheadThrows":           "medicine_ball_overhead_throws",
+	"MedicineBallPushUp":                   "medicine_ball_push_up",
+	"MedicineBallSideThrow":                "medicine_ball_side_throw",
+	"MedicineBallSitUp":                    "medicine_ball_sit_up",
+	"MedicineBallSlam":                     "medicine_ball_slam",
+	"MedicineBallVUp":                      "medicine_ball_v_up",
+	"MedicineBallWoodChops":                "medicine_ball_wood_chops",
+	"Medium":                               "medium",
+	"Memo":                                 "memo",
+	"MemoGlob":                             "memo_glob",
+	"MesgCapabilities":                     "mesg_capabilities",
+	"MesgCount":                            "mesg_count",
+	"MesgData":                             "mesg_data",
+	"MesgId":                               "mesg_id",
+	"MesgNum":                              "mesg_num",
+	"MessageCount":                         "message_count",
+	"MessageIndex":                         "message_index",
+	"MessageNumber":                        "message_number",
+	"MetZone":                              "met_zone",
+	"MetabolicAge":                         "metabolic_age",
+	"Metalogics":                           "metalogics",
+	"Meters":                               "meters",
+	"MetersPerHour":                        "meters_per_hour",
+	"MetersPerMin":                         "meters_per_min",
+	"MetersPerSec":                         "meters_per_sec",
+	"Metric":                               "metric",
+	"Metrigear":                            "metrigear",
+	"MexicoCentral":                        "mexico_central",
+	"MexicoMountain":                       "mexico_mountain",
+	"MexicoPacific":                        "mexico_pacific",
+	"MfgRangeMax":                          "mfg_range_max",
+	"MfgRangeMin":                          "mfg_range_min",
+	"MgrsGrid":                             "mgrs_grid",
+	"Miami":                                "miami",
+	"Microprogram":                         "microprogram",
+	"MidAtlantic":                          "mid_atlantic",
+	"MiddleFork":                           "middle_fork",
+	"Miles":                                "miles",
+	"MilesPerHour":                         "miles_per_hour",
+	"Military":                             "military",
+	"Millimeters":                          "millimeters",
+	"Milliseconds":                         "milliseconds",
+	"Min":                                  "min",
+	"MinAltitude":                          "min_altitude",
+	"MinHeartRate":                         "min_heart_rate",
+	"MinSaturatedHemoglobinPercent":        "min_saturated_hemoglobin_percent",
+	"MinTotalHemoglobinConc":               "min_total_hemoglobin_conc",
+	"Minimal":                              "minimal",
+	"Minimum":                              "minimum",
+	"Minimum24h":                           "minimum_24h",
+	"Minneapolis":                          "minneapolis",
+	"Minoura":                              "minoura",
+	"Minutes":                              "minutes",
+	"MioMagellan":                          "mio_magellan",
+	"MioTechnologyEurope":                  "mio_technology_europe",
+	"Mixed":                                "mixed",
+	"MixedGripChinUp":                      "mixed_grip_chin_up",
+	"MixedGripPullUp":                      "mixed_grip_pull_up",
+	"MixedSurface":                         "mixed_surface",
+	"MmHg":                                 "mm_hg",
+	"Mode":                                 "mode",
+	"Model":                                "model",
+	"ModerateActivityMinutes":              "moderate_activity_minutes",
+	"Modern":                               "modern",
+	"ModifiedFrontLever":                   "modified_front_lever",
+	"ModifiedInvertedRow":                  "modified_inverted_row",
+	"ModifiedMountainClimberAndExtension":  "modified_mountain_climber_and_extension",
+	"ModifiedPilatesRollUpWithBall":        "modified_pilates_roll_up_with_ball",
+	"ModifiedSitUp":                        "modified_sit_up",
+	"ModifiedSwedishGrid":                  "modified_swedish_grid",
+	"MonarkExercise":                       "monark_exercise",
+	"Monday":                               "monday",
+	"Mongolian":                            "mongolian",
+	"Monitoring":                           "monitoring",
+	"MonitoringA":                          "monitoring_a",
+	"MonitoringB":                          "monitoring_b",
+	"MonitoringDaily":                      "monitoring_daily",
+	"MonitoringInfo":                       "monitoring_info",
+	"MonthDay":                             "month_day",
+	"Monthly":                              "monthly",
+	"Moscow":                               "moscow",
+	"MostlyCloudy":                         "mostly_cloudy",
+	"Motocross":                            "motocross",
+	"MotorPower":                           "motor_power",
+	"Motorcycling":                         "motorcycling",
+	"Mountain":                             "mountain",
+	"MountainClimber":                      "mountain_climber",
+	"MountainClimberOnSlidingDiscs":        "mountain_climber_on_sliding_discs",
+	"MountainClimberWithFeetOnBosuBall":    "mountain_climber_with_feet_on_bosu_ball",
+	"MountainClimberWithHandsOnBench":      "mountain_climber_with_hands_on_bench",
+	"MountainClimberWithHandsOnSwissBall":  "mountain_climber_with_hands_on_swiss_ball",
+	"Mountaineering":                       "mountaineering",
+	"MountingSide":                         "mounting_side",
+	"MoveAlertEnabled":                     "move_alert_enabled",
+	"Moving":                               "moving",
+	"Moxy":                                 "moxy",
+	"MultiGasDiving":                       "multi_gas_diving",
+	"MultiSportSpeedDistance":              "multi_sport_speed_distance",
+	"Multisport":                           "multisport",
+	"Munich":                               "munich",
+	"Muscat":                               "muscat",
+	"MuscleMass":                           "muscle_mass",
+	"MuscleOxygen":                         "muscle_oxygen",
+	"MuscleUp":                             "muscle_up",
+	"N2Load":                               "n2_load",
+	"Name":                                 "name",
+	"NativeFieldNum":                       "native_field_num",
+	"NativeMesgNum":                        "native_mesg_num",
+	"Nautical":                             "nautical",
+	"Nautilus":                             "nautilus",
+	"Nautix":                               "nautix",
+	"Navigate":                             "navigate",
+	"Navigation":                           "navigation",
+	"NavigationDistance":                   "navigation_distance",
+	"NavigationEstimatedTimeOfArrival":     "navigation_estimated_time_of_arrival",
+	"NavigationHeading":                    "navigation_heading",
+	"NavigationLocation":                   "navigation_location",
+	"NavigationTime":                       "navigation_time",
+	"NavigationTurn":                       "navigation_turn",
+	"Navman":                               "navman",
+	"NciTechnology":                        "nci_technology",
+	"NdlTime":                              "ndl_time",
+	"NecLat":                               "nec_lat",
+	"NecLong":                              "nec_long",
+	"Neck":                                 "neck",
+	"NeckPull":                             "neck_pull",
+	"NeckRotations":                        "neck_rotations",
+	"NeckTilts":                            "neck_tilts",
+	"NegativeSitUp":                        "negative_sit_up",
+	"NeutralGripAlternatingDumbbellRow":    "neutral_grip_alternating_dumbbell_row",
+	"NeutralGripDumbbellBenchPress":        "neutral_grip_dumbbell_bench_press",
+	"NeutralGripDumbbellInclineBenchPress": "neutral_grip_dumbbell_incline_bench_press",
+	"Never":                                "never",
+	"New":                                  "new",
+	"NewLeaf":                              "new_leaf",
+	"NewOrleans":                           "new_orleans",
+	"NewYork":                              "new_york",
+	"NewZealandGrid":                       "new_zealand_grid",
+	"NewZealandTransverse":                 "new_zealand_transverse",
+	"Newfoundland":                         "newfoundland",
+	"NextCoursePoint":                      "next_course_point",
+	"NextStopDepth":                        "next_stop_depth",
+	"NextStopTime":                         "next_stop_time",
+	"NielsenKellerman":                     "nielsen_kellerman",
+	"NmeaSentence":                         "nmea_sentence",
+	"NoError":                              "no_error",
+	"NoEvent":                              "no_event",
+	"NoGps":                                "no_gps",
+	"NoQualifier":                          "no_qualifier",
+	"NoUnits":                              "no_units",
+	"None":                                 "none",
+	"Normal":                               "normal",
+	"NormalBodyAccelValid":                 "normal_body_accel_valid",
+	"NormalizedPower":                      "normalized_power",
+	"NorthPoleEngineering":                 "north_pole_engineering",
+	"Norwegian":                            "norwegian",
+	"Notes":                                "notes",
+	"Novosibirsk":                          "novosibirsk",
+	"NumActiveLengths":                     "num_active_lengths",
+	"NumLaps":                              "num_laps",
+	"NumLengths":                           "num_lengths",
+	"NumPerFile":                           "num_per_file",
+	"NumSessions":                          "num_sessions",
+	"NumValidSteps":                        "num_valid_steps",
+	"Number":                               "number",
+	"NumberLightsConnected":                "number_lights_connected",
+	"NumberOfScreens":                      "number_of_screens",
+	"Numerical":                            "numerical",
+	"Nurvv":                                "nurvv",
+	"O2Toxicity":                           "o2_toxicity",
+	"ObdiiData":                            "obdii_data",
+	"ObservedAtTime":                       "observed_at_time",
+	"ObservedLocationLat":                  "observed_location_lat",
+	"ObservedLocationLong":                 "observed_location_long",
+	"Obstacle":                             "obstacle",
+	"Occasionally":                         "occasionally",
+	"OctaneFitness":                        "octane_fitness",
+	"Odometer":                             "odometer",
+	"OdometerRollover":                     "odometer_rollover",
+	"Off":                                  "off",
+	"OffCourse":                            "off_course",
+	"Offset":                               "offset",
+	"OffsetCal":                            "offset_cal",
+	"OffsetDumbbellLunge":                  "offset_dumbbell_lunge",
+	"OffsetDumbbellReverseLunge":           "offset_dumbbell_reverse_lunge",
+	"OhrSettings":                          "ohr_settings",
+	"Ok":                                   "ok",
+	"OlympicLift":                          "olympic_lift",
+	"OlympicLiftExerciseName":              "olympic_lift_exercise_name",
+	"Omata":                                "omata",
+	"On":                                   "on",
+	"OnceADay":                             "once_a_day",
+	"OneArmBentOverRow":                    "one_arm_bent_over_row",
+	"OneArmBurpee":                         "one_arm_burpee",
+	"OneArmCableLateralRaise":              "one_arm_cable_lateral_raise",
+	"OneArmFloorPress":                     "one_arm_floor_press",
+	"OneArmFullSitUp":                      "one_arm_full_sit_up",
+	"OneArmOneLegDeadlift":                 "one_arm_one_leg_deadlift",
+	"OneArmOneLegRow":                      "one_arm_one_leg_row",
+	"OneArmPreacherCurl":                   "one_arm_preacher_curl",
+	"OneArmPushPress":                      "one_arm_push_press",
+	"OneArmPushUp":                         "one_arm_push_up",
+	"OneArmRowWithBand":                    "one_arm_row_with_band",
+	"OneDSensorCalibration":                "one_d_sensor_calibration",
+	"OneGiantLeap":                         "one_giant_leap",
+	"OneHandDumbbellSplitSnatch":           "one_hand_dumbbell_split_snatch",
+	"OneLeggedDumbbellRow":                 "one_legged_dumbbell_row",
+	"OneLeggedSquat":                       "one_legged_squat",
+	"Onelap":                               "onelap",
+	"Ontario":                              "ontario",
+	"Open":                                 "open",
+	"OpenKneeTucks":                        "open_knee_tucks",
+	"OpenWater":                            "open_water",
+	"OpponentName":                         "opponent_name",
+	"OpponentScore":                        "opponent_score",
+	"OppositeArmAndLegBalance":             "opposite_arm_and_leg_balance",
+	"Orangetheory":                         "orangetheory",
+	"Oregon7xx":                            "oregon7xx",
+	"Oregon7xxWw":                          "oregon7xx_ww",
+	"OrientationMatrix":                    "orientation_matrix",
+	"Oslo":                                 "oslo",
+	"Osynce":                               "osynce",
+	"Other":                                "other",
+	"Overall":                              "overall",
+	"OverhandGripRearLateralRaise":         "overhand_grip_rear_lateral_raise",
+	"OverheadBarbellPress":                 "overhead_barbell_press",
+	"OverheadBarbellShrug":                 "overhead_barbell_shrug",
+	"OverheadBulgarianSplitSquat":          "overhead_bulgarian_split_squat",
+	"OverheadCarry":                        "overhead_carry",
+	"OverheadDumbbellPress":                "overhead_dumbbell_press",
+	"OverheadDumbbellReverseLunge":         "overhead_dumbbell_reverse_lunge",
+	"OverheadDumbbellShrug":                "overhead_dumbbell_shrug",
+	"OverheadDumbbellSplitSquat":           "overhead_dumbbell_split_squat",
+	"OverheadDumbbellSquat":                "overhead_dumbbell_squat",
+	"OverheadDumbbellTricepsExtension":     "overhead_dumbbell_triceps_extension",
+	"OverheadLunge":                        "overhead_lunge",
+	"OverheadLungeWithMedicineBall":        "overhead_lunge_with_medicine_ball",
+	"OverheadLungeWithRotation":            "overhead_lunge_with_rotation",
+	"OverheadSquat":                        "overhead_squat",
+	"OverheadWalk":                         "overhead_walk",
+	"OxygenContent":                        "oxygen_content",
+	"Pace":                                 "pace",
+	"Pad":                                  "pad",
+	"Paddling":                             "paddling",
+	"PagesEnabled":                         "pages_enabled",
+	"PairingTimeout":                       "pairing_timeout",
+	"ParalletteHandstandPushUp":            "parallette_handstand_push_up",
+	"Paris":                                "paris",
+	"PartIndex":                            "part_index",
+	"PartNumber":                           "part_number",
+	"PartialLockout":                       "partial_lockout",
+	"PartialSingleLegSquat":                "partial_single_leg_squat",
+	"PartlyCloudy":                         "partly_cloudy",
+	"Paused":                               "paused",
+	"Peaksware":                            "peaksware",
+	"PedalBrain":                           "pedal_brain",
+	"PedalSmoothness":                      "pedal_smoothness",
+	"PelvicClocks":                         "pelvic_clocks",
+	"Percent":                              "percent",
+	"PercentFat":                           "percent_fat",
+	"PercentFtp":                           "percent_ftp",
+	"PercentHrr":                           "percent_hrr",
+	"PercentHydration":                     "percent_hydration",
+	"PercentMaxHr":                         "percent_max_hr",
+	"PercentMaximum":                       "percent_maximum",
+	"PercentMaximumAverage":                "percent_maximum_average",
+	"PerceptionDigital":                    "perception_digital",
+	"PerformanceCondition":                 "performance_condition",
+	"Peripedal":                            "peripedal",
+	"PersonalBest":                         "personal_best",
+	"Perth":                                "perth",
+	"PetropavlovskKamchatskiy":             "petropavlovsk_kamchatskiy",
+	"Phoenix":                              "phoenix",
+	"PhotoTaken":                           "photo_taken",
+	"PhysicalEnterprises":                  "physical_enterprises",
+	"PhysiqueRating":                       "physique_rating",
+	"Pid":                                  "pid",
+	"PidDataSize":                          "pid_data_size",
+	"Pilates":                              "pilates",
+	"PilatesCrunch":                        "pilates_crunch",
+	"PilatesPlieSquatsParallelTurnedOutFlatAndHeels": "pilates_plie_squats_parallel_turned_out_flat_and_heels",
+	"PilatesPushup":                    "pilates_pushup",
+	"PilatesRollUpWithBall":            "pilates_roll_up_with_ball",
+	"Pioneer":                          "pioneer",
+	"PistolSquat":                      "pistol_squat",
+	"Pitch":                            "pitch",
+	"PitchValid":                       "pitch_valid",
+	"Plank":                            "plank",
+	"PlankExerciseName":                "plank_exercise_name",
+	"PlankJacksWithFeetOnSlidingDiscs": "plank_jacks_with_feet_on_sliding_discs",
+	"PlankKneeTucks":                   "plank_knee_tucks",
+	"PlankKneeTwist":                   "plank_knee_twist",
+	"PlankPikeJumps":                   "plank_pike_jumps",
+	"PlankPikes":                       "plank_pikes",
+	"PlankToStandUp":                   "plank_to_stand_up",
+	"PlankWithArmRaise":                "plank_with_arm_raise",
+	"PlankWithArmVariations":           "plank_with_arm_variations",
+	"PlankWithFeetOnSwissBall":         "plank_with_feet_on_swiss_ball",
+	"PlankWithKneeToElbow":             "plank_with_knee_to_elbow",
+	"PlankWithLegLift":                 "plank_with_leg_lift",
+	"PlankWithObliqueCrunch":           "plank_with_oblique_crunch",
+	"PlatePinchCurl":                   "plate_pinch_curl",
+	"PlateRaises":                      "plate_raises",
+	"PlayerScore":                      "player_score",
+	"PlieSlides":                       "plie_slides",
+	"PlieSquat":                        "plie_squat",
+	"Plyo":                             "plyo",
+	"PlyoExerciseName":                 "plyo_exercise_name",
+	"PlyometricSidePlank":              "plyometric_side_plank",
+	"Po2Critical":                      "po2_critical",
+	"Po2Deco":                          "po2_deco",
+	"Po2Warn":                          "po2_warn",
+	"Podoon":                           "podoon",
+	"PolarElectro":                     "polar_electro",
+	"Polish":                           "polish",
+	"PoolLength":                       "pool_length",
+	"PoolLengthUnit":                   "pool_length_unit",
+	"PortableMax":                      "portable_max",
+	"PortableMin":                      "portable_min",
+	"Portrait":                         "portrait",
+	"PortraitFlipped":                  "portrait_flipped",
+	"Portuguese":                       "portuguese",
+	"Position":                         "position",
+	"PositionLap":                      "position_lap",
+	"PositionLat":                      "position_lat",
+	"PositionLong":                     "position_long",
+	"PositionMarked":                   "position_marked",
+	"PositionSetting":                  "position_setting",
+	"PositionStart":                    "position_start",
+	"PositionWaypoint":                 "position_waypoint",
+	"Pound":                            "pound",
+	"Power":                            "power",
+	"Power10s":                         "power_10s",
+	"Power10sGreaterThan":              "power_10s_greater_than",
+	"Power10sLessThan":                 "power_10s_less_than",
+	"Power30s":                         "power_30s",
+	"Power30sGreaterThan":              "power_30s_greater_than",
+	"Power30sLessThan":                 "power_30s_less_than",
+	"Power3s":                          "power_3s",
+	"Power3sGreaterThan":               "power_3s_greater_than",
+	"Power3sLessThan":                  "power_3s_less_than",
+	"PowerCalFactor":                   "power_cal_factor",
+	"PowerDown":                        "power_down",
+	"PowerEnabled":                     "power_enabled",
+	"PowerGreaterThan":                 "power_greater_than",
+	"PowerHighAlert":                   "power_high_alert",
+	"PowerLap":                         "power_lap",
+	"PowerLapGreaterThan":              "power_lap_greater_than",
+	"PowerLapLessThan":                 "power_lap_less_than",
+	"PowerLessThan":                    "power_less_than",
+	"PowerLowAlert":                    "power_low_alert",
+	"PowerPhaseArcLength":              "power_phase_arc_length",
+	"PowerPhaseCenter":                 "power_phase_center",
+	"PowerPhaseEndAngle":               "power_phase_end_angle",
+	"PowerPhaseStartAngle":             "power_phase_start_angle",
+	"PowerPhaseType":                   "power_phase_type",
+	"PowerRatio":                       "power_ratio",
+	"PowerSetting":                     "power_setting",
+	"PowerSquatChops":                  "power_squat_chops",
+	"PowerUp":                          "power_up",
+	"PowerWeightRatio":                 "power_weight_ratio",
+	"PowerZone":                        "power_zone",
+	"Powerbahn":                        "powerbahn",
+	"Pr":                               "pr",
+	"Prague":                           "prague",
+	"Praxisworks":                      "praxisworks",
+	"PreacherCurlWithCable":            "preacher_curl_with_cable",
+	"PrecipitationProbability":         "precipitation_probability",
+	"Precor":                           "precor",
+	"Pressure":                         "pressure",
+	"PretzelSideKick":                  "pretzel_side_kick",
+	"PrisonerSquat":                    "prisoner_squat",
+	"Private":                          "private",
+	"Processed":                        "processed",
+	"Product":                          "product",
+	"ProductName":                      "product_name",
+	"ProneHipInternalRotation":         "prone_hip_internal_rotation",
+	"Protected":                        "protected",
+	"Public":                           "public",
+	"PullUp":                           "pull_up",
+	"PullUpExerciseName":               "pull_up_exercise_name",
+	"PushJerk":                         "push_jerk",
+	"PushUp":                           "push_up",
+	"PushUpAndRow":                     "push_up_and_row",
+	"PushUpExerciseName":               "push_up_exercise_name",
+	"PushUpPlus":                       "push_up_plus",
+	"PushUpWithFeetOnSwissBall":        "push_up_with_feet_on_swiss_ball",
+	"PushUpWithOneHandOnMedicineBall":  "push_up_with_one_hand_on_medicine_ball",
+	"PwrCalcType":                      "pwr_calc_type",
+	"PwrZoneCalc":                      "pwr_zone_calc",
+	"QatarGrid":                        "qatar_grid",
+	"Qom":                              "qom",
+	"Quadruped":                        "quadruped",
+	"QuadrupedHipExtension":            "quadruped_hip_extension",
+	"QuadrupedLegRaise":                "quadruped_leg_raise",
+	"QuadrupedRocking":                 "quadruped_rocking",
+	"QuadrupedWithLegLift":             "quadruped_with_leg_lift",
+	"Qualifier":                        "qualifier",
+	"Quarq":                            "quarq",
+	"Quebec":                           "quebec",
+	"Race":                             "race",
+	"RackPull":                         "rack_pull",
+	"Racquet":                          "racquet",
+	"RadarThreatAlert":                 "radar_threat_alert",
+	"RadarThreatCount":                 "radar_threat_count",
+	"RadarThreatLevelMax":              "radar_threat_level_max",
+	"RadarThreatLevelType":             "radar_threat_level_type",
+	"Rafting":                          "rafting",
+	"Rain":                             "rain",
+	"Rainfall":                         "rainfall",
+	"RaisedLegsCrunch":                 "raised_legs_crunch",
+	"RawData":                          "raw_data",
+	"RcDrone":                          "rc_drone",
+	"ReachRollAndLift":                 "reach_roll_and_lift",
+	"Read":                             "read",
+	"Ready":                            "ready",
+	"RearGear":                         "rear_gear",
+	"RearGearChange":                   "rear_gear_change",
+	"RearGearNum":                      "rear_gear_num",
+	"RearGearShiftCount":               "rear_gear_shift_count",
+	"RecliningCircle":                  "reclining_circle",
+	"RecliningTricepsPress":            "reclining_triceps_press",
+	"Recon":                            "recon",
+	"Record":                           "record",
+	"RecoveryHr":                       "recovery_hr",
+	"Recreation":                       "recreation",
+	"Recumbent":                        "recumbent",
+	"Recurrence":                       "recurrence",
+	"RecurrenceValue":                  "recurrence_value",
+	"ReferenceIndex":                   "reference_index",
+	"ReferenceMesg":                    "reference_mesg",
+	"RelativeHumidity":                 "relative_humidity",
+	"ReleveStraightLegAndKneeBentWithOneLegVariation": "releve_straight_leg_and_knee_bent_with_one_leg_variation",
+	"Remote":                                 "remote",
+	"RemoteManualSync":                       "remote_manual_sync",
+	"RenegadeRow":                            "renegade_row",
+	"Repeat":                                 "repeat",
+	"RepeatCalories":                         "repeat_calories",
+	"RepeatDistance":                         "repeat_distance",
+	"RepeatDiveInterval":                     "repeat_dive_interval",
+	"RepeatHr":                               "repeat_hr",
+	"RepeatPower":                            "repeat_power",
+	"RepeatSteps":                            "repeat_steps",
+	"RepeatTime":                             "repeat_time",
+	"RepeatUntilCalories":                    "repeat_until_calories",
+	"RepeatUntilDistance":                    "repeat_until_distance",
+	"RepeatUntilHrGreaterThan":               "repeat_until_hr_greater_than",
+	"RepeatUntilHrLessThan":                  "repeat_until_hr_less_than",
+	"RepeatUntilMaxPowerLastLapLessThan":     "repeat_until_max_power_last_lap_less_than",
+	"RepeatUntilPowerGreaterThan":            "repeat_until_power_greater_than",
+	"RepeatUntilPowerLastLapLessThan":        "repeat_until_power_last_lap_less_than",
+	"RepeatUntilPowerLessThan":               "repeat_until_power_less_than",
+	"RepeatUntilStepsCmplt":                  "repeat_until_steps_cmplt",
+	"RepeatUntilTime":                        "repeat_until_time",
+	"RepeatUntilTrainingPeaksTss":            "repeat_until_training_peaks_tss",
+	"RepetitionNum":                          "repetition_num",
+	"RepetitionTime":                         "repetition_time",
+	"Repetitions":                            "repetitions",
+	"ReportId":                               "report_id",
+	"Reps":                                   "reps",
+	"Reserve":                                "reserve",
+	"Reserved":                               "reserved",
+	"Resistance":                             "resistance",
+	"Resort":                                 "resort",
+	"Rest":                                   "rest",
+	"RestingHeartRate":                       "resting_heart_rate",
+	"RestingMetabolicRate":                   "resting_metabolic_rate",
+	"ReverseBarbellBoxLunge":                 "reverse_barbell_box_lunge",
+	"ReverseBoxLunge":                        "reverse_box_lunge",
+	"ReverseCrunch":                          "reverse_crunch",
+	"ReverseCrunchOnABench":                  "reverse_crunch_on_a_bench",
+	"ReverseCurlAndLift":                     "reverse_curl_and_lift",
+	"ReverseCurlUp":                          "reverse_curl_up",
+	"ReverseDumbbellBoxLunge":                "reverse_dumbbell_box_lunge",
+	"ReverseDumbbellCrossoverLunge":          "reverse_dumbbell_crossover_lunge",
+	"ReverseDumbbellDiagonalLunge":           "reverse_dumbbell_diagonal_lunge",
+	"ReverseEzBarCurl":                       "reverse_ez_bar_curl",
+	"ReverseGripBarbellBenchPress":           "reverse_grip_barbell_bench_press",
+	"ReverseGripBarbellBicepsCurl":           "reverse_grip_barbell_biceps_curl",
+	"ReverseGripBarbellRow":                  "reverse_grip_barbell_row",
+	"ReverseGripInclineBenchPress":           "reverse_grip_incline_bench_press",
+	"ReverseGripPressdown":                   "reverse_grip_pressdown",
+	"ReverseGripPulldown":                    "reverse_grip_pulldown",
+	"ReverseGripTricepsPressdown":            "reverse_grip_triceps_pressdown",
+	"ReverseGripWristCurl":                   "reverse_grip_wrist_curl",
+	"ReverseHipRaise":                        "reverse_hip_raise",
+	"ReverseLegRaise":                        "reverse_leg_raise",
+	"ReverseLegRaiseOnSwissBall":             "reverse_leg_raise_on_swiss_ball",
+	"ReverseLungeWithReachBack":              "reverse_lunge_with_reach_back",
+	"ReverseLungeWithTwistAndOverheadReach":  "reverse_lunge_with_twist_and_overhead_reach",
+	"ReversePlankWithLegPull":                "reverse_plank_with_leg_pull",
+	"ReverseSlidingBoxLunge":                 "reverse_sliding_box_lunge",
+	"ReverseSlidingLunge":                    "reverse_sliding_lunge",
+	"Reykjavik":                              "reykjavik",
+	"RiderPosition":                          "rider_position",
+	"RiderPositionChange":                    "rider_position_change",
+	"RiderPositionType":                      "rider_position_type",
+	"Right":                                  "right",
+	"RightAbdomen":                           "right_abdomen",
+	"RightArm":                               "right_arm",
+	"RightBicep":                             "right_bicep",
+	"RightBrachioradialis":                   "right_brachioradialis",
+	"RightCalf":                              "right_calf",
+	"RightChest":                             "right_chest",
+	"RightForearmExtensors":                  "right_forearm_extensors",
+	"RightFork":                              "right_fork",
+	"RightGlute":                             "right_glute",
+	"RightGroundContactTimeBalance":          "right_ground_contact_time_balance",
+	"RightHamstring":                         "right_hamstring",
+	"RightLeg":                               "right_leg",
+	"RightLowerBack":                         "right_lower_back",
+	"RightPco":                               "right_pco",
+	"RightPedalSmoothness":                   "right_pedal_smoothness",
+	"RightPlatformCenterOffset":              "right_platform_center_offset",
+	"RightPowerPhase":                        "right_power_phase",
+	"RightPowerPhaseFinishAngle":             "right_power_phase_finish_angle",
+	"RightPowerPhasePeak":                    "right_power_phase_peak",
+	"RightPowerPhaseStartAngle":              "right_power_phase_start_angle",
+	"RightQuad":                              "right_quad",
+	"RightShin":                              "right_shin",
+	"RightShoulder":                          "right_shoulder",
+	"RightTorqueEffectiveness":               "right_torque_effectiveness",
+	"RightTricep":                            "right_tricep",
+	"RightUpperBack":                         "right_upper_back",
+	"RingDip":                                "ring_dip",
+	"RingHandstandPushUp":                    "ring_handstand_push_up",
+	"RingMuscleUp":                           "ring_muscle_up",
+	"RingPushUp":                             "ring_push_up",
+	"Rino7xx":                                "rino7xx",
+	"RipTide":                                "rip_tide",
+	"Rival":                                  "rival",
+	"Riyahd":                                 "riyahd",
+	"Road":                                   "road",
+	"RockClimbing":                           "rock_climbing",
+	"Roll":                                   "roll",
+	"RollOver":                               "roll_over",
+	"RollUp":                                 "roll_up",
+	"RollValid":                              "roll_valid",
+	"Rolling":                                "rolling",
+	"RollingSidePlank":                       "rolling_side_plank",
+	"Romanian":                               "romanian",
+	"Rome":                                   "rome",
+	"RopeClimb":                              "rope_climb",
+	"RopeHandleCableRow":                     "rope_handle_cable_row",
+	"RopePressdown":                          "rope_pressdown",
+	"RotationalDumbbellStraightLegDeadlift":  "rotational_dumbbell_straight_leg_deadlift",
+	"RotationalLift":                         "rotational_lift",
+	"Rotations":                              "rotations",
+	"Rotor":                                  "rotor",
+	"Roundabout135Idx":                       "roundabout_135_idx",
+	"Roundabout180Idx":                       "roundabout_180_idx",
+	"Roundabout225Idx":                       "roundabout_225_idx",
+	"Roundabout270Idx":                       "roundabout_270_idx",
+	"Roundabout315Idx":                       "roundabout_315_idx",
+	"Roundabout360Idx":                       "roundabout_360_idx",
+	"Roundabout45Idx":                        "roundabout_45_idx",
+	"Roundabout90Idx":                        "roundabout_90_idx",
+	"RoundaboutGenericIdx":                   "roundabout_generic_idx",
+	"RoundaboutNeg135Idx":                    "roundabout_neg_135_idx",
+	"RoundaboutNeg180Idx":                    "roundabout_neg_180_idx",
+	"RoundaboutNeg225Idx":                    "roundabout_neg_225_idx",
+	"RoundaboutNeg270Idx":                    "roundabout_neg_270_idx",
+	"RoundaboutNeg315Idx":                    "roundabout_neg_315_idx",
+	"RoundaboutNeg360Idx":                    "roundabout_neg_360_idx",
+	"RoundaboutNeg45Idx":                     "roundabout_neg_45_idx",
+	"RoundaboutNeg90Idx":                     "roundabout_neg_90_idx",
+	"RoundaboutNegGenericIdx":                "roundabout_neg_generic_idx",
+	"Row":                                    "row",
+	"RowExerciseName":                        "row_exercise_name",
+	"Rowing":                                 "rowing",
+	"Rowing1":                                "rowing_1",
+	"Rowing2":                                "rowing_2",
+	"Rpm":                                    "rpm",
+	"Run":                                    "run",
+	"RunExerciseName":                        "run_exercise_name",
+	"RunToBikeTransition":                    "run_to_bike_transition",
+	"RunnersLungeToBalance":                  "runners_lunge_to_balance",
+	"Running":                                "running",
+	"RunningCadence":                         "running_cadence",
+	"RunningDynamicsPod":                     "running_dynamics_pod",
+	"Russian":                                "russian",
+	"RussianTwist":                           "russian_twist",
+	"SafetyStopEnabled":                      "safety_stop_enabled",
+	"SafetyStopTime":                         "safety_stop_time",
+	"Sailing":                                "sailing",
+	"Salt":                                   "salt",
+	"Salutron":                               "salutron",
+	"Samara":                                 "samara",
+	"Samoa":                                  "samoa",
+	"SampleTimeOffset":                       "sample_time_offset",
+	"SantaFe":                                "santa_fe",
+	"Santiago":                               "santiago",
+	"Saris":                                  "saris",
+	"Saskatchewan":                           "saskatchewan",
+	"SaturatedHemoglobinPercent":             "saturated_hemoglobin_percent",
+	"SaturatedHemoglobinPercentMax":          "saturated_hemoglobin_percent_max",
+	"SaturatedHemoglobinPercentMin":          "saturated_hemoglobin_percent_min",
+	"Saturday":                               "saturday",
+	"Saxonar":                                "saxonar",
+	"Scale":                                  "scale",
+	"Scaling":                                "scaling",
+	"Scaption":                               "scaption",
+	"ScaptionAndShrug":                       "scaption_and_shrug",
+	"ScapularRetraction":                     "scapular_retraction",
+	"ScatteredShowers":                       "scattered_showers",
+	"ScatteredThunderstorms":                 "scattered_thunderstorms",
+	"Schedule":                               "schedule",
+	"ScheduledTime":                          "scheduled_time",
+	"Schedules":                              "schedules",
+	"Scissors":                               "scissors",
+	"Score":                                  "score",
+	"Scorpion":                               "scorpion",
+	"Scosche":                                "scosche",
+	"ScreenEnabled":                          "screen_enabled",
+	"ScreenIndex":                            "screen_index",
+	"ScribeLabs":                             "scribe_labs",
+	"Sdm4":                                   "sdm4",
+	"SdmAntId":                               "sdm_ant_id",
+	"SdmAntIdTransType":                      "sdm_ant_id_trans_type",
+	"SdmCalFactor":                           "sdm_cal_factor",
+	"SdmProfile":                             "sdm_profile",
+	"Seated":                                 "seated",
+	"SeatedAlternatingDumbbellBicepsCurl":    "seated_alternating_dumbbell_biceps_curl",
+	"SeatedAlternatingReverseCrunch":         "seated_alternating_reverse_crunch",
+	"SeatedBarbellGoodMorning":               "seated_barbell_good_morning",
+	"SeatedBarbellOverheadTricepsExtension":  "seated_barbell_overhead_triceps_extension",
+	"SeatedBarbellShoulderPress":             "seated_barbell_shoulder_press",
+	"SeatedCableRow":                         "seated_cable_row",
+	"SeatedCalfRaise":                        "seated_calf_raise",
+	"SeatedDumbbellBicepsCurl":               "seated_dumbbell_biceps_curl",
+	"SeatedDumbbellExternalRotation":         "seated_dumbbell_external_rotation",
+	"SeatedDumbbellOverheadTricepsExtension": "seated_dumbbell_overhead_triceps_extension",
+	"SeatedDumbbellRow":                      "seated_dumbbell_row",
+	"SeatedDumbbellShoulderPress":            "seated_dumbbell_shoulder_press",
+	"SeatedDumbbellToeRaise":                 "seated_dumbbell_toe_raise",
+	"SeatedEzBarOverheadTricepsExtension":    "seated_ez_bar_overhead_triceps_extension",
+	"SeatedLateralRaise":                     "seated_lateral_raise",
+	"SeatedLegU":                             "seated_leg_u",
+	"SeatedRearLateralRaise":                 "seated_rear_lateral_raise",
+	"SeatedReverseDumbbellCurl":              "seated_reverse_dumbbell_curl",
+	"SeatedSingleArmOverheadDumbbellExtension": "seated_single_arm_overhead_dumbbell_extension",
+	"Seattle":                        "seattle",
+	"Second":                         "second",
+	"SecondCategory":                 "second_category",
+	"SecondPerKilometer":             "second_per_kilometer",
+	"SecondPerMile":                  "second_per_mile",
+	"Seconds":                        "seconds",
+	"Sedentary":                      "sedentary",
+	"Seesense":                       "seesense",
+	"Segment":                        "segment",
+	"SegmentDeleteStatus":            "segment_delete_status",
+	"SegmentEnd":                     "segment_end",
+	"SegmentFile":                    "segment_file",
+	"SegmentId":                      "segment_id",
+	"SegmentLap":                     "segment_lap",
+	"SegmentLapStatus":               "segment_lap_status",
+	"SegmentLeaderboardEntry":        "segment_leaderboard_entry",
+	"SegmentLeaderboardType":         "segment_leaderboard_type",
+	"SegmentList":                    "segment_list",
+	"SegmentPoint":                   "segment_point",
+	"SegmentSelectionType":           "segment_selection_type",
+	"SegmentStart":                   "segment_start",
+	"SegmentTime":                    "segment_time",
+	"SeikoEpson":                     "seiko_epson",
+	"SeikoEpsonOem":                  "seiko_epson_oem",
+	"Selected":                       "selected",
+	"SelectionType":                  "selection_type",
+	"SensitivusGauge":                "sensitivus_gauge",
+	"SensorPosition":                 "sensor_position",
+	"SensorType":                     "sensor_type",
+	"Sentence":                       "sentence",
+	"SerialNumber":                   "serial_number",
+	"SerratusChairShrug":             "serratus_chair_shrug",
+	"SerratusShrug":                  "serratus_shrug",
+	"Serve":                          "serve",
+	"Session":                        "session",
+	"SessionEnd":                     "session_end",
+	"SessionTrigger":                 "session_trigger",
+	"Sessions":                       "sessions",
+	"Set":                            "set",
+	"SetType":                        "set_type",
+	"Settings":                       "settings",
+	"SetupIncomplete":                "setup_incomplete",
+	"SevereThunderstorm":             "severe_thunderstorm",
+	"Severity":                       "severity",
+	"Shapelog":                       "shapelog",
+	"SharpLeft":                      "sharp_left",
+	"SharpRight":                     "sharp_right",
+	"SharpTurnLeftIdx":               "sharp_turn_left_idx",
+	"SharpTurnRightIdx":              "sharp_turn_right_idx",
+	"ShavingTheHead":                 "shaving_the_head",
+	"Shifter":                        "shifter",
+	"ShiftingSideLunge":              "shifting_side_lunge",
+	"ShimanoDi2Enabled":              "shimano_di2_enabled",
+	"ShoulderCircles":                "shoulder_circles",
+	"ShoulderPress":                  "shoulder_press",
+	"ShoulderPressExerciseName":      "shoulder_press_exercise_name",
+	"ShoulderPushUp":                 "shoulder_push_up",
+	"ShoulderStability":              "shoulder_stability",
+	"ShoulderStabilityExerciseName":  "shoulder_stability_exercise_name",
+	"Shrug":                          "shrug",
+	"ShrugExerciseName":              "shrug_exercise_name",
+	"Side":                           "side",
+	"SideAbsLegLift":                 "side_abs_leg_lift",
+	"SideAndCrossoverLunge":          "side_and_crossover_lunge",
+	"SideBend":                       "side_bend",
+	"SideKickPlank":                  "side_kick_plank",
+	"SideLunge":                      "side_lunge",
+	"SideLungeAndPress":              "side_lunge_and_press",
+	"SideLungeJumpOff":               "side_lunge_jump_off",
+	"SideLungeSweep":                 "side_lunge_sweep",
+	"SideLungeToCrossoverTap":        "side_lunge_to_crossover_tap",
+	"SideLyingLateralRaise":          "side_lying_lateral_raise",
+	"SideLyingLegRaise":              "side_lying_leg_raise",
+	"SidePlank":                      "side_plank",
+	"SidePlankAndRow":                "side_plank_and_row",
+	"SidePlankLift":                  "side_plank_lift",
+	"SidePlankToPlankWithReachUnder": "side_plank_to_plank_with_reach_under",
+	"SidePlankWithElbowOnBosuBall":   "side_plank_with_elbow_on_bosu_ball",
+	"SidePlankWithFeetOnBench":       "side_plank_with_feet_on_bench",
+	"SidePlankWithKneeCircle":        "side_plank_with_knee_circle",
+	"SidePlankWithKneeTuck":          "side_plank_with_knee_tuck",
+	"SidePlankWithLegLift":           "side_plank_with_leg_lift",
+	"SidePlankWithReachUnder":        "side_plank_with_reach_under",
+	"SideStep":                       "side_step",
+	"SideToSideCrunchAndWeave":       "side_to_side_crunch_and_weave",
+	"SideToSideLegSwings":            "side_to_side_leg_swings",
+	"SideToSideLungeChops":           "side_to_side_lunge_chops",
+	"SideToSideMedicineBallThrows":   "side_to_side_medicine_ball_throws",
+	"SideToSideShuffleJump":          "side_to_side_shuffle_jump",
+	"SiffJumpLunge":                  "siff_jump_lunge",
+	"Sigmasport":                     "sigmasport",
+	"Simple":                         "simple",
+	"SimpleDynamicIcon":              "simple_dynamic_icon",
+	"SingleArmCableChestPress":       "single_arm_cable_chest_press",
+	"SingleArmCableRow":              "single_arm_cable_row",
+	"SingleArmCableRowAndRotation":   "single_arm_cable_row_and_rotation",
+	"SingleArmDeadlift":              "single_arm_deadlift",
+	"SingleArmDumbbellBenchPress":    "single_arm_dumbbell_bench_press",
+	"SingleArmDumbbellOverheadTricepsExtension":    "single_arm_dumbbell_overhead_triceps_extension",
+	"SingleArmDumbbellShoulderPress":               "single_arm_dumbbell_shoulder_press",
+	"SingleArmDumbbellSnatch":                      "single_arm_dumbbell_snatch",
+	"SingleArmDumbbellSwing":                       "single_arm_dumbbell_swing",
+	"SingleArmHangSnatch":                          "single_arm_hang_snatch",
+	"SingleArmInvertedRow":                         "single_arm_inverted_row",
+	"SingleArmKettlebellSnatch":                    "single_arm_kettlebell_snatch",
+	"SingleArmKettlebellSwing":                     "single_arm_kettlebell_swing",
+	"SingleArmMedicineBallPushUp":                  "single_arm_medicine_ball_push_up",
+	"SingleArmNeutralGripDumbbellRow":              "single_arm_neutral_grip_dumbbell_row",
+	"SingleArmNeutralGripDumbbellRowAndRotation":   "single_arm_neutral_grip_dumbbell_row_and_rotation",
+	"SingleArmReverseLungeAndPress":                "single_arm_reverse_lunge_and_press",
+	"SingleArmStandingCableReverseFlye":            "single_arm_standing_cable_reverse_flye",
+	"SingleArmStepUpAndPress":                      "single_arm_step_up_and_press",
+	"SingleDumbbellSeatedOverheadTricepsExtension": "single_dumbbell_seated_overhead_triceps_extension",
+	"SingleGasDiving":                              "single_gas_diving",
+	"SingleLegBackExtension":                       "single_leg_back_extension",
+	"SingleLegBarbellDeadlift":                     "single_leg_barbell_deadlift",
+	"SingleLegBarbellGoodMorning":                  "single_leg_barbell_good_morning",
+	"SingleLegBarbellStraightLegDeadlift":          "single_leg_barbell_straight_leg_deadlift",
+	"SingleLegBenchDipAndKick":                     "single_leg_bench_dip_and_kick",
+	"SingleLegBenchGetUp":                          "single_leg_bench_get_up",
+	"SingleLegBenchSquat":                          "single_leg_bench_squat",
+	"SingleLegBentKneeCalfRaise":                   "single_leg_bent_knee_calf_raise",
+	"SingleLegCircles":                             "single_leg_circles",
+	"SingleLegDeadliftWithBarbell":                 "single_leg_deadlift_with_barbell",
+	"SingleLegDeclinePushUp":                       "single_leg_decline_push_up",
+	"SingleLegDip":                                 "single_leg_dip",
+	"SingleLegDonkeyCalfRaise":                     "single_leg_donkey_calf_raise",
+	"SingleLegElevatedFeetPlank":                   "single_leg_elevated_feet_plank",
+	"SingleLegFlexAndExtend":                       "single_leg_flex_and_extend",
+	"SingleLegHipRaise":                            "single_leg_hip_raise",
+	"SingleLegHipRaiseWithFootOnBench":             "single_leg_hip_raise_with_foot_on_bench",
+	"SingleLegHipRaiseWithFootOnBosuBall":          "single_leg_hip_raise_with_foot_on_bosu_ball",
+	"SingleLegHipRaiseWithFootOnFoamRoller":        "single_leg_hip_raise_with_foot_on_foam_roller",
+	"SingleLegHipRaiseWithFootOnMedicineBall":      "single_leg_hip_raise_with_foot_on_medicine_ball",
+	"SingleLegHipRaiseWithHeadOnBosuBall":          "single_leg_hip_raise_with_head_on_bosu_ball",
+	"SingleLegHipRaiseWithKneeHold":                "single_leg_hip_raise_with_knee_hold",
+	"SingleLegLoweringDrill":                       "single_leg_lowering_drill",
+	"SingleLegRdlCircuit":                          "single_leg_rdl_circuit",
+	"SingleLegReverseCrunch":                       "single_leg_reverse_crunch",
+	"SingleLegRomanianDeadliftWithDumbbell":        "single_leg_romanian_deadlift_with_dumbbell",
+	"SingleLegSidePlank":                           "single_leg_side_plank",
+	"SingleLegSlidingLegCurl":                      "single_leg_sliding_leg_curl",
+	"SingleLegSquatOnSwissBall":                    "single_leg_squat_on_swiss_ball",
+	"SingleLegStandingCalfRaise":                   "single_leg_standing_calf_raise",
+	"SingleLegStandingDumbbellCalfRaise":           "single_leg_standing_dumbbell_calf_raise",
+	"SingleLegStretch":                             "single_leg_stretch",
+	"SingleLegSwissBallHipRaiseAndLegCurl":         "single_leg_swiss_ball_hip_raise_and_leg_curl",
+	"SingleLegSwissBallJackknife":                  "single_leg_swiss_ball_jackknife",
+	"Sint16":                                       "sint16",
+	"Sint32":                                       "sint32",
+	"Sint64":                                       "sint64",
+	"Sint8":                                        "sint8",
+	"SitUp":                                        "sit_up",
+	"SitUpExerciseName":                            "sit_up_exercise_name",
+	"SkateSkiing":                                  "skate_skiing",
+	"SkaterCrunchCross":                            "skater_crunch_cross",
+	"SkiMoguls":                                    "ski_moguls",
+	"SkyDiving":                                    "sky_diving",
+	"SlaveDevice":                                  "slave_device",
+	"SleepTime":                                    "sleep_time",
+	"SleeperStretch":                               "sleeper_stretch",
+	"Sleet":                                        "sleet",
+	"SlideOut":                                     "slide_out",
+	"SlidingHipAdduction":                          "sliding_hip_adduction",
+	"SlidingLateralLunge":                          "sliding_lateral_lunge",
+	"SlidingLegCurl":                               "sliding_leg_curl",
+	"SlightLeft":                                   "slight_left",
+	"SlightRight":                                  "slight_right",
+	"Slovakian":                                    "slovakian",
+	"Slovenian":                                    "slovenian",
+	"Slow":                                         "slow",
+	"SmallCraft":                                   "small_craft",
+	"SmallCraftHazardousSeas":                      "small_craft_hazardous_seas",
+	"SmallCraftRoughBar":                           "small_craft_rough_bar",
+	"SmallCraftWinds":                              "small_craft_winds",
+	"SmartNotificationDisplayOrientation":          "smart_notification_display_orientation",
+	"SmartNotifications":                           "smart_notifications",
+	"Smash":                                        "smash",
+	"SmithMachineBenchPress":                       "smith_machine_bench_press",
+	"SmithMachineOverheadPress":                    "smith_machine_overhead_press",
+	"Smog":                                         "smog",
+	"SnakeTwist1And2":                              "snake_twist_1_and_2",
+	"Snow":                                         "snow",
+	"SnowAlert":                                    "snow_alert",
+	"SnowAndBlowingSnow":                           "snow_and_blowing_snow",
+	"SnowSquall":                                   "snow_squall",
+	"Snowboarding":                                 "snowboarding",
+	"Snowfall":                                     "snowfall",
+	"Snowmobiling":                                 "snowmobiling",
+	"Snowshoeing":                                  "snowshoeing",
+	"SoaringTechnology":                            "soaring_technology",
+	"Soccer":                                       "soccer",
+	"Software":                                     "software",
+	"SoftwareVersion":                              "software_version",
+	"SolutionCoasting":                             "solution_coasting",
+	"Sound":                                        "sound",
+	"SoundOfMotion":                                "sound_of_motion",
+	"Source":                                       "source",
+	"SourceType":                                   "source_type",
+	"SouthAfricanGrid":                             "south_african_grid",
+	"Spanish":                                      "spanish",
+	"Spantec":                                      "spantec",
+	"SparkHk":                                      "spark_hk",
+	"SpdEnabled":                                   "spd_enabled",
+	"SpdcadEnabled":                                "spdcad_enabled",
+	"SpecialEvent":                                 "special_event",
+	"SpecialMarine":                                "special_marine",
+	"SpecialWeather":                               "special_weather",
+	"Specialized":                                  "specialized",
+	"Speed":                                        "speed",
+	"Speed1s":                                      "speed_1s",
+	"SpeedHighAlert":                               "speed_high_alert",
+	"SpeedLap":                                     "speed_lap",
+	"SpeedLowAlert":                                "speed_low_alert",
+	"SpeedSetting":                                 "speed_setting",
+	"SpeedSource":                                  "speed_source",
+	"SpeedWalking":                                 "speed_walking",
+	"SpeedZone":                                    "speed_zone",
+	"SpidermanPlank":                               "spiderman_plank",
+	"SpidermanPushUp":                              "spiderman_push_up",
+	"Spin":                                         "spin",
+	"SpineExtension":                               "spine_extension",
+	"Spivi":                                        "spivi",
+	"SplitBarbellGoodMorning":                      "split_barbell_good_morning",
+	"SplitJacks":                                   "split_jacks",
+	"SplitJerk":                                    "split_jerk",
+	"SplitStanceExtension":                         "split_stance_extension",
+	"SplitStanceHammerCurlToPress":                 "split_stance_hammer_curl_to_press",
+	"SplitStanceOffsetPinkyDumbbellCurl":           "split_stance_offset_pinky_dumbbell_curl",
+	"Sport":                                        "sport",
+	"SportBits0":                                   "sport_bits_0",
+	"SportBits1":                                   "sport_bits_1",
+	"SportBits2":                                   "sport_bits_2",
+	"SportBits3":                                   "sport_bits_3",
+	"SportBits4":                                   "sport_bits_4",
+	"SportBits5":                                   "sport_bits_5",
+	"SportBits6":                                   "sport_bits_6",
+	"SportEvent":                                   "sport_event",
+	"SportIndex":                                   "sport_index",
+	"SportPoint":                                   "sport_point",
+	"Sports":                                       "sports",
+	"Sprint":                                       "sprint",
+	"Squall":                                       "squall",
+	"Squat":                                        "squat",
+	"SquatAndSideKick":                             "squat_and_side_kick",
+	"SquatCleanAndJerk":                            "squat_clean_and_jerk",
+	"SquatExerciseName":                            "squat_exercise_name",
+	"SquatJacks":                                   "squat_jacks",
+	"SquatJumpOntoBox":                             "squat_jump_onto_box",
+	"SquatJumpsInAndOut":                           "squat_jumps_in_and_out",
+	"SquatJumpsInNOut":                             "squat_jumps_in_n_out",
+	"SquatPlankPushUp":                             "squat_plank_push_up",
+	"SquatThrusts":                                 "squat_thrusts",
+	"SquatsWithBand":                               "squats_with_band",
+	"Sram":                                         "sram",
+	"Srm":                                          "srm",
+	"StackedFeetPushUp":                            "stacked_feet_push_up",
+	"Stage":                                        "stage",
+	"StagesCycling":                                "stages_cycling",
+	"StaggeredHandsPushUp":                         "staggered_hands_push_up",
+	"StaggeredSquat":                               "staggered_squat",
+	"StaggeredStanceGoodMorning":                   "staggered_stance_good_morning",
+	"StairClimbing":                                "stair_climbing",
+	"StanceTime":                                   "stance_time",
+	"StanceTimeBalance":                            "stance_time_balance",
+	"StanceTimePercent":                            "stance_time_percent",
+	"StandCount":                                   "stand_count",
+	"StandUpPaddleboarding":                        "stand_up_paddleboarding",
+	"Standing":                                     "standing",
+	"StandingAdduction":                            "standing_adduction",
+	"StandingAlternatingDumbbellCurls":             "standing_alternating_dumbbell_curls",
+	"StandingBarbellCalfRaise":                     "standing_barbell_calf_raise",
+	"StandingCableCrunch":                          "standing_cable_crunch",
+	"StandingCableHipAbduction":                    "standing_cable_hip_abduction",
+	"StandingCablePullover":                        "standing_cable_pullover",
+	"StandingCalfRaise":                            "standing_calf_raise",
+	"StandingDumbbellBicepsCurl":                   "standing_dumbbell_biceps_curl",
+	"StandingDumbbellCalfRaise":                    "standing_dumbbell_calf_raise",
+	"StandingEzBarBicepsCurl":                      "standing_ez_bar_biceps_curl",
+	"StandingHipAbduction":                         "standing_hip_abduction",
+	"StandingLRaise":                               "standing_l_raise",
+	"StandingLift":                                 "standing_lift",
+	"StandingRearLegRaise":                         "standing_rear_leg_raise",
+	"StandingRotationalChop":                       "standing_rotational_chop",
+	"StandingSideCrunch":                           "standing_side_crunch",
+	"StandingSplitRotationalChop":                  "standing_split_rotational_chop",
+	"StandingSplitRotationalReverseChop":           "standing_split_rotational_reverse_chop",
+	"StandingStabilityReverseChop":                 "standing_stability_reverse_chop",
+	"StandingTRotationBalance":                     "standing_t_rotation_balance",
+	"StarTrac":                                     "star_trac",
+	"Starred":                                      "starred",
+	"Start":                                        "start",
+	"StartCns":                                     "start_cns",
+	"StartDate":                                    "start_date",
+	"StartN2":                                      "start_n2",
+	"StartPositionLat":                             "start_position_lat",
+	"StartPositionLong":                            "start_position_long",
+	"StartTime":                                    "start_time",
+	"StartTimestamp":                               "start_timestamp",
+	"StartTimestampMs":                             "start_timestamp_ms",
+	"Statement":                                    "statement",
+	"StaticBackExtension":                          "static_back_extension",
+	"StaticCurl":                                   "static_curl",
+	"StaticLyingTricepsExtension":                  "static_lying_triceps_extension",
+	"StationaryMax":                                "stationary_max",
+	"StationaryMin":                                "stationary_min",
+	"Status":                                       "status",
+	"Statute":                                      "statute",
+	"StepClimb":                                    "step_climb",
+	"StepLength":                                   "step_length",
+	"StepOutSwing":                                 "step_out_swing",
+	"StepUp":                                       "step_up",
+	"Steps":                                        "steps",
+	"Stockholm":                                    "stockholm",
+	"Stop":                                         "stop",
+	"StopAll":                                      "stop_all",
+	"StopDisable":                                  "stop_disable",
+	"StopDisableAll":                               "stop_disable_all",
+	"Stopped":                                      "stopped",
+	"Storm":                                        "storm",
+	"StormSurge":                                   "storm_surge",
+	"Straight":                                     "straight",
+	"StraightArmPlank":                             "straight_arm_plank",
+	"StraightArmPlankWithShoulderTouch":            "straight_arm_plank_with_shoulder_touch",
+	"StraightArmPulldown":                          "straight_arm_pulldown",
+	"StraightLegCrunchWithBall":                    "straight_leg_crunch_with_ball",
+	"Strava":                                       "strava",
+	"Street":                                       "street",
+	"StrengthTraining":                             "strength_training",
+	"StressLevel":                                  "stress_level",
+	"StressLevelTime":                              "stress_level_time",
+	"StressLevelValue":                             "stress_level_value",
+	"StrideLength":                                 "stride_length",
+	"StrideSpeedDistance":                          "stride_speed_distance",
+	"String":                                       "string",
+	"StringList":                                   "string_list",
+	"StrokeCount":                                  "stroke_count",
+	"StrokeType":                                   "stroke_type",
+	"Strokes":                                      "strokes",
+	"StrongWind":                                   "strong_wind",
+	"Stryd":                                        "stryd",
+	"SubSport":                                     "sub_sport",
+	"Suggested":                                    "suggested",
+	"SuitcaseSquats":                               "suitcase_squats",
+	"Summit":                                       "summit",
+	"SumoDeadlift":                                 "sumo_deadlift",
+	"SumoDeadliftHighPull":                         "sumo_deadlift_high_pull",
+	"SumoSquat":                                    "sumo_squat",
+	"SumoSquatSlideIn":                             "sumo_squat_slide_in",
+	"SumoSquatToHighPull":                          "sumo_squat_to_high_pull",
+	"SumoSquatToStand":                             "sumo_squat_to_stand",
+	"SumoSquatWithRotation":                        "sumo_squat_with_rotation",
+	"Sunday":                                       "sunday",
+	"Sunrise":                                      "sunrise",
+	"Sunset":                                       "sunset",
+	"SupermanFromFloor":                            "superman_from_floor",
+	"SupermanOnSwissBall":                          "superman_on_swiss_ball",
+	"SupineFloorBarre":                             "supine_floor_barre",
+	"SupineHipInternalRotation":                    "supine_hip_internal_rotation",
+	"SupportedExdScreenLayouts":                    "supported_exd_screen_layouts",
+	"SurfaceInterval":                              "surface_interval",
+	"Surfing":                                      "surfing",
+	"SuspendedChinUp":                              "suspended_chin_up",
+	"SuspendedDip":                                 "suspended_dip",
+	"SuspendedInvertedRow":                         "suspended_inverted_row",
+	"SuspendedPushUp":                              "suspended_push_up",
+	"SuspendedRow":                                 "suspended_row",
+	"Suunto":                                       "suunto",
+	"Swan":                                         "swan",
+	"SwcLat":                                       "swc_lat",
+	"SwcLong":                                      "swc_long",
+	"Swedish":                                      "swedish",
+	"SwedishGrid":                                  "swedish_grid",
+	"SwedishRef99Grid":                             "swedish_ref_99_grid",
+	"Swim":                                         "swim",
+	"Swim2":                                        "swim2",
+	"Swim2Apac":                                    "swim2_apac",
+	"SwimFins":                                     "swim_fins",
+	"SwimKickboard":                                "swim_kickboard",
+	"SwimPaddles":                                  "swim_paddles",
+	"SwimPullBuoy":                                 "swim_pull_buoy",
+	"SwimSnorkel":                                  "swim_snorkel",
+	"SwimStroke":                                   "swim_stroke",
+	"SwimToBikeTransition":                         "swim_to_bike_transition",
+	"Swimming":                                     "swimming",
+	"SwingSensor":                                  "swing_sensor",
+	"SwingSensorRemote":                            "swing_sensor_remote",
+	"SwissBallBackExtension":                       "swiss_ball_back_extension",
+	"SwissBallBodyWeightWallSquat":                 "swiss_ball_body_weight_wall_squat",
+	"SwissBallCrunch":                              "swiss_ball_crunch",
+	"SwissBallDumbbellChestPress":                  "swiss_ball_dumbbell_chest_press",
+	"SwissBallDumbbellFlye":                        "swiss_ball_dumbbell_flye",
+	"SwissBallDumbbellLyingTricepsExtension":       "swiss_ball_dumbbell_lying_triceps_extension",
+	"SwissBallDumbbellOverheadTricepsExtension": "swiss_ball_dumbbell_overhead_triceps_extension",
+	"SwissBallDumbbellShoulderPress":            "swiss_ball_dumbbell_shoulder_press",
+	"SwissBallEzBarLyingTricepsExtension":       "swiss_ball_ez_bar_lying_triceps_extension",
+	"SwissBallEzBarOverheadTricepsExtension":    "swiss_ball_ez_bar_overhead_triceps_extension",
+	"SwissBallEzBarPreacherCurl":                "swiss_ball_ez_bar_preacher_curl",
+	"SwissBallEzBarPullover":                    "swiss_ball_ez_bar_pullover",
+	"SwissBallHipCrossover":                     "swiss_ball_hip_crossover",
+	"SwissBallHipRaiseAndLegCurl":               "swiss_ball_hip_raise_and_leg_curl",
+	"SwissBallHyperextension":                   "swiss_ball_hyperextension",
+	"SwissBallIRaise":                           "swiss_ball_i_raise",
+	"SwissBallJackknife":                        "swiss_ball_jackknife",
+	"SwissBallOppositeArmAndLegLift":            "swiss_ball_opposite_arm_and_leg_lift",
+	"SwissBallPike":                             "swiss_ball_pike",
+	"SwissBallPlank":                            "swiss_ball_plank",
+	"SwissBallPlankLegLift":                     "swiss_ball_plank_leg_lift",
+	"SwissBallPlankLegLiftAndHold":              "swiss_ball_plank_leg_lift_and_hold",
+	"SwissBallPlankWithFeetOnBench":             "swiss_ball_plank_with_feet_on_bench",
+	"SwissBallProneJackknife":                   "swiss_ball_prone_jackknife",
+	"SwissBallPushUp":                           "swiss_ball_push_up",
+	"SwissBallPushUpPlus":                       "swiss_ball_push_up_plus",
+	"SwissBallReachRollAndLift":                 "swiss_ball_reach_roll_and_lift",
+	"SwissBallReverseCrunch":                    "swiss_ball_reverse_crunch",
+	"SwissBallRollout":                          "swiss_ball_rollout",
+	"SwissBallRussianTwist":                     "swiss_ball_russian_twist",
+	"SwissBallSideCrunch":                       "swiss_ball_side_crunch",
+	"SwissBallSidePlank":                        "swiss_ball_side_plank",
+	"SwissBallTRaise":                           "swiss_ball_t_raise",
+	"SwissBallWRaise":                           "swiss_ball_w_raise",
+	"SwissBallWindshieldWipers":                 "swiss_ball_windshield_wipers",
+	"SwissBallYRaise":                           "swiss_ball_y_raise",
+	"SwissGrid":                                 "swiss_grid",
+	"Switch":                                    "switch",
+	"Sydney":                                    "sydney",
+	"SystemTime":                                "system_time",
+	"SystemTimestamp":                           "system_timestamp",
+	"SystemTimestampMs":                         "system_timestamp_ms",
+	"SystolicPressure":                          "systolic_pressure",
+	"TBarRow":                                   "t_bar_row",
+	"TPushUp":                                   "t_push_up",
+	"TStabilization":                            "t_stabilization",
+	"TabletopDip":                               "tabletop_dip",
+	"Tactical":                                  "tactical",
+	"Tacx":                                      "tacx",
+	"TacxTrainingAppAndroid":                    "tacx_training_app_android",
+	"TacxTrainingAppIos":                        "tacx_training_app_ios",
+	"TacxTrainingAppLegacy":                     "tacx_training_app_legacy",
+	"TacxTrainingAppMac":                        "tacx_training_app_mac",
+	"TacxTrainingAppWin":                        "tacx_training_app_win",
+	"TaiwanGrid":                                "taiwan_grid",
+	"Taiwanese":                                 "taiwanese",
+	"Tanita":                                    "tanita",
+	"TapInterface":                              "tap_interface",
+	"TapSensitivity":                            "tap_sensitivity",
+	"TargetCadenceZone":                         "target_cadence_zone",
+	"TargetHrZone":                              "target_hr_zone",
+	"TargetPowerZone":                           "target_power_zone",
+	"TargetSpeedZone":                           "target_speed_zone",
+	"TargetStrokeType":                          "target_stroke_type",
+	"TargetType":                                "target_type",
+	"TargetValue":                               "target_value",
+	"Tasmania":                                  "tasmania",
+	"Tcx":                                       "tcx",
+	"Teaser":                                    "teaser",
+	"Technogym":                                 "technogym",
+	"Tehran":                                    "tehran",
+	"Tempe":                                     "tempe",
+	"Temperature":                               "temperature",
+	"TemperatureFeelsLike":                      "temperature_feels_like",
+	"TemperatureMax":                            "temperature_max",
+	"TemperatureMin":                            "temperature_min",
+	"TemperatureSetting":                        "temperature_setting",
+	"TenSecondAverage":                          "ten_second_average",
+	"Tennis":                                    "tennis",
+	"Text":                                      "text",
+	"Thai":                                      "thai",
+	"TheHundred":                                "the_hundred",
+	"TheHurtBox":                                "the_hurt_box",
+	"TheSufferfest":                             "the_sufferfest",
+	"TheTeaser":                                 "the_teaser",
+	"TheTeaserWeighted":                         "the_teaser_weighted",
+	"Thinkrider":                                "thinkrider",
+	"Third":                                     "third",
+	"ThirdCategory":                             "third_category",
+	"ThirtySecondAverage":                       "thirty_second_average",
+	"ThitaElektronik":                           "thita_elektronik",
+	"ThoracicCrunchesOnFoamRoller":              "thoracic_crunches_on_foam_roller",
+	"ThoracicRotation":                          "thoracic_rotation",
+	"ThreatApproaching":                         "threat_approaching",
+	"ThreatApproachingFast":                     "threat_approaching_fast",
+	"ThreatNone":                                "threat_none",
+	"ThreatUnknown":                             "threat_unknown",
+	"ThreeDSensorCalibration":                   "three_d_sensor_calibration",
+	"ThreePartRollDown":                         "three_part_roll_down",
+	"ThreeSecondAverage":                        "three_second_average",
+	"ThreeWayPlank":                             "three_way_plank",
+	"ThresholdHeartRate":                        "threshold_heart_rate",
+	"ThresholdPower":                            "threshold_power",
+	"Throat":                                    "throat",
+	"Thrusters":                                 "thrusters",
+	"Thunderstorms":                             "thunderstorms",
+	"Thursday":                                  "thursday",
+	"Tigrasport":                                "tigrasport",
+	"Time":                                      "time",
+	"Time128":                                   "time128",
+	"Time256":                                   "time256",
+	"TimeCreated":                               "time_created",
+	"TimeDurationAlert":                         "time_duration_alert",
+	"TimeFromCourse":                            "time_from_course",
+	"TimeInCadenceZone":                         "time_in_cadence_zone",
+	"TimeInHeartRateZone":                       "time_in_heart_rate_zone",
+	"TimeInHrZone":                              "time_in_hr_zone",
+	"TimeInPowerZone":                           "time_in_power_zone",
+	"TimeInSpeedZone":                           "time_in_speed_zone",
+	"TimeIntoDay":                               "time_into_day",
+	"TimeMode":                                  "time_mode",
+	"TimeOfDay":                                 "time_of_day",
+	"TimeOffset":                                "time_offset",
+	"TimeOnZone":                                "time_on_zone",
+	"TimeOnly":                                  "time_only",
+	"TimeSeated":                                "time_seated",
+	"TimeStanding":                              "time_standing",
+	"TimeToSurface":                             "time_to_surface",
+	"TimeZone":                                  "time_zone",
+	"TimeZoneOffset":                            "time_zone_offset",
+	"Timer":                                     "timer",
+	"TimerTime":                                 "timer_time",
+	"TimerTrigger":                              "timer_trigger",
+	"Timestamp":                                 "timestamp",
+	"Timestamp16":                               "timestamp_16",
+	"TimestampCorrelation":                      "timestamp_correlation",
+	"TimestampMin8":                             "timestamp_min_8",
+	"TimestampMs":                               "timestamp_ms",
+	"Timex":                                     "timex",
+	"TissueModelType":                           "tissue_model_type",
+	"Title":                                     "title",
+	"ToDestination":                             "to_destination",
+	"ToGo":                                      "to_go",
+	"ToNext":                                    "to_next",
+	"ToesToBar":                                 "toes_to_bar",
+	"Tokyo":                                     "tokyo",
+	"Tomtom":                                    "tomtom",
+	"Tone":                                      "tone",
+	"ToneAndVibrate":                            "tone_and_vibrate",
+	"TopactionTechnology":                       "topaction_technology",
+	"Tornado":                                   "tornado",
+	"TorqueEffectiveness":                       "torque_effectiveness",
+	"TorsoBack":                                 "torso_back",
+	"TorsoFront":                                "torso_front",
+	"Total":                                     "total",
+	"TotalAnaerobicTrainingEffect":              "total_anaerobic_training_effect",
+	"TotalAscent":                               "total_ascent",
+	"TotalBody":                                 "total_body",
+	"TotalBodyExerciseName":                     "total_body_exercise_name",
+	"TotalCalories":                             "total_calories",
+	"TotalCycles":                               "total_cycles",
+	"TotalDescent":                              "total_descent",
+	"TotalDistance":                             "total_distance",
+	"TotalElapsedTime":                          "total_elapsed_time",
+	"TotalFatCalories":                          "total_fat_calories",
+	"TotalFlow":                                 "total_flow",
+	"TotalFractionalAscent":                     "total_fractional_ascent",
+	"TotalFractionalCycles":                     "total_fractional_cycles",
+	"TotalFractionalDescent":                    "total_fractional_descent",
+	"TotalGrit":                                 "total_grit",
+	"TotalHemoglobinConc":                       "total_hemoglobin_conc",
+	"TotalHemoglobinConcMax":                    "total_hemoglobin_conc_max",
+	"TotalHemoglobinConcMin":                    "total_hemoglobin_conc_min",
+	"TotalMovingTime":                           "total_moving_time",
+	"TotalStrides":                              "total_strides",
+	"TotalStrokes":                              "total_strokes",
+	"TotalTimerTime":                            "total_timer_time",
+	"TotalTrainingEffect":                       "total_training_effect",
+	"TotalWork":                                 "total_work",
+	"Totals":                                    "totals",
+	"Touring":                                   "touring",
+	"TowelGripInvertedRow":                      "towel_grip_inverted_row",
+	"TowelPlankAndKneeIn":                       "towel_plank_and_knee_in",
+	"TowelPullUp":                               "towel_pull_up",
+	"Track":                                     "track",
+	"TrackAngleHeadingValid":                    "track_angle_heading_valid",
+	"TrackCycling":                              "track_cycling",
+	"TrackMe":                                   "track_me",
+	"Traditional":                               "traditional",
+	"Trail":                                     "trail",
+	"Trailforks":                                "trailforks",
+	"TrainerResistance":                         "trainer_resistance",
+	"TrainerRoad":                               "trainer_road",
+	"TrainerTargetPower":                        "trainer_target_power",
+	"Training":                                  "training",
+	"TrainingCenter":                            "training_center",
+	"TrainingEffect":                            "training_effect",
+	"TrainingFile":                              "training_file",
+	"TrainingPeaksTss":                          "training_peaks_tss",
+	"TrainingStressScore":                       "training_stress_score",
+	"Transition":                                "transition",
+	"TransitionToSeated":                        "transition_to_seated",
+	"TransitionToStanding":                      "transition_to_standing",
+	"TransmissionType":                          "transmission_type",
+	"Transportation":                            "transportation",
+	"TrapBarDeadlift":                           "trap_bar_deadlift",
+	"Treadmill":                                 "treadmill",
+	"TriangleHipPress":                          "triangle_hip_press",
+	"TricepsCrunch":                             "triceps_crunch",
+	"TricepsExtension":                          "triceps_extension",
+	"TricepsExtensionExerciseName":              "triceps_extension_exercise_name",
+	"TricepsExtensionOnFloor":                   "triceps_extension_on_floor",
+	"TricepsPressdown":                          "triceps_pressdown",
+	"Trigger":                                   "trigger",
+	"TripleStopBarbellBenchPress":               "triple_stop_barbell_bench_press",
+	"TripleStopPushUp":                          "triple_stop_push_up",
+	"TripleUnder":                               "triple_under",
+	"TropicalStorm":                             "tropical_storm",
+	"TrueTrackAngle":                            "true_track_angle",
+	"TrueUp":                                    "true_up",
+	"Truswing":                                  "truswing",
+	"TrxSuspendedJackknife":                     "trx_suspended_jackknife",
+	"Tsunami":                                   "tsunami",
+	"Tuesday":                                   "tuesday",
+	"Turkish":                                   "turkish",
+	"TurkishGetUpToSidePlank":                   "turkish_get_up_to_side_plank",
+	"TurnLeftIdx":                               "turn_left_idx",
+	"TurnRate":                                  "turn_rate",
+	"TurnRateValid":                             "turn_rate_valid",
+	"TurnRightIdx":                              "turn_right_idx",
+	"TurnType":                                  "turn_type",
+	"TwistingStandingDumbbellBicepsCurl":        "twisting_standing_dumbbell_biceps_curl",
+	"TwoPointPlank":                             "two_point_plank",
+	"Type":                                      "type",
+	"Typhoon":                                   "typhoon",
+	"UBoat":                                     "u_boat",
+	"UTurn":                                     "u_turn",
+	"Uint16":                                    "uint16",
+	"Uint16z":                                   "uint16z",
+	"Uint32":                                    "uint32",
+	"Uint32z":                                   "uint32z",
+	"Uint64":                                    "uint64",
+	"Uint64z":                                   "uint64z",
+	"Uint8":                                     "uint8",
+	"Uint8z":                                    "uint8z",
+	"Ukrainian":                                 "ukrainian",
+	"Uncategorized":                             "uncategorized",
+	"UnderhandGripCableRow":                     "underhand_grip_cable_row",
+	"UnderhandGripRearLateralRaise":             "underhand_grip_rear_lateral_raise",
+	"UnevenSquat":                               "uneven_squat",
+	"UnitedStatesGrid":                          "united_states_grid",
+	"Units":                                     "units",
+	"Unknown":                                   "unknown",
+	"UnknownPrecipitation":                      "unknown_precipitation",
+	"Unspecified":                               "unspecified",
+	"Url":                                       "url",
+	"UsAlaska":                                  "us_alaska",
+	"UsArizona":                                 "us_arizona",
+	"UsAtlantic":                                "us_atlantic",
+	"UsCentral":                                 "us_central",
+	"UsEastern":                                 "us_eastern",
+	"UsHawaii":                                  "us_hawaii",
+	"UsMountain":                                "us_mountain",
+	"UsPacific":                                 "us_pacific",
+	"User":                                      "user",
+	"UserLocalId":                               "user_local_id",
+	"UserMarker":                                "user_marker",
+	"UserProfile":                               "user_profile",
+	"UserProfileIndex":                          "user_profile_index",
+	"UserProfilePrimaryKey":                     "user_profile_primary_key",
+	"UserRunningStepLength":                     "user_running_step_length",
+	"UserWalkingStepLength":                     "user_walking_step_length",
+	"Utc":                                       "utc",
+	"UtcOffset":                                 "utc_offset",
+	"UtcTimestamp":                              "utc_timestamp",
+	"UtmUpsGrid":                                "utm_ups_grid",
+	"UturnLeftIdx":                              "uturn_left_idx",
+	"UturnRightIdx":                             "uturn_right_idx",
+	"Uuid":                                      "uuid",
+	"VGripCableRow":                             "v_grip_cable_row",
+	"VUp":                                       "v_up",
+	"Valid":                                     "valid",
+	"Validity":                                  "validity",
+	"Valley":                                    "valley",
+	"Value":                                     "value",
+	"Vam":                                       "vam",
+	"VariaHeadlight":                            "varia_headlight",
+	"VariaRadarDisplay":                         "varia_radar_display",
+	"VariaRadarTaillight":                       "varia_radar_taillight",
+	"VariaRemote":                               "varia_remote",
+	"VariaTaillight":                            "varia_taillight",
+	"VariaTaillightOld":                         "varia_taillight_old",
+	"VariaUt800":                                "varia_ut800",
+	"VariaVision":                               "varia_vision",
+	"Vdo":                                       "vdo",
+	"Vector2":                                   "vector_2",
+	"Vector2s":                                  "vector_2s",
+	"VectorCp":                                  "vector_cp",
+	"VectorS":                                   "vector_s",
+	"VectorSs":                                  "vector_ss",
+	"Velocity":                                  "velocity",
+	"Velosense":                                 "velosense",
+	"Venezuela":                                 "venezuela",
+	"Venu":                                      "venu",
+	"Venu2plus":                                 "venu2plus",
+	"VenuDaimler":                               "venu_daimler",
+	"VenuDaimlerAsia":                           "venu_daimler_asia",
+	"Venusq":                                    "venusq",
+	"VenusqAsia":                                "venusq_asia",
+	"VersaDesign":                               "versa_design",
+	"Version":                                   "version",
+	"VerticalDistance":                          "vertical_distance",
+	"VerticalOscillation":                       "vertical_oscillation",
+	"VerticalRatio":                             "vertical_ratio",
+	"VerticalSpeed":                             "vertical_speed",
+	"Vibrate":                                   "vibrate",
+	"Video":                                     "video",
+	"VideoClip":                                 "video_clip",
+	"VideoDescription":                          "video_description",
+	"VideoEnd":                                  "video_end",
+	"VideoFrame":                                "video_frame",
+	"VideoPause":                                "video_pause",
+	"VideoResume":                               "video_resume",
+	"VideoSecondStreamEnd":                      "video_second_stream_end",
+	"VideoSecondStreamPause":                    "video_second_stream_pause",
+	"VideoSecondStreamResume":                   "video_second_stream_resume",
+	"VideoSecondStreamSplit":                    "video_second_stream_split",
+	"VideoSecondStreamSplitStart":               "video_second_stream_split_start",
+	"VideoSecondStreamStart":                    "video_second_stream_start",
+	"VideoSplit":                                "video_split",
+	"VideoSplitStart":                           "video_split_start",
+	"VideoStart":                                "video_start",
+	"VideoTitle":                                "video_title",
+	"Vienna":                                    "vienna",
+	"Vietnamese":                                "vietnamese",
+	"VigorousActivityMinutes":                   "vigorous_activity_minutes",
+	"Virb360":                                   "virb_360",
+	"VirbElite":                                 "virb_elite",
+	"VirbRemote":                                "virb_remote",
+	"VirbUltra30":                               "virb_ultra_30",
+	"Virbx":                                     "virbx",
+	"Virbxe":                                    "virbxe",
+	"VirtualActivity":                           "virtual_activity",
+	"VirtualPartner":                            "virtual_partner",
+	"VirtualPartnerPace":                        "virtual_partner_pace",
+	"VirtualPartnerSpeed":                       "virtual_partner_speed",
+	"Virtualtraining":                           "virtualtraining",
+	"Virtugo":                                   "virtugo",
+	"VisceralFatMass":                           "visceral_fat_mass",
+	"VisceralFatRating":                         "visceral_fat_rating",
+	"VivoActive":                                "vivo_active",
+	"VivoActive3mApac":                          "vivo_active3m_apac",
+	"VivoActive3tChn":                           "vivo_active3t_chn",
+	"VivoActive4LargeAsia":                      "vivo_active4_large_asia",
+	"VivoActive4OledAsia":                       "vivo_active4_oled_asia",
+	"VivoActive4SmallAsia":                      "vivo_active4_small_asia",
+	"VivoActiveApac":                            "vivo_active_apac",
+	"VivoActiveHr":                              "vivo_active_hr",
+	"VivoActiveHrApac":                          "vivo_active_hr_apac",
+	"VivoFit":                                   "vivo_fit",
+	"VivoFit2":                                  "vivo_fit2",
+	"VivoFit3":                                  "vivo_fit3",
+	"VivoFitJr":                                 "vivo_fit_jr",
+	"VivoKi":                                    "vivo_ki",
+	"VivoMove":                                  "vivo_move",
+	"VivoMove3":                                 "vivo_move3",
+	"VivoMove3Asia":                             "vivo_move3_asia",
+	"VivoMove3Premium":                          "vivo_move3_premium",
+	"VivoMoveHr":                                "vivo_move_hr",
+	"VivoMoveHrAsia":                            "vivo_move_hr_asia",
+	"VivoSmart":                                 "vivo_smart",
+	"VivoSmart2":                                "vivo_smart2",
+	"VivoSmart3":                                "vivo_smart3",
+	"VivoSmart3Apac":                            "vivo_smart3_apac",
+	"VivoSmart4Asia":                            "vivo_smart4_asia",
+	"VivoSmartApac":                             "vivo_smart_apac",
+	"VivoSmartEmea":                             "vivo_smart_emea",
+	"VivoSmartGpsHr":                            "vivo_smart_gps_hr",
+	"VivoSmartGpsHrAsia":                        "vivo_smart_gps_hr_asia",
+	"VivoSmartHr":                               "vivo_smart_hr",
+	"VivoSmartHrAsia":                           "vivo_smart_hr_asia",
+	"VivoSport":                                 "vivo_sport",
+	"VivoSportApac":                             "vivo_sport_apac",
+	"Vivoactive3":                               "vivoactive3",
+	"Vivoactive3Daimler":                        "vivoactive3_daimler",
+	"Vivoactive3mL":                             "vivoactive3m_l",
+	"Vivoactive3mW":                             "vivoactive3m_w",
+	"Vivoactive4Large":                          "vivoactive4_large",
+	"Vivoactive4Small":                          "vivoactive4_small",
+	"Vivosmart4":                                "vivosmart_4",
+	"Vladivostok":                               "vladivostok",
+	"Vmg":                                       "vmg",
+	"WahooFitness":                              "wahoo_fitness",
+	"WaistFront":                                "waist_front",
+	"WaistLeft":                                 "waist_left",
+	"WaistMidBack":                              "waist_mid_back",
+	"WaistRight":                                "waist_right",
+	"WaistSlimmingSquat":                        "waist_slimming_squat",
+	"WakeTime":                                  "wake_time",
+	"Wakeboarding":                              "wakeboarding",
+	"Walk":                                      "walk",
+	"Walking":                                   "walking",
+	"WalkingBarbellLunge":                       "walking_barbell_lunge",
+	"WalkingDumbbellLunge":                      "walking_dumbbell_lunge",
+	"WalkingHighKicks":                          "walking_high_kicks",
+	"WalkingHighKnees":                          "walking_high_knees",
+	"WalkingKneeHugs":                           "walking_knee_hugs",
+	"WalkingLegCradles":                         "walking_leg_cradles",
+	"WalkingLunge":                              "walking_lunge",
+	"Walkout":                                   "walkout",
+	"WalkoutFromPushUpPosition":                 "walkout_from_push_up_position",
+	"WallBall":                                  "wall_ball",
+	"WallSlide":                                 "wall_slide",
+	"WarmUp":                                    "warm_up",
+	"WarmUpExerciseName":                        "warm_up_exercise_name",
+	"Warmup":                                    "warmup",
+	"Warning":                                   "warning",
+	"Warsaw":                                    "warsaw",
+	"WashingtonDc":                              "washington_dc",
+	"Watch":                                     "watch",
+	"WatchfaceMode":                             "watchface_mode",
+	"WatchfaceSettings":                         "watchface_settings",
+	"Water":                                     "water",
+	"WaterDensity":                              "water_density",
+	"WaterSkiing":                               "water_skiing",
+	"WaterType":                                 "water_type",
+	"Waterrower":                                "waterrower",
+	"Waterspout":                                "waterspout",
+	"Wattbike":                                  "wattbike",
+	"Watteam":                                   "watteam",
+	"Watts":                                     "watts",
+	"WattsOffset":                               "watts_offset",
+	"WattsPerKilogram":                          "watts_per_kilogram",
+	"Weather":                                   "weather",
+	"WeatherAlert":                              "weather_alert",
+	"WeatherAlerts":                             "weather_alerts",
+	"WeatherAlertsEnabled":                      "weather_alerts_enabled",
+	"WeatherConditions":                         "weather_conditions",
+	"WeatherConditionsEnabled":                  "weather_conditions_enabled",
+	"WeatherReport":                             "weather_report",
+	"WeatherSevereType":                         "weather_severe_type",
+	"WeatherSeverity":                           "weather_severity",
+	"WeatherStatus":                             "weather_status",
+	"Wednesday":                                 "wednesday",
+	"Weekly":                                    "weekly",
+	"Weight":                                    "weight",
+	"WeightDisplayUnit":                         "weight_display_unit",
+	"WeightPlateFrontRaise":                     "weight_plate_front_raise",
+	"WeightScale":                               "weight_scale",
+	"WeightSetting":                             "weight_setting",
+	"Weighted45DegreePlank":                     "weighted_45_degree_plank",
+	"Weighted90DegreeStaticHold":                "weighted_90_degree_static_hold",
+	"WeightedAbsJabs":                           "weighted_abs_jabs",
+	"WeightedAlternatingHandsMedicineBallPushUp":       "weighted_alternating_hands_medicine_ball_push_up",
+	"WeightedAlternatingJumpLunge":                     "weighted_alternating_jump_lunge",
+	"WeightedAlternatingSitUp":                         "weighted_alternating_sit_up",
+	"WeightedAlternatingSlideOut":                      "weighted_alternating_slide_out",
+	"WeightedAlternatingStaggeredPushUp":               "weighted_alternating_staggered_push_up",
+	"WeightedBackExtensionWithOppositeArmAndLegReach":  "weighted_back_extension_with_opposite_arm_and_leg_reach",
+	"WeightedBackSquats":                               "weighted_back_squats",
+	"WeightedBalancingSquat":                           "weighted_balancing_squat",
+	"WeightedBarbellRollout":                           "weighted_barbell_rollout",
+	"WeightedBaseRotations":                            "weighted_base_rotations",
+	"WeightedBearCrawl":                                "weighted_bear_crawl",
+	"WeightedBenchDip":                                 "weighted_bench_dip",
+	"WeightedBenchSquatWithRotationalChop":             "weighted_bench_squat_with_rotational_chop",
+	"WeightedBentKneeReverseHyperextension":            "weighted_bent_knee_reverse_hyperextension",
+	"WeightedBentKneeSwissBallReverseHipRaise":         "weighted_bent_knee_swiss_ball_reverse_hip_raise",
+	"WeightedBentKneeVUp":                              "weighted_bent_knee_v_up",
+	"WeightedBicycleCrunch":                            "weighted_bicycle_crunch",
+	"WeightedBobAndWeaveCircle":                        "weighted_bob_and_weave_circle",
+	"WeightedBosuBallPushUp":                           "weighted_bosu_ball_push_up",
+	"WeightedBoxStepSquat":                             "weighted_box_step_squat",
+	"WeightedBridgeWithLegExtension":                   "weighted_bridge_with_leg_extension",
+	"WeightedBurpee":                                   "weighted_burpee",
+	"WeightedBurpeeBoxJump":                            "weighted_burpee_box_jump",
+	"WeightedBurpeePullUp":                             "weighted_burpee_pull_up",
+	"WeightedButterflySitup":                           "weighted_butterfly_situp",
+	"WeightedCardioCoreCrawl":                          "weighted_cardio_core_crawl",
+	"WeightedClamBridge":                               "weighted_clam_bridge",
+	"WeightedClappingPushUp":                           "weighted_clapping_push_up",
+	"WeightedCloseGripChinUp":                          "weighted_close_grip_chin_up",
+	"WeightedCloseGripMedicineBallPushUp":              "weighted_close_grip_medicine_ball_push_up",
+	"WeightedCloseHandsPushUp":                         "weighted_close_hands_push_up",
+	"WeightedCrescentCircle":                           "weighted_crescent_circle",
+	"WeightedCrossBodyMountainClimber":                 "weighted_cross_body_mountain_climber",
+	"WeightedCrossChopToKnee":                          "weighted_cross_chop_to_knee",
+	"WeightedCrossKneeStrike":                          "weighted_cross_knee_strike",
+	"WeightedCrossLegReverseCrunch":                    "weighted_cross_leg_reverse_crunch",
+	"WeightedCrossPunchRollUp":                         "weighted_cross_punch_roll_up",
+	"WeightedCrossedArmsCrunch":                        "weighted_crossed_arms_crunch",
+	"WeightedCrossedArmsSitUp":                         "weighted_crossed_arms_sit_up",
+	"WeightedCrossoverChinUp":                          "weighted_crossover_chin_up",
+	"WeightedCrunch":                                   "weighted_crunch",
+	"WeightedCrunchChop":                               "weighted_crunch_chop",
+	"WeightedCyclingRussianTwist":                      "weighted_cycling_russian_twist",
+	"WeightedDeadBug":                                  "weighted_dead_bug",
+	"WeightedDeclinePushUp":                            "weighted_decline_push_up",
+	"WeightedDepthJump":                                "weighted_depth_jump",
+	"WeightedDiamondPushUp":                            "weighted_diamond_push_up",
+	"WeightedDip":                                      "weighted_dip",
+	"WeightedDonkeyCalfRaise":                          "weighted_donkey_calf_raise",
+	"WeightedDoubleCrunch":                             "weighted_double_crunch",
+	"WeightedDoubleUnder":                              "weighted_double_under",
+	"WeightedElbowPlankPikeJacks":                      "weighted_elbow_plank_pike_jacks",
+	"WeightedElbowToKneeCrunch":                        "weighted_elbow_to_knee_crunch",
+	"WeightedElevatedFeetInvertedRow":                  "weighted_elevated_feet_inverted_row",
+	"WeightedElevatedFeetPlank":                        "weighted_elevated_feet_plank",
+	"WeightedElevatedFeetRussianTwist":                 "weighted_elevated_feet_russian_twist",
+	"WeightedElevatedSingleLegSquat":                   "weighted_elevated_single_leg_squat",
+	"WeightedElevatorAbs":                              "weighted_elevator_abs",
+	"WeightedExplosiveCrossoverPushUp":                 "weighted_explosive_crossover_push_up",
+	"WeightedExplosivePushUp":                          "weighted_explosive_push_up",
+	"WeightedExtendedPlank":                            "weighted_extended_plank",
+	"WeightedExternalHipRaise":                         "weighted_external_hip_raise",
+	"WeightedFeetElevatedSideToSidePushUp":             "weighted_feet_elevated_side_to_side_push_up",
+	"WeightedFigureFourSquats":                         "weighted_figure_four_squats",
+	"WeightedFireHydrantKicks":                         "weighted_fire_hydrant_kicks",
+	"WeightedFloorIRaise":                              "weighted_floor_i_raise",
+	"WeightedFloorInvertedShoulderPress":               "weighted_floor_inverted_shoulder_press",
+	"WeightedFloorTRaise":                              "weighted_floor_t_raise",
+	"WeightedFloorYRaise":                              "weighted_floor_y_raise",
+	"WeightedFlutterKicks":                             "weighted_flutter_kicks",
+	"WeightedFoamRollerReverseCrunchOnBench":           "weighted_foam_roller_reverse_crunch_on_bench",
+	"WeightedFrontKickTabletop":                        "weighted_front_kick_tabletop",
+	"WeightedFrontKneeStrike":                          "weighted_front_knee_strike",
+	"WeightedFullPlankPasseTwist":                      "weighted_full_plank_passe_twist",
+	"WeightedGetUpSitUp":                               "weighted_get_up_sit_up",
+	"WeightedGhdBackExtensions":                        "weighted_ghd_back_extensions",
+	"WeightedHalfKneelingRotation":                     "weighted_half_kneeling_rotation",
+	"WeightedHandReleasePushUp":                        "weighted_hand_release_push_up",
+	"WeightedHandstandPushUp":                          "weighted_handstand_push_up",
+	"WeightedHangingHurdle":                            "weighted_hanging_hurdle",
+	"WeightedHangingKneeRaise":                         "weighted_hanging_knee_raise",
+	"WeightedHangingKneeRaiseObliqueCrunch":            "weighted_hanging_knee_raise_oblique_crunch",
+	"WeightedHangingLegRaise":                          "weighted_hanging_leg_raise",
+	"WeightedHangingSingleLegRaise":                    "weighted_hanging_single_leg_raise",
+	"WeightedHighBoxJump":                              "weighted_high_box_jump",
+	"WeightedHipCircles":                               "weighted_hip_circles",
+	"WeightedHipCrossover":                             "weighted_hip_crossover",
+	"WeightedHipExtensionAndCross":                     "weighted_hip_extension_and_cross",
+	"WeightedHipRaise":                                 "weighted_hip_raise",
+	"WeightedHipRaiseWithFeetOnSwissBall":              "weighted_hip_raise_with_feet_on_swiss_ball",
+	"WeightedHipRaiseWithHeadOnBosuBall":               "weighted_hip_raise_with_head_on_bosu_ball",
+	"WeightedHipRaiseWithHeadOnSwissBall":              "weighted_hip_raise_with_head_on_swiss_ball",
+	"WeightedHipRaiseWithKneeSqueeze":                  "weighted_hip_raise_with_knee_squeeze",
+	"WeightedHollowHoldAndRoll":                        "weighted_hollow_hold_and_roll",
+	"WeightedHollowRock":                               "weighted_hollow_rock",
+	"WeightedHoveringSitUp":                            "weighted_hovering_sit_up",
+	"WeightedInchingElbowPlank":                        "weighted_inching_elbow_plank",
+	"WeightedInchwormToSidePlank":                      "weighted_inchworm_to_side_plank",
+	"WeightedInclineDip":                               "weighted_incline_dip",
+	"WeightedInclineIRaise":                            "weighted_incline_i_raise",
+	"WeightedInclineLRaise":                            "weighted_incline_l_raise",
+	"WeightedInclinePushUp":                            "weighted_incline_push_up",
+	"WeightedInclineRearLegExtension":                  "weighted_incline_rear_leg_extension",
+	"WeightedInclineReverseCrunch":                     "weighted_incline_reverse_crunch",
+	"WeightedInclineTRaise":                            "weighted_incline_t_raise",
+	"WeightedInclineWRaise":                            "weighted_incline_w_raise",
+	"WeightedInclineYRaise":                            "weighted_incline_y_raise",
+	"WeightedInnerThighLift":                           "weighted_inner_thigh_lift",
+	"WeightedInvertedRowWithFeetOnSwissBall":           "weighted_inverted_row_with_feet_on_swiss_ball",
+	"WeightedInvertedShoulderPress":                    "weighted_inverted_shoulder_press",
+	"WeightedIsometricExplosiveJumpSquat":              "weighted_isometric_explosive_jump_squat",
+	"WeightedIsometricExplosivePushUp":                 "weighted_isometric_explosive_push_up",
+	"WeightedJudoPushUp":                               "weighted_judo_push_up",
+	"WeightedJumpRope":                                 "weighted_jump_rope",
+	"WeightedJumpRopeCrossover":                        "weighted_jump_rope_crossover",
+	"WeightedJumpRopeJog":                              "weighted_jump_rope_jog",
+	"WeightedJumpSquat":                                "weighted_jump_squat",
+	"WeightedJumpingJacks":                             "weighted_jumping_jacks",
+	"WeightedJumpingPullUps":                           "weighted_jumping_pull_ups",
+	"WeightedKicks":                                    "weighted_kicks",
+	"WeightedKippingPullUp":                            "weighted_kipping_pull_up",
+	"WeightedKneeRaises":                               "weighted_knee_raises",
+	"WeightedKneelingAbWheel":                          "weighted_kneeling_ab_wheel",
+	"WeightedKneelingCrossCrunch":                      "weighted_kneeling_cross_crunch",
+	"WeightedKneelingPlank":                            "weighted_kneeling_plank",
+	"WeightedKneelingPushUp":                           "weighted_kneeling_push_up",
+	"WeightedKneelingSidePlankWithLegLift":             "weighted_kneeling_side_plank_with_leg_lift",
+	"WeightedKneelingSuperman":                         "weighted_kneeling_superman",
+	"WeightedLPullUp":                                  "weighted_l_pull_up",
+	"WeightedLateralLeapAndHop":                        "weighted_lateral_leap_and_hop",
+	"WeightedLateralPlyoSquats":                        "weighted_lateral_plyo_squats",
+	"WeightedLateralRoll":                              "weighted_lateral_roll",
+	"WeightedLateralSlide":                             "weighted_lateral_slide",
+	"WeightedLateralStepover":                          "weighted_lateral_stepover",
+	"WeightedLegCurl":                                  "weighted_leg_curl",
+	"WeightedLegExtensions":                            "weighted_leg_extensions",
+	"WeightedLegLoweringDrill":                         "weighted_leg_lowering_drill",
+	"WeightedLunge":                                    "weighted_lunge",
+	"WeightedLungeMatrix":                              "weighted_lunge_matrix",
+	"WeightedLyingReversePlank":                        "weighted_lying_reverse_plank",
+	"WeightedLyingStraightLegRaise":                    "weighted_lying_straight_leg_raise",
+	"WeightedMarchingHipRaise":                         "weighted_marching_hip_raise",
+	"WeightedMarchingHipRaiseWithFeetOnASwissBall":     "weighted_marching_hip_raise_with_feet_on_a_swiss_ball",
+	"WeightedMcgillCurlUp":                             "weighted_mcgill_curl_up",
+	"WeightedMedicineBallMountainClimber":              "weighted_medicine_ball_mountain_climber",
+	"WeightedMedicineBallPushUp":                       "weighted_medicine_ball_push_up",
+	"WeightedMixedGripChinUp":                          "weighted_mixed_grip_chin_up",
+	"WeightedMixedGripPullUp":                          "weighted_mixed_grip_pull_up",
+	"WeightedModifiedFrontLever":                       "weighted_modified_front_lever",
+	"WeightedModifiedInvertedRow":                      "weighted_modified_inverted_row",
+	"WeightedModifiedMountainClimberAndExtension":      "weighted_modified_mountain_climber_and_extension",
+	"WeightedModifiedPilatesRollUpWithBall":            "weighted_modified_pilates_roll_up_with_ball",
+	"WeightedMountainClimber":                          "weighted_mountain_climber",
+	"WeightedMountainClimberOnSlidingDiscs":            "weighted_mountain_climber_on_sliding_discs",
+	"WeightedMountainClimberWithFeetOnBosuBall":        "weighted_mountain_climber_with_feet_on_bosu_ball",
+	"WeightedMountainClimberWithHandsOnBench":          "weighted_mountain_climber_with_hands_on_bench",
+	"WeightedMountainClimberWithHandsOnSwissBall":      "weighted_mountain_climber_with_hands_on_swiss_ball",
+	"WeightedOneArmFloorPress":                         "weighted_one_arm_floor_press",
+	"WeightedOneArmPushUp":                             "weighted_one_arm_push_up",
+	"WeightedOpenKneeTucks":                            "weighted_open_knee_tucks",
+	"WeightedParalletteHandstandPushUp":                "weighted_parallette_handstand_push_up",
+	"WeightedPartialSingleLegSquat":                    "weighted_partial_single_leg_squat",
+	"WeightedPilatesCrunch":                            "weighted_pilates_crunch",
+	"WeightedPilatesRollUpWithBall":                    "weighted_pilates_roll_up_with_ball",
+	"WeightedPistolSquat":                              "weighted_pistol_squat",
+	"WeightedPlank":                                    "weighted_plank",
+	"WeightedPlankJacksWithFeetOnSlidingDiscs":         "weighted_plank_jacks_with_feet_on_sliding_discs",
+	"WeightedPlankKneeTucks":                           "weighted_plank_knee_tucks",
+	"WeightedPlankKneeTwist":                           "weighted_plank_knee_twist",
+	"WeightedPlankPikeJumps":                           "weighted_plank_pike_jumps",
+	"WeightedPlankPikes":                               "weighted_plank_pikes",
+	"WeightedPlankToStandUp":                           "weighted_plank_to_stand_up",
+	"WeightedPlankWithArmRaise":                        "weighted_plank_with_arm_raise",
+	"WeightedPlankWithKneeToElbow":                     "weighted_plank_with_knee_to_elbow",
+	"WeightedPlankWithObliqueCrunch":                   "weighted_plank_with_oblique_crunch",
+	"WeightedPlieSlides":                               "weighted_plie_slides",
+	"WeightedPlieSquat":                                "weighted_plie_squat",
+	"WeightedPlyometricSidePlank":                      "weighted_plyometric_side_plank",
+	"WeightedPowerSquatChops":                          "weighted_power_squat_chops",
+	"WeightedPretzelSideKick":                          "weighted_pretzel_side_kick",
+	"WeightedPrisonerSquat":                            "weighted_prisoner_squat",
+	"WeightedProneHipInternalRotation":                 "weighted_prone_hip_internal_rotation",
+	"WeightedPullUp":                                   "weighted_pull_up",
+	"WeightedPushUp":                                   "weighted_push_up",
+	"WeightedPushUpAndRow":                             "weighted_push_up_and_row",
+	"WeightedPushUpPlus":                               "weighted_push_up_plus",
+	"WeightedPushUpWithFeetOnSwissBall":                "weighted_push_up_with_feet_on_swiss_ball",
+	"WeightedPushUpWithOneHandOnMedicineBall":          "weighted_push_up_with_one_hand_on_medicine_ball",
+	"WeightedQuadrupedHipExtension":                    "weighted_quadruped_hip_extension",
+	"WeightedQuadrupedLegRaise":                        "weighted_quadruped_leg_raise",
+	"WeightedQuadrupedWithLegLift":                     "weighted_quadruped_with_leg_lift",
+	"WeightedRaisedLegsCrunch":                         "weighted_raised_legs_crunch",
+	"WeightedRecliningCircle":                          "weighted_reclining_circle",
+	"WeightedReverseCrunch":                            "weighted_reverse_crunch",
+	"WeightedReverseCrunchOnABench":                    "weighted_reverse_crunch_on_a_bench",
+	"WeightedReverseCurlAndLift":                       "weighted_reverse_curl_and_lift",
+	"WeightedReverseCurlUp":                            "weighted_reverse_curl_up",
+	"WeightedReverseHipRaise":                          "weighted_reverse_hip_raise",
+	"WeightedReverseLegRaise":                          "weighted_reverse_leg_raise",
+	"WeightedReverseLegRaiseOnSwissBall":               "weighted_reverse_leg_raise_on_swiss_ball",
+	"WeightedReverseLungeWithReachBack":                "weighted_reverse_lunge_with_reach_back",
+	"WeightedReverseLungeWithTwistAndOverheadReach":    "weighted_reverse_lunge_with_twist_and_overhead_reach",
+	"WeightedReverseSlidingBoxLunge":                   "weighted_reverse_sliding_box_lunge",
+	"WeightedReverseSlidingLunge":                      "weighted_reverse_sliding_lunge",
+	"WeightedRingDip":                                  "weighted_ring_dip",
+	"WeightedRingHandstandPushUp":                      "weighted_ring_handstand_push_up",
+	"WeightedRingMuscleUp":                             "weighted_ring_muscle_up",
+	"WeightedRingPushUp":                               "weighted_ring_push_up",
+	"WeightedRollingSidePlank":                         "weighted_rolling_side_plank",
+	"WeightedRopeClimb":                                "weighted_rope_climb",
+	"WeightedRotationalLift":                           "weighted_rotational_lift",
+	"WeightedRunnersLungeToBalance":                    "weighted_runners_lunge_to_balance",
+	"WeightedRussianTwistOnSwissBall":                  "weighted_russian_twist_on_swiss_ball",
+	"WeightedSeatedAlternatingReverseCrunch":           "weighted_seated_alternating_reverse_crunch",
+	"WeightedSeatedCalfRaise":                          "weighted_seated_calf_raise",
+	"WeightedSeatedLegU":                               "weighted_seated_leg_u",
+	"WeightedSerratusChairShrug":                       "weighted_serratus_chair_shrug",
+	"WeightedSerratusShrug":                            "weighted_serratus_shrug",
+	"WeightedShoulderPushUp":                           "weighted_shoulder_push_up",
+	"WeightedSideAbsLegLift":                           "weighted_side_abs_leg_lift",
+	"WeightedSideAndCrossoverLunge":                    "weighted_side_and_crossover_lunge",
+	"WeightedSideBend":                                 "weighted_side_bend",
+	"WeightedSideKickPlank":                            "weighted_side_kick_plank",
+	"WeightedSideLunge":                                "weighted_side_lunge",
+	"WeightedSideLungeSweep":                           "weighted_side_lunge_sweep",
+	"WeightedSideLungeToCrossoverTap":                  "weighted_side_lunge_to_crossover_tap",
+	"WeightedSideLyingLegRaise":                        "weighted_side_lying_leg_raise",
+	"WeightedSidePlank":                                "weighted_side_plank",
+	"WeightedSidePlankAndRow":                          "weighted_side_plank_and_row",
+	"WeightedSidePlankLift":                            "weighted_side_plank_lift",
+	"WeightedSidePlankWithElbowOnBosuBall":             "weighted_side_plank_with_elbow_on_bosu_ball",
+	"WeightedSidePlankWithFeetOnBench":                 "weighted_side_plank_with_feet_on_bench",
+	"WeightedSidePlankWithKneeCircle":                  "weighted_side_plank_with_knee_circle",
+	"WeightedSidePlankWithKneeTuck":                    "weighted_side_plank_with_knee_tuck",
+	"WeightedSidePlankWithLegLift":                     "weighted_side_plank_with_leg_lift",
+	"WeightedSidePlankWithReachUnder":                  "weighted_side_plank_with_reach_under",
+	"WeightedSideStep":                                 "weighted_side_step",
+	"WeightedSideToSideCrunchAndWeave":                 "weighted_side_to_side_crunch_and_weave",
+	"WeightedSideToSideLungeChops":                     "weighted_side_to_side_lunge_chops",
+	"WeightedSideToSideShuffleJump":                    "weighted_side_to_side_shuffle_jump",
+	"WeightedSiffJumpLunge":                            "weighted_siff_jump_lunge",
+	"WeightedSingleArmInvertedRow":                     "weighted_single_arm_inverted_row",
+	"WeightedSingleArmMedicineBallPushUp":              "weighted_single_arm_medicine_ball_push_up",
+	"WeightedSingleLegBackExtension":                   "weighted_single_leg_back_extension",
+	"WeightedSingleLegBenchDipAndKick":                 "weighted_single_leg_bench_dip_and_kick",
+	"WeightedSingleLegBenchGetUp":                      "weighted_single_leg_bench_get_up",
+	"WeightedSingleLegBenchSquat":                      "weighted_single_leg_bench_squat",
+	"WeightedSingleLegBentKneeCalfRaise":               "weighted_single_leg_bent_knee_calf_raise",
+	"WeightedSingleLegDip":                             "weighted_single_leg_dip",
+	"WeightedSingleLegDonkeyCalfRaise":                 "weighted_single_leg_donkey_calf_raise",
+	"WeightedSingleLegElevatedFeetPlank":               "weighted_single_leg_elevated_feet_plank",
+	"WeightedSingleLegFlexAndExtend":                   "weighted_single_leg_flex_and_extend",
+	"WeightedSingleLegHipRaise":                        "weighted_single_leg_hip_raise",
+	"WeightedSingleLegHipRaiseWithFootOnBench":         "weighted_single_leg_hip_raise_with_foot_on_bench",
+	"WeightedSingleLegHipRaiseWithFootOnBosuBall":      "weighted_single_leg_hip_raise_with_foot_on_bosu_ball",
+	"WeightedSingleLegHipRaiseWithFootOnFoamRoller":    "weighted_single_leg_hip_raise_with_foot_on_foam_roller",
+	"WeightedSingleLegHipRaiseWithFootOnMedicineBall":  "weighted_single_leg_hip_raise_with_foot_on_medicine_ball",
+	"WeightedSingleLegHipRaiseWithHeadOnBosuBall":      "weighted_single_leg_hip_raise_with_head_on_bosu_ball",
+	"WeightedSingleLegLoweringDrill":                   "weighted_single_leg_lowering_drill",
+	"WeightedSingleLegReverseCrunch":                   "weighted_single_leg_reverse_crunch",
+	"WeightedSingleLegSidePlank":                       "weighted_single_leg_side_plank",
+	"WeightedSingleLegSquatOnSwissBall":                "weighted_single_leg_squat_on_swiss_ball",
+	"WeightedSingleLegSwissBallJackknife":              "weighted_single_leg_swiss_ball_jackknife",
+	"WeightedSitUp":                                    "weighted_sit_up",
+	"WeightedSkaterCrunchCross":                        "weighted_skater_crunch_cross",
+	"WeightedSkiMoguls":                                "weighted_ski_moguls",
+	"WeightedSlidingHipAdduction":                      "weighted_sliding_hip_adduction",
+	"WeightedSlidingLateralLunge":                      "weighted_sliding_lateral_lunge",
+	"WeightedSpidermanPlank":                           "weighted_spiderman_plank",
+	"WeightedSpidermanPushUp":                          "weighted_spiderman_push_up",
+	"WeightedSpineExtension":                           "weighted_spine_extension",
+	"WeightedSplitJacks":                               "weighted_split_jacks",
+	"WeightedSquat":                                    "weighted_squat",
+	"WeightedSquatJacks":                               "weighted_squat_jacks",
+	"WeightedSquatJumpOntoBox":                         "weighted_squat_jump_onto_box",
+	"WeightedSquatJumpsInAndOut":                       "weighted_squat_jumps_in_and_out",
+	"WeightedSquatPlankPushUp":                         "weighted_squat_plank_push_up",
+	"WeightedSquatThrusts":                             "weighted_squat_thrusts",
+	"WeightedStackedFeetPushUp":                        "weighted_stacked_feet_push_up",
+	"WeightedStaggeredHandsPushUp":                     "weighted_staggered_hands_push_up",
+	"WeightedStaggeredSquat":                           "weighted_staggered_squat",
+	"WeightedStandingAdduction":                        "weighted_standing_adduction",
+	"WeightedStandingCalfRaise":                        "weighted_standing_calf_raise",
+	"WeightedStandingHipAbduction":                     "weighted_standing_hip_abduction",
+	"WeightedStandingRearLegRaise":                     "weighted_standing_rear_leg_raise",
+	"WeightedStandingTRotationBalance":                 "weighted_standing_t_rotation_balance",
+	"WeightedStaticBackExtension":                      "weighted_static_back_extension",
+	"WeightedStepClimb":                                "weighted_step_climb",
+	"WeightedStepUp":                                   "weighted_step_up",
+	"WeightedStraightArmPlank":                         "weighted_straight_arm_plank",
+	"WeightedStraightArmPlankWithShoulderTouch":        "weighted_straight_arm_plank_with_shoulder_touch",
+	"WeightedSumoSquatSlideIn":                         "weighted_sumo_squat_slide_in",
+	"WeightedSumoSquatToStand":                         "weighted_sumo_squat_to_stand",
+	"WeightedSumoSquatWithRotation":                    "weighted_sumo_squat_with_rotation",
+	"WeightedSupermanFromFloor":                        "weighted_superman_from_floor",
+	"WeightedSupineHipInternalRotation":                "weighted_supine_hip_internal_rotation",
+	"WeightedSuspendedChinUp":                          "weighted_suspended_chin_up",
+	"WeightedSuspendedDip":                             "weighted_suspended_dip",
+	"WeightedSuspendedInvertedRow":                     "weighted_suspended_inverted_row",
+	"WeightedSuspendedPushUp":                          "weighted_suspended_push_up",
+	"WeightedSwissBallBackExtension":                   "weighted_swiss_ball_back_extension",
+	"WeightedSwissBallCrunch":                          "weighted_swiss_ball_crunch",
+	"WeightedSwissBallHyperextension":                  "weighted_swiss_ball_hyperextension",
+	"WeightedSwissBallIRaise":                          "weighted_swiss_ball_i_raise",
+	"WeightedSwissBallJackknife":                       "weighted_swiss_ball_jackknife",
+	"WeightedSwissBallOppositeArmAndLegLift":           "weighted_swiss_ball_opposite_arm_and_leg_lift",
+	"WeightedSwissBallPike":                            "weighted_swiss_ball_pike",
+	"WeightedSwissBallPlank":                           "weighted_swiss_ball_plank",
+	"WeightedSwissBallPlankLegLift":                    "weighted_swiss_ball_plank_leg_lift",
+	"WeightedSwissBallPlankLegLiftAndHold":             "weighted_swiss_ball_plank_leg_lift_and_hold",
+	"WeightedSwissBallPlankWithFeetOnBench":            "weighted_swiss_ball_plank_with_feet_on_bench",
+	"WeightedSwissBallProneJackknife":                  "weighted_swiss_ball_prone_jackknife",
+	"WeightedSwissBallPushUp":                          "weighted_swiss_ball_push_up",
+	"WeightedSwissBallPushUpPlus":                      "weighted_swiss_ball_push_up_plus",
+	"WeightedSwissBallReverseCrunch":                   "weighted_swiss_ball_reverse_crunch",
+	"WeightedSwissBallRollout":                         "weighted_swiss_ball_rollout",
+	"WeightedSwissBallRussianTwist":                    "weighted_swiss_ball_russian_twist",
+	"WeightedSwissBallSideCrunch":                      "weighted_swiss_ball_side_crunch",
+	"WeightedSwissBallSidePlank":                       "weighted_swiss_ball_side_plank",
+	"WeightedSwissBallTRaise":                          "weighted_swiss_ball_t_raise",
+	"WeightedSwissBallWRaise":                          "weighted_swiss_ball_w_raise",
+	"WeightedSwissBallWallSquat":                       "weighted_swiss_ball_wall_squat",
+	"WeightedSwissBallYRaise":                          "weighted_swiss_ball_y_raise",
+	"WeightedTPushUp":                                  "weighted_t_push_up",
+	"WeightedTStabilization":                           "weighted_t_stabilization",
+	"WeightedTabletopDip":                              "weighted_tabletop_dip",
+	"WeightedThoracicCrunchesOnFoamRoller":             "weighted_thoracic_crunches_on_foam_roller",
+	"WeightedThreePartRollDown":                        "weighted_three_part_roll_down",
+	"WeightedThreeWayPlank":                            "weighted_three_way_plank",
+	"WeightedToesToBar":                                "weighted_toes_to_bar",
+	"WeightedTowelGripInvertedRow":                     "weighted_towel_grip_inverted_row",
+	"WeightedTowelPlankAndKneeIn":                      "weighted_towel_plank_and_knee_in",
+	"WeightedTowelPullUp":                              "weighted_towel_pull_up",
+	"WeightedTriangleHipPress":                         "weighted_triangle_hip_press",
+	"WeightedTripleStopPushUp":                         "weighted_triple_stop_push_up",
+	"WeightedTripleUnder":                              "weighted_triple_under",
+	"WeightedTrxSuspendedJackknife":                    "weighted_trx_suspended_jackknife",
+	"WeightedTurkishGetUpToSidePlank":                  "weighted_turkish_get_up_to_side_plank",
+	"WeightedTwoPointPlank":                            "weighted_two_point_plank",
+	"WeightedUBoat":                                    "weighted_u_boat",
+	"WeightedUnevenSquat":                              "weighted_uneven_squat",
+	"WeightedVUp":                                      "weighted_v_up",
+	"WeightedWalkingLunge":                             "weighted_walking_lunge",
+	"WeightedWallSlide":                                "weighted_wall_slide",
+	"WeightedWallSquat":                                "weighted_wall_squat",
+	"WeightedWideGripPullUp":                           "weighted_wide_grip_pull_up",
+	"WeightedWideHandsPushUp":                          "weighted_wide_hands_push_up",
+	"WeightedWideStancePlankWithDiagonalArmLift":       "weighted_wide_stance_plank_with_diagonal_arm_lift",
+	"WeightedWideStancePlankWithDiagonalLegLift":       "weighted_wide_stance_plank_with_diagonal_leg_lift",
+	"WeightedWideStancePlankWithLegLift":               "weighted_wide_stance_plank_with_leg_lift",
+	"WeightedWideStancePlankWithOppositeArmAndLegLift": "weighted_wide_stance_plank_with_opposite_arm_and_leg_lift",
+	"WeightedWindmillSwitches":                         "weighted_windmill_switches",
+	"WeightedXAbs":                                     "weighted_x_abs",
+	"Wellgo":                                           "wellgo",
+	"WestMalayan":                                      "west_malayan",
+	"Whitewater":                                       "whitewater",
+	"Whoop":                                            "whoop",
+	"WideGripBarbellBenchPress":                        "wide_grip_barbell_bench_press",
+	"WideGripBarbellDeadlift":                          "wide_grip_barbell_deadlift",
+	"WideGripEzBarBicepsCurl":                          "wide_grip_ez_bar_biceps_curl",
+	"WideGripJumpShrug":                                "wide_grip_jump_shrug",
+	"WideGripLatPulldown":                              "wide_grip_lat_pulldown",
+	"WideGripOverheadBarbellSplitSquat":                "wide_grip_overhead_barbell_split_squat",
+	"WideGripPullUp":                                   "wide_grip_pull_up",
+	"WideGripSeatedCableRow":                           "wide_grip_seated_cable_row",
+	"WideHandsPushUp":                                  "wide_hands_push_up",
+	"WideStanceBarbellSquat":                           "wide_stance_barbell_squat",
+	"WideStanceGobletSquat":                            "wide_stance_goblet_squat",
+	"WideStancePlankWithDiagonalArmLift":               "wide_stance_plank_with_diagonal_arm_lift",
+	"WideStancePlankWithDiagonalLegLift":               "wide_stance_plank_with_diagonal_leg_lift",
+	"WideStancePlankWithLegLift":                       "wide_stance_plank_with_leg_lift",
+	"WideStancePlankWithOppositeArmAndLegLift":         "wide_stance_plank_with_opposite_arm_and_leg_lift",
+	"Wifi":                   "wifi",
+	"WifiVerification":       "wifi_verification",
+	"WildcardPairingTimeout": "wildcard_pairing_timeout",
+	"Wind":                   "wind",
+	"WindChill":              "wind_chill",
+	"WindDirection":          "wind_direction",
+	"WindSpeed":              "wind_speed",
+	"WindmillSwitches":       "windmill_switches",
+	"Windsurfing":            "windsurfing",
+	"Windy":                  "windy",
+	"Wingsuit":               "wingsuit",
+	"Winkhoek":               "winkhoek",
+	"WinterStorm":            "winter_storm",
+	"WinterWeather":          "winter_weather",
+	"WintryMix":              "wintry_mix",
+	"WktName":                "wkt_name",
+	"WktStepDuration":        "wkt_step_duration",
+	"WktStepIndex":           "wkt_step_index",
+	"WktStepName":            "wkt_step_name",
+	"WktStepTarget":          "wkt_step_target",
+	"Woodway":                "woodway",
+	"Work":                   "work",
+	"Workout":                "workout",
+	"WorkoutCapabilities":    "workout_capabilities",
+	"WorkoutDownload":        "workout_download",
+	"WorkoutDownloadEnabled": "workout_download_enabled",
+	"WorkoutEquipment":       "workout_equipment",
+	"WorkoutHr":              "workout_hr",
+	"WorkoutPower":           "workout_power",
+	"WorkoutSession":         "workout_session",
+	"WorkoutStep":            "workout_step",
+	"WorkoutsSupported":      "workouts_supported",
+	"WreckhouseWinds":        "wreckhouse_winds",
+	"Write":                  "write",
+	"Wtek":                   "wtek",
+	"XAbs":                   "x_abs",
+	"Xelic":                  "xelic",
+	"Xplova":                 "xplova",
+	"YamahaMotors":           "yamaha_motors",
+	"Yards":                  "yards",
+	"Yearly":                 "yearly",
+	"Yoga":                   "yoga",
+	"Zephyr":                 "zephyr",
+	"ZercherGoodMorning":     "zercher_good_morning",
+	"ZercherSquat":           "zercher_squat",
+	"Zhl16c":                 "zhl_16c",
+	"Zone":                   "zone",
+	"Zone1":                  "zone_1",
+	"Zone2":                  "zone_2",
+	"Zone3":                  "zone_3",
+	"Zone4":                  "zone_4",
+	"Zone5":                  "zone_5",
+	"Zone5cloud":             "zone5cloud",
+	"Zone6":                  "zone_6",
+	"Zone7":                  "zone_7",
+	"Zone8":                  "zone_8",
+	"Zone9":                  "zone_9",
+	"ZoneCount":              "zone_count",
+	"ZonesTarget":            "zones_target",
+	"Zurich":                 "zurich",
+	"Zwift":                  "zwift",
+}
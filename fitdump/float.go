@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ParseMissing checks the text for Options.Missing, which must be "-" or
+// "n/a"
+func ParseMissing(s string) (string, error) {
+	if s != "-" && s != "n/a" {
+		return "", fmt.Errorf("the text for missing values must be '-' or 'n/a'")
+	}
+	return s, nil
+}
+
+// missing returns the text printed in place of values which couldn't be
+// computed, "-" unless Missing is set
+func (o Options) missing() string {
+	if o.Missing == "" {
+		return "-"
+	}
+	return o.Missing
+}
+
+// finite reports whether v is neither NaN nor infinite
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// FormatFloat formats v with prec decimal places, followed by a space and
+// unit unless unit is "". NaN and infinite values are o.Missing, without the
+// unit.
+func (o Options) FormatFloat(v float64, prec int, unit string) string {
+	if !finite(v) {
+		return o.missing()
+	}
+	s := strconv.FormatFloat(v, 'f', prec, 64)
+	if unit != "" {
+		s += " " + unit
+	}
+	return s
+}
+
+// Float is a float64 which is written as null in JSON if it's NaN or
+// infinite, which encoding/json would otherwise fail on
+type Float float64
+
+// MarshalJSON implements json.Marshaler
+func (f Float) MarshalJSON() ([]byte, error) {
+	if !finite(float64(f)) {
+		return []byte("null"), nil
+	}
+	return strconv.AppendFloat(nil, float64(f), 'g', -1, 64), nil
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"io"
+	"sort"
+
+	"github.com/tormoder/fit"
+)
+
+// Formatter writes a decoded file to w in some output format
+type Formatter func(w io.Writer, fitf *fit.File) error
+
+var formats = make(map[string]Formatter)
+
+// RegisterFormat makes a Formatter available by name, such as for a
+// command's -format flag. Registering a name again replaces the formatter,
+// so that a command can register its built-in formats configured from its
+// flags. It's meant to be called from init functions, and isn't safe to call
+// concurrently with LookupFormat.
+func RegisterFormat(name string, f Formatter) {
+	if name == "" || f == nil {
+		panic("fitdump: RegisterFormat needs a name and a Formatter")
+	}
+	formats[name] = f
+}
+
+// LookupFormat returns the Formatter registered as name
+func LookupFormat(name string) (Formatter, bool) {
+	f, ok := formats[name]
+	return f, ok
+}
+
+// FormatNames returns the names of the registered formats, sorted
+func FormatNames() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
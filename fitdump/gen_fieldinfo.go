@@ -0,0 +1,714 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+//go:build ignore
+
+// gen_fieldinfo generates fieldinfo_table.go from the source of the fit
+// package. That source is itself generated from the FIT SDK profile, so it
+// holds everything we need: the field definitions (number and base type) in
+// profile.go, the scale, offset and units in the GetXXXScaled() helpers in
+// messages.go, and the components each field expands into in the
+// expandComponents() methods, and the invalid constant of each enum type in
+// types.go and types_man.go. Note that the fit package only records units for scaled
+// fields, so unscaled fields will have an empty Unit, apart from temperatures,
+// which are given "C".
+//
+// The snake_case profile names of messages and types are in the doc comments
+// of the fit package, but those of fields and enum values aren't kept, so
+// they're read from the newest Profile.xlsx in the testdata of the fit
+// package's generator. That can be older than the profile the fit package
+// was generated from, so SnakeCase falls back to guessing for names which
+// were added since.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const fitModule = "github.com/tormoder/fit"
+
+type field struct {
+	name   string
+	goType string
+	num    int
+	base   int
+	kind   int
+	array  bool
+	unit   string
+	scale  string
+	offset string
+}
+
+type message struct {
+	name   string
+	fields []*field
+}
+
+// Mirrors the bit-packing of types.Fit in the fit package
+func decodeFitType(v int) (base, kind int, array bool) {
+	return v & 0x1f, (v >> 6) & 0x7, v&0x20 != 0
+}
+
+var invalidByBase = map[int]string{
+	0x00: "0xFF",               // enum
+	0x01: "0x7F",               // sint8
+	0x02: "0xFF",               // uint8
+	0x03: "0x7FFF",             // sint16
+	0x04: "0xFFFF",             // uint16
+	0x05: "0x7FFFFFFF",         // sint32
+	0x06: "0xFFFFFFFF",         // uint32
+	0x07: `""`,                 // string
+	0x08: "0xFFFFFFFF",         // float32
+	0x09: "0xFFFFFFFFFFFFFFFF", // float64
+	0x0a: "0x00",               // uint8z
+	0x0b: "0x0000",             // uint16z
+	0x0c: "0x00000000",         // uint32z
+	0x0d: "0xFF",               // byte
+	0x0e: "0x7FFFFFFFFFFFFFFF", // sint64
+	0x0f: "0xFFFFFFFFFFFFFFFF", // uint64
+	0x10: "0x0000000000000000", // uint64z
+}
+
+var goTypeByBase = map[int]string{
+	0x00: "uint8",
+	0x01: "int8",
+	0x02: "uint8",
+	0x03: "int16",
+	0x04: "uint16",
+	0x05: "int32",
+	0x06: "uint32",
+	0x07: "string",
+	0x08: "float32",
+	0x09: "float64",
+	0x0a: "uint8",
+	0x0b: "uint16",
+	0x0c: "uint32",
+	0x0d: "uint8",
+	0x0e: "int64",
+	0x0f: "uint64",
+	0x10: "uint64",
+}
+
+var baseNames = map[int]string{
+	0x00: "enum",
+	0x01: "sint8",
+	0x02: "uint8",
+	0x03: "sint16",
+	0x04: "uint16",
+	0x05: "sint32",
+	0x06: "uint32",
+	0x07: "string",
+	0x08: "float32",
+	0x09: "float64",
+	0x0a: "uint8z",
+	0x0b: "uint16z",
+	0x0c: "uint32z",
+	0x0d: "byte",
+	0x0e: "sint64",
+	0x0f: "uint64",
+	0x10: "uint64z",
+}
+
+// baseType returns the name of the field's base type, as in the profile,
+// marking arrays with "[]"
+func (f *field) baseType() string {
+	if f.array {
+		return baseNames[f.base] + "[]"
+	}
+	return baseNames[f.base]
+}
+
+func (f *field) invalid() string {
+	switch {
+	case f.array:
+		// Arrays are invalid when empty
+		return "nil"
+	case f.kind != 0:
+		// Time and position types know their own validity
+		return "nil"
+	case f.goType == "bool":
+		return "false"
+	case f.base == 0x07:
+		return `""`
+	case f.base == 0x08:
+		return "math.Float32frombits(" + invalidByBase[f.base] + ")"
+	case f.base == 0x09:
+		return "math.Float64frombits(" + invalidByBase[f.base] + ")"
+	}
+	return goTypeByBase[f.base] + "(" + invalidByBase[f.base] + ")"
+}
+
+func fitDir() string {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", fitModule).Output()
+	if err != nil {
+		log.Fatalf("locating %s: %v", fitModule, err)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func parse(fset *token.FileSet, dir, name string) *ast.File {
+	f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return f
+}
+
+func intLit(e ast.Expr) int {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok {
+		log.Fatalf("expected literal, got %T", e)
+	}
+	v, err := strconv.ParseInt(lit.Value, 0, 64)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return int(v)
+}
+
+func numLit(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		return e.Value
+	case *ast.UnaryExpr:
+		return e.Op.String() + numLit(e.X)
+	}
+	log.Fatalf("expected number, got %T", e)
+	return ""
+}
+
+// structFields returns the Go field names and types of every XXXMsg struct
+func structFields(f *ast.File) map[string][][2]string {
+	structs := make(map[string][][2]string)
+	ast.Inspect(f, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok || !strings.HasSuffix(ts.Name.Name, "Msg") {
+			return false
+		}
+		var fields [][2]string
+		for _, fl := range st.Fields.List {
+			typ := typeString(fl.Type)
+			for _, n := range fl.Names {
+				fields = append(fields, [2]string{n.Name, typ})
+			}
+		}
+		structs[ts.Name.Name] = fields
+		return false
+	})
+	return structs
+}
+
+func typeString(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return typeString(e.X) + "." + e.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + typeString(e.Elt)
+	}
+	return fmt.Sprintf("%T", e)
+}
+
+func varValue(f *ast.File, name string) *ast.CompositeLit {
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, s := range gd.Specs {
+			vs := s.(*ast.ValueSpec)
+			if len(vs.Names) == 1 && vs.Names[0].Name == name {
+				return vs.Values[0].(*ast.CompositeLit)
+			}
+		}
+	}
+	log.Fatalf("var %s not found", name)
+	return nil
+}
+
+// scaling extracts the scale and offset from the expression
+// "float64(x.Field) / scale [- offset]"
+func scaling(fn *ast.FuncDecl) (scale, offset string) {
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		be, ok := n.(*ast.BinaryExpr)
+		if !ok || scale != "" {
+			return scale == ""
+		}
+		switch be.Op {
+		case token.SUB:
+			if quo, ok := be.X.(*ast.BinaryExpr); ok && quo.Op == token.QUO {
+				scale, offset = numLit(quo.Y), numLit(be.Y)
+				return false
+			}
+		case token.QUO:
+			scale = numLit(be.Y)
+			return false
+		}
+		return true
+	})
+	return scale, offset
+}
+
+// selectorFields returns the names of the fields of x used in n, in order
+func selectorFields(n ast.Node) []string {
+	var names []string
+	ast.Inspect(n, func(n ast.Node) bool {
+		if se, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := se.X.(*ast.Ident); ok && id.Name == "x" {
+				names = append(names, se.Sel.Name)
+			}
+		}
+		return true
+	})
+	return names
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// enumInvalids adds the value of the XxxInvalid constant of each type Xxx
+// declared in f to invalids
+func enumInvalids(f *ast.File, invalids map[string]string) {
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, s := range gd.Specs {
+			vs := s.(*ast.ValueSpec)
+			typ, ok := vs.Type.(*ast.Ident)
+			if !ok || len(vs.Names) != 1 || len(vs.Values) != 1 || vs.Names[0].Name != typ.Name+"Invalid" {
+				continue
+			}
+			if lit, ok := vs.Values[0].(*ast.BasicLit); ok {
+				invalids[typ.Name] = lit.Value
+			}
+		}
+	}
+}
+
+// toCamelCase converts a profile name to the Go name the fit package gives
+// it, as its generator does
+func toCamelCase(s string) string {
+	chunks := strings.Split(s, "_")
+	for i, c := range chunks {
+		if c != "" {
+			chunks[i] = string(unicode.ToUpper(rune(c[0]))) + c[1:]
+		}
+	}
+	return strings.Join(chunks, "")
+}
+
+var profileName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// addProfileName records that the Go name of the profile name snake is its
+// CamelCase form. Names which the fit package renames, or which aren't
+// names at all, are skipped.
+func addProfileName(names map[string]string, snake string) {
+	snake = strings.TrimSpace(snake)
+	if !profileName.MatchString(snake) {
+		return
+	}
+	camel := toCamelCase(snake)
+	if prev, ok := names[camel]; ok && prev != snake {
+		log.Fatalf("%s is the Go name of both %s and %s", camel, prev, snake)
+	}
+	names[camel] = snake
+}
+
+var representsRE = regexp.MustCompile(`^// (\w+) represents the (\w+) FIT (?:message )?type\.`)
+
+// commentNames adds the profile names of the messages and types declared
+// in f, from their doc comments, "// XxxMsg represents the xxx FIT message
+// type."
+func commentNames(f *ast.File, names map[string]string) {
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE || gd.Doc == nil {
+			continue
+		}
+		m := representsRE.FindStringSubmatch(gd.Doc.List[0].Text)
+		if m == nil {
+			continue
+		}
+		if toCamelCase(m[2]) == strings.TrimSuffix(m[1], "Msg") {
+			addProfileName(names, m[2])
+		}
+	}
+}
+
+// newestProfile returns the path of the Profile.xlsx with the highest
+// version in the testdata of the fit package's generator
+func newestProfile(dir string) string {
+	paths, err := filepath.Glob(filepath.Join(dir, "cmd", "fitgen", "internal", "profile", "testdata", "*.xlsx"))
+	if err != nil || len(paths) == 0 {
+		log.Fatalf("no profile found in %s", dir)
+	}
+	version := func(p string) (v [2]int) {
+		fmt.Sscanf(filepath.Base(p), "%d.%d.xlsx", &v[0], &v[1])
+		return v
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		a, b := version(paths[i]), version(paths[j])
+		return a[0] < b[0] || a[0] == b[0] && a[1] < b[1]
+	})
+	return paths[len(paths)-1]
+}
+
+// readXML decodes the file name in the xlsx archive r into v
+func readXML(r *zip.Reader, name string, v interface{}) {
+	f, err := r.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	if err := xml.NewDecoder(f).Decode(v); err != nil {
+		log.Fatalf("%s: %v", name, err)
+	}
+}
+
+// sheetColumns returns the cells of each row of the sheet named sheet of the
+// xlsx workbook r, as text, indexed by column letter
+func sheetColumns(r *zip.Reader, sheet string) []map[string]string {
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	readXML(r, "xl/workbook.xml", &workbook)
+
+	var rels struct {
+		Rels []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	readXML(r, "xl/_rels/workbook.xml.rels", &rels)
+
+	target := ""
+	for _, s := range workbook.Sheets {
+		for _, rel := range rels.Rels {
+			if s.Name == sheet && rel.ID == s.ID {
+				target = path.Join("xl", rel.Target)
+			}
+		}
+	}
+	if target == "" {
+		log.Fatalf("no %s sheet in the profile", sheet)
+	}
+
+	// Text cells hold an index into the shared strings. Rich text is
+	// split into runs.
+	var shared struct {
+		Items []struct {
+			Text string   `xml:"t"`
+			Runs []string `xml:"r>t"`
+		} `xml:"si"`
+	}
+	readXML(r, "xl/sharedStrings.xml", &shared)
+
+	var data struct {
+		Rows []struct {
+			Cells []struct {
+				Ref   string `xml:"r,attr"`
+				Type  string `xml:"t,attr"`
+				Value string `xml:"v"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	readXML(r, target, &data)
+
+	var rows []map[string]string
+	for _, row := range data.Rows {
+		cells := make(map[string]string)
+		for _, c := range row.Cells {
+			v := c.Value
+			if c.Type == "s" {
+				i, err := strconv.Atoi(v)
+				if err != nil || i >= len(shared.Items) {
+					log.Fatalf("bad shared string %q in %s", v, c.Ref)
+				}
+				v = shared.Items[i].Text + strings.Join(shared.Items[i].Runs, "")
+			}
+			cells[strings.TrimRight(c.Ref, "0123456789")] = v
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
+
+// xlsxNames adds the profile names of the messages, fields and enum values
+// in the Profile.xlsx at path. Message and type names are in column A, and
+// field and value names in column C.
+func xlsxNames(path string, names map[string]string) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer zr.Close()
+
+	for _, row := range sheetColumns(&zr.Reader, "Types") {
+		addProfileName(names, row["C"])
+	}
+	for _, row := range sheetColumns(&zr.Reader, "Messages") {
+		addProfileName(names, row["A"])
+		addProfileName(names, row["C"])
+	}
+}
+
+// components extracts which fields each field expands into from an
+// expandComponents method. Each top-level if tests a source field and
+// assigns its components. The compressed fields are checked in one if and
+// expanded in the next, which tests a local flag, so an if without a field
+// in its condition carries on from the one before.
+func components(fn *ast.FuncDecl) map[string][]string {
+	comps := make(map[string][]string)
+	src := ""
+	for _, stmt := range fn.Body.List {
+		is, ok := stmt.(*ast.IfStmt)
+		if !ok {
+			continue
+		}
+		if names := selectorFields(is.Cond); len(names) > 0 {
+			src = names[0]
+		}
+		ast.Inspect(is.Body, func(n ast.Node) bool {
+			as, ok := n.(*ast.AssignStmt)
+			if !ok {
+				return true
+			}
+			for _, lhs := range as.Lhs {
+				for _, dst := range selectorFields(lhs) {
+					if dst != src && !contains(comps[src], dst) {
+						comps[src] = append(comps[src], dst)
+					}
+				}
+			}
+			return true
+		})
+	}
+	return comps
+}
+
+func main() {
+	dir := fitDir()
+	fset := token.NewFileSet()
+	profile := parse(fset, dir, "profile.go")
+	messages := parse(fset, dir, "messages.go")
+
+	structs := structFields(messages)
+
+	invalids := make(map[string]string)
+	enumInvalids(parse(fset, dir, "types.go"), invalids)
+	enumInvalids(parse(fset, dir, "types_man.go"), invalids)
+
+	// Go name -> profile name
+	profileNames := make(map[string]string)
+	commentNames(messages, profileNames)
+	commentNames(parse(fset, dir, "types.go"), profileNames)
+	commentNames(parse(fset, dir, "types_man.go"), profileNames)
+	profilePath := newestProfile(dir)
+	xlsxNames(profilePath, profileNames)
+
+	// MesgNumXXX -> XXXMsg
+	typeNames := make(map[string]string)
+	for _, e := range varValue(profile, "msgsTypes").Elts {
+		kv := e.(*ast.KeyValueExpr)
+		call := kv.Value.(*ast.CallExpr)
+		lit := call.Args[0].(*ast.CompositeLit)
+		typeNames[kv.Key.(*ast.Ident).Name] = lit.Type.(*ast.Ident).Name
+	}
+
+	msgs := make(map[string]*message)
+	for _, e := range varValue(profile, "_fields").Elts {
+		kv := e.(*ast.KeyValueExpr)
+		typeName, ok := typeNames[kv.Key.(*ast.Ident).Name]
+		if !ok {
+			continue
+		}
+		goFields := structs[typeName]
+
+		msg := &message{name: strings.TrimSuffix(typeName, "Msg")}
+		for _, fe := range kv.Value.(*ast.CompositeLit).Elts {
+			def := fe.(*ast.KeyValueExpr).Value.(*ast.CompositeLit).Elts
+			sindex := intLit(def[0])
+			fitType := intLit(def[2].(*ast.CallExpr).Args[0])
+			base, kind, array := decodeFitType(fitType)
+			msg.fields = append(msg.fields, &field{
+				name:   goFields[sindex][0],
+				goType: goFields[sindex][1],
+				num:    intLit(def[1]),
+				base:   base,
+				kind:   kind,
+				array:  array,
+				scale:  "1",
+				offset: "0",
+			})
+		}
+		msgs[typeName] = msg
+	}
+
+	for _, d := range messages.Decls {
+		fn, ok := d.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || !strings.HasSuffix(fn.Name.Name, "Scaled") {
+			continue
+		}
+		recv := fn.Recv.List[0].Type.(*ast.StarExpr).X.(*ast.Ident).Name
+		msg, ok := msgs[recv]
+		if !ok {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(fn.Name.Name, "Get"), "Scaled")
+		for _, f := range msg.fields {
+			if f.name != name {
+				continue
+			}
+			for _, c := range fn.Doc.List {
+				if i := strings.Index(c.Text, "Units: "); i >= 0 {
+					f.unit = strings.TrimSpace(c.Text[i+len("Units: "):])
+				}
+			}
+			if scale, offset := scaling(fn); scale != "" {
+				f.scale = scale
+				if offset != "" {
+					f.offset = offset
+				}
+			}
+		}
+	}
+
+	// The fit package only has units for scaled fields, and temperatures
+	// aren't scaled, so they're in degrees Celsius by their name
+	for _, msg := range msgs {
+		for _, f := range msg.fields {
+			if f.unit == "" && f.baseType() == "sint8" && strings.Contains(f.name, "Temperature") {
+				f.unit = "C"
+			}
+		}
+	}
+
+	// XXXMsg -> source field -> component fields
+	comps := make(map[string]map[string][]string)
+	for _, d := range messages.Decls {
+		fn, ok := d.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != "expandComponents" {
+			continue
+		}
+		recv := fn.Recv.List[0].Type.(*ast.StarExpr).X.(*ast.Ident).Name
+		if _, ok := msgs[recv]; ok {
+			comps[recv] = components(fn)
+		}
+	}
+
+	var names []string
+	for n := range msgs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var table bytes.Buffer
+	fmt.Fprintf(&table, "var fieldInfos = map[string]map[string]Info{\n")
+	for _, n := range names {
+		msg := msgs[n]
+		fmt.Fprintf(&table, "%q: {\n", msg.name)
+		for _, f := range msg.fields {
+			fmt.Fprintf(&table, "%q: {Num: %d, BaseType: %q, Unit: %q, Scale: %s, Offset: %s, Invalid: %s},\n",
+				f.name, f.num, f.baseType(), f.unit, f.scale, f.offset, f.invalid())
+		}
+		fmt.Fprintf(&table, "},\n")
+	}
+	fmt.Fprintf(&table, "}\n\n")
+
+	fmt.Fprintf(&table, "var fieldComponents = map[string]map[string][]string{\n")
+	for _, n := range names {
+		if len(comps[n]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&table, "%q: {\n", msgs[n].name)
+		var srcs []string
+		for src := range comps[n] {
+			srcs = append(srcs, src)
+		}
+		sort.Strings(srcs)
+		for _, src := range srcs {
+			fmt.Fprintf(&table, "%q: {", src)
+			for _, dst := range comps[n][src] {
+				fmt.Fprintf(&table, "%q, ", dst)
+			}
+			fmt.Fprintf(&table, "},\n")
+		}
+		fmt.Fprintf(&table, "},\n")
+	}
+	fmt.Fprintf(&table, "}\n\n")
+
+	var types []string
+	for t := range invalids {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	fmt.Fprintf(&table, "var enumInvalids = map[string]uint64{\n")
+	for _, t := range types {
+		fmt.Fprintf(&table, "%q: %s,\n", t, invalids[t])
+	}
+	fmt.Fprintf(&table, "}\n\n")
+
+	var goNames []string
+	for n := range profileNames {
+		goNames = append(goNames, n)
+	}
+	sort.Strings(goNames)
+
+	fmt.Fprintf(&table, "// profileNames maps the Go names of messages, fields, types and enum\n")
+	fmt.Fprintf(&table, "// values to their names in the profile, from profile %s\n", strings.TrimSuffix(filepath.Base(profilePath), ".xlsx"))
+	fmt.Fprintf(&table, "var profileNames = map[string]string{\n")
+	for _, n := range goNames {
+		fmt.Fprintf(&table, "%q: %q,\n", n, profileNames[n])
+	}
+	fmt.Fprintf(&table, "}\n")
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_fieldinfo.go from %s. DO NOT EDIT.\n\n", filepath.Base(dir))
+	fmt.Fprintf(&buf, "package fitdump\n\n")
+	if bytes.Contains(table.Bytes(), []byte("math.")) {
+		fmt.Fprintf(&buf, "import \"math\"\n\n")
+	}
+	buf.Write(table.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("fieldinfo_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+type gpxRoutePoint struct {
+	Lat  string `xml:"lat,attr"`
+	Lon  string `xml:"lon,attr"`
+	Ele  string `xml:"ele,omitempty"`
+	Time string `xml:"time,omitempty"`
+	Name string `xml:"name,omitempty"`
+	Type string `xml:"type,omitempty"`
+}
+
+type gpxRoute struct {
+	Name   string          `xml:"name,omitempty"`
+	Points []gpxRoutePoint `xml:"rtept"`
+}
+
+type gpx struct {
+	XMLName xml.Name `xml:"http://www.topografix.com/GPX/1/1 gpx"`
+	Version string   `xml:"version,attr"`
+	Creator string   `xml:"creator,attr"`
+	Route   gpxRoute `xml:"rte"`
+}
+
+func gpxCoord(deg float64) string {
+	return strconv.FormatFloat(deg, 'f', 7, 64)
+}
+
+func gpxTime(t time.Time) string {
+	if fit.IsBaseTime(t) {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// recordPoint returns the route point for a record, preferring the enhanced
+// altitude
+func recordPoint(r *fit.RecordMsg) gpxRoutePoint {
+	p := gpxRoutePoint{
+		Lat:  gpxCoord(r.PositionLat.Degrees()),
+		Lon:  gpxCoord(r.PositionLong.Degrees()),
+		Time: gpxTime(r.Timestamp),
+	}
+	if alt := r.GetEnhancedAltitudeScaled(); !math.IsNaN(alt) {
+		p.Ele = strconv.FormatFloat(alt, 'f', 1, 64)
+	} else if alt := r.GetAltitudeScaled(); !math.IsNaN(alt) {
+		p.Ele = strconv.FormatFloat(alt, 'f', 1, 64)
+	}
+	return p
+}
+
+// cuePoint returns the route point for a course point, named and typed so
+// that navigation apps can show it as a cue
+func cuePoint(cp *fit.CoursePointMsg) gpxRoutePoint {
+	p := gpxRoutePoint{
+		Lat:  gpxCoord(cp.PositionLat.Degrees()),
+		Lon:  gpxCoord(cp.PositionLong.Degrees()),
+		Time: gpxTime(cp.Timestamp),
+		Name: cp.Name,
+	}
+	if cp.Type != fit.CoursePointInvalid {
+		p.Type = SnakeCase(cp.Type.String())
+	}
+	return p
+}
+
+// cueBefore reports whether a course point comes before a record along the
+// course, by distance if both have one, otherwise by time
+func cueBefore(cp *fit.CoursePointMsg, r *fit.RecordMsg) bool {
+	if FieldValid(cp, "Distance") && FieldValid(r, "Distance") {
+		return cp.Distance <= r.Distance
+	}
+	return !cp.Timestamp.After(r.Timestamp)
+}
+
+// WriteGPXRoute writes a course as a GPX 1.1 route, with a route point for
+// each record with a position. With cues, the course points are included
+// too, in their place along the course, as named route points.
+func WriteGPXRoute(w io.Writer, course *fit.CourseFile, cues bool) error {
+	doc := gpx{
+		Version: "1.1",
+		Creator: "fit-tools",
+	}
+	if course.Course != nil {
+		doc.Route.Name = course.Course.Name
+	}
+
+	var points []*fit.CoursePointMsg
+	if cues {
+		for _, cp := range course.CoursePoints {
+			if !cp.PositionLat.Invalid() && !cp.PositionLong.Invalid() {
+				points = append(points, cp)
+			}
+		}
+		sort.SliceStable(points, func(i, j int) bool {
+			a, b := points[i], points[j]
+			if FieldValid(a, "Distance") && FieldValid(b, "Distance") {
+				return a.Distance < b.Distance
+			}
+			return a.Timestamp.Before(b.Timestamp)
+		})
+	}
+
+	for _, r := range course.Records {
+		if r.PositionLat.Invalid() || r.PositionLong.Invalid() {
+			continue
+		}
+		for len(points) > 0 && cueBefore(points[0], r) {
+			doc.Route.Points = append(doc.Route.Points, cuePoint(points[0]))
+			points = points[1:]
+		}
+		doc.Route.Points = append(doc.Route.Points, recordPoint(r))
+	}
+	// Any after the last record
+	for _, cp := range points {
+		doc.Route.Points = append(doc.Route.Points, cuePoint(cp))
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
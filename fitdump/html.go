@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// Size of the chart, in SVG units
+const (
+	htmlChartWidth  = 800
+	htmlChartHeight = 200
+	// The records are thinned to at most this many points, which is
+	// plenty for the width
+	htmlChartPoints = 1000
+)
+
+type htmlSection struct {
+	Name string
+	Dump string
+}
+
+type htmlSeries struct {
+	Name, Colour, Points string
+	Min, Max             string
+}
+
+type htmlReport struct {
+	Title    string
+	Type     string
+	Summary  [][2]string
+	Series   []htmlSeries
+	Sections []htmlSection
+	Width    int
+	Height   int
+}
+
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 1em 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th { text-align: left; padding-right: 2em; color: #555; font-weight: normal; }
+svg { border: 1px solid #ccc; background: #fafafa; }
+.legend span { margin-right: 2em; }
+summary { cursor: pointer; padding: 0.2em 0; }
+pre { background: #f4f4f4; padding: 0.5em; overflow-x: auto; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Type}} file</p>
+{{- if .Summary}}
+<table>
+{{- range .Summary}}
+<tr><th>{{index . 0}}</th><td>{{index . 1}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Series}}
+<svg width="{{.Width}}" height="{{.Height}}" viewBox="0 0 {{.Width}} {{.Height}}">
+{{- range .Series}}
+<polyline fill="none" stroke="{{.Colour}}" stroke-width="1.5" points="{{.Points}}"/>
+{{- end}}
+</svg>
+<p class="legend">
+{{- range .Series}}
+<span style="color: {{.Colour}}">{{.Name}}: {{.Min}} to {{.Max}}</span>
+{{- end}}
+</p>
+{{- end}}
+<h2>Messages</h2>
+{{- range .Sections}}
+<details>
+<summary>{{.Name}}</summary>
+<pre>{{.Dump}}</pre>
+</details>
+{{- end}}
+</body>
+</html>
+`))
+
+// scaledValue returns the physical value of a field of msg, or NaN if it's
+// invalid or not a number
+func scaledValue(msg reflect.Value, field string) float64 {
+	info, ok := FieldInfo(msg.Type().Name(), field)
+	v := msg.FieldByName(field)
+	if !ok || !v.IsValid() || info.IsInvalid(v) {
+		return math.NaN()
+	}
+	if f, ok := info.Value(v); ok {
+		return f
+	}
+	return math.NaN()
+}
+
+func htmlDistance(m float64, opts Options) string {
+	if opts.Units == Imperial {
+		return opts.FormatFloat(m/metersPerMile, 2, "mi")
+	}
+	return opts.FormatFloat(m/1000, 2, "km")
+}
+
+func htmlDuration(secs float64, opts Options) string {
+	if !finite(secs) {
+		return opts.missing()
+	}
+	return (time.Duration(secs) * time.Second).String()
+}
+
+// htmlSummary lists the totals of the first session of an activity
+func htmlSummary(activity fit.ActivityFile, opts Options) [][2]string {
+	if len(activity.Sessions) == 0 {
+		return nil
+	}
+	s := activity.Sessions[0]
+	msg := reflect.ValueOf(s).Elem()
+
+	sport := s.Sport.String()
+	if IsInvalidEnum(s.Sport) {
+		sport = opts.missing()
+	}
+	start := opts.missing()
+	if !fit.IsBaseTime(s.StartTime) {
+		start = s.StartTime.String()
+	}
+
+	summary := [][2]string{
+		{"Sport", sport},
+		{"Start", start},
+		{"Distance", htmlDistance(scaledValue(msg, "TotalDistance"), opts)},
+		{"Timer time", htmlDuration(scaledValue(msg, "TotalTimerTime"), opts)},
+		{"Elapsed time", htmlDuration(scaledValue(msg, "TotalElapsedTime"), opts)},
+		{"Average heart rate", opts.FormatFloat(scaledValue(msg, "AvgHeartRate"), 0, "bpm")},
+		{"Maximum heart rate", opts.FormatFloat(scaledValue(msg, "MaxHeartRate"), 0, "bpm")},
+		{"Average power", opts.FormatFloat(scaledValue(msg, "AvgPower"), 0, "W")},
+	}
+	if len(activity.Sessions) > 1 {
+		summary = append(summary, [2]string{"Sessions", fmt.Sprintf("%d, the first is shown", len(activity.Sessions))})
+	}
+	return summary
+}
+
+// htmlChart returns the records' altitude and heart rate against time, each
+// scaled to the height of the chart. Series without any values are left
+// out.
+func htmlChart(records []*fit.RecordMsg, opts Options) []htmlSeries {
+	if len(records) < 2 {
+		return nil
+	}
+	start, end := records[0].Timestamp, records[len(records)-1].Timestamp
+	span := end.Sub(start).Seconds()
+	if span <= 0 {
+		return nil
+	}
+	step := (len(records) + htmlChartPoints - 1) / htmlChartPoints
+
+	charts := []struct {
+		name, colour, unit string
+		fields             []string
+	}{
+		{"Altitude", "#393", "m", []string{"EnhancedAltitude", "Altitude"}},
+		{"Heart rate", "#c33", "bpm", []string{"HeartRate"}},
+	}
+
+	var series []htmlSeries
+	for _, c := range charts {
+		var xs, ys []float64
+		min, max := math.Inf(1), math.Inf(-1)
+		for i := 0; i < len(records); i += step {
+			msg := reflect.ValueOf(records[i]).Elem()
+			v := math.NaN()
+			for _, field := range c.fields {
+				if v = scaledValue(msg, field); finite(v) {
+					break
+				}
+			}
+			if !finite(v) {
+				continue
+			}
+			xs = append(xs, records[i].Timestamp.Sub(start).Seconds()/span*htmlChartWidth)
+			ys = append(ys, v)
+			min, max = math.Min(min, v), math.Max(max, v)
+		}
+		if len(ys) == 0 {
+			continue
+		}
+
+		var sb strings.Builder
+		for i := range ys {
+			y := htmlChartHeight / 2.0
+			if max > min {
+				// A margin of 5 above and below
+				y = htmlChartHeight - 5 - (ys[i]-min)/(max-min)*(htmlChartHeight-10)
+			}
+			fmt.Fprintf(&sb, "%.1f,%.1f ", xs[i], y)
+		}
+		series = append(series, htmlSeries{
+			Name:   c.name,
+			Colour: c.colour,
+			Points: strings.TrimSpace(sb.String()),
+			Min:    opts.FormatFloat(min, 0, c.unit),
+			Max:    opts.FormatFloat(max, 0, c.unit),
+		})
+	}
+	return series
+}
+
+// htmlSections returns the text dump of each field of val holding
+// messages, skipping those which are empty
+func htmlSections(val reflect.Value, opts Options) []htmlSection {
+	var sections []htmlSection
+	for i := 0; i < val.NumField(); i++ {
+		name := val.Type().Field(i).Name
+		field := val.Field(i)
+		if !exported(name) || field.Kind() == reflect.Ptr && field.IsNil() || field.Kind() == reflect.Slice && field.Len() == 0 {
+			continue
+		}
+
+		title := name
+		if opts.SnakeCase {
+			title = SnakeCase(name)
+		}
+		if field.Kind() == reflect.Slice {
+			title = fmt.Sprintf("%s (%d)", title, field.Len())
+		}
+
+		var buf bytes.Buffer
+		NewDumper(&buf, opts).Dump(field, title)
+		sections = append(sections, htmlSection{Name: title, Dump: buf.String()})
+	}
+	return sections
+}
+
+// WriteHTML writes a single, self-contained HTML page describing fitf, for
+// sharing with people who wouldn't read the text dump. Activities get a
+// summary of their first session and an SVG chart of the altitude and
+// heart rate. Each field of the file and of body, which is its body such as
+// fit.ActivityFile or the zero Value, is a collapsed section holding its
+// text dump.
+func WriteHTML(w io.Writer, title string, fitf *fit.File, body reflect.Value, opts Options) error {
+	report := htmlReport{
+		Title:  title,
+		Type:   fitf.Type().String(),
+		Width:  htmlChartWidth,
+		Height: htmlChartHeight,
+	}
+
+	if activity, ok := activityBody(body); ok {
+		report.Summary = htmlSummary(activity, opts)
+		report.Series = htmlChart(activity.Records, opts)
+	}
+
+	report.Sections = htmlSections(reflect.ValueOf(*fitf), opts)
+	if body.IsValid() {
+		report.Sections = append(report.Sections, htmlSections(body, opts)...)
+	}
+
+	return htmlTemplate.Execute(w, report)
+}
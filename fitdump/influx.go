@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+var (
+	influxTagEscaper    = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	influxStringEscaper = strings.NewReplacer(`"`, `\"`, `\`, `\\`)
+)
+
+// InfluxTags returns the tags identifying where records came from: the
+// device from fileId, and sport, each left out if it's invalid.
+func InfluxTags(fileId fit.FileIdMsg, sport fit.Sport) map[string]string {
+	tags := map[string]string{}
+
+	if sport != fit.SportInvalid {
+		tags["sport"] = SnakeCase(sport.String())
+	}
+
+	if fileId.Manufacturer != fit.ManufacturerInvalid {
+		device := SnakeCase(fileId.Manufacturer.String())
+		info, _ := FieldInfo("FileId", "Product")
+		if !info.IsInvalid(reflect.ValueOf(fileId.Product)) {
+			// Products the fit package doesn't know print as
+			// "GarminProduct(1234)", so use the number for those
+			product := fmt.Sprint(fileId.GetProduct())
+			if strings.Contains(product, "(") {
+				product = strconv.Itoa(int(fileId.Product))
+			}
+			device += "_" + SnakeCase(product)
+		}
+		tags["device"] = device
+	}
+
+	return tags
+}
+
+// InfluxWriter writes records as InfluxDB line protocol, one point per
+// record, so that the records don't all need to be in memory at once.
+type InfluxWriter struct {
+	w    *bufio.Writer
+	tags string
+	sb   strings.Builder
+}
+
+// NewInfluxWriter returns an InfluxWriter which writes points for the
+// "record" measurement, with the given tags.
+func NewInfluxWriter(w io.Writer, tags map[string]string) *InfluxWriter {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	// Influx prefers the tags in key order
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&sb, ",%s=%s", influxTagEscaper.Replace(k), influxTagEscaper.Replace(tags[k]))
+	}
+
+	return &InfluxWriter{
+		w:    bufio.NewWriter(w),
+		tags: sb.String(),
+	}
+}
+
+// influxValue returns the line protocol form of a field value, or false if
+// it's invalid or doesn't have one.
+func influxValue(v reflect.Value, info Info) (string, bool) {
+	if v.Kind() == reflect.Slice || info.IsInvalid(v) {
+		return "", false
+	}
+
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return "", false
+	case fit.Latitude:
+		return strconv.FormatFloat(x.Degrees(), 'f', positionDigits, 64), true
+	case fit.Longitude:
+		return strconv.FormatFloat(x.Degrees(), 'f', positionDigits, 64), true
+	case fmt.Stringer:
+		str := x.String()
+		if invalidEnum(v) {
+			return "", false
+		}
+		return `"` + influxStringEscaper.Replace(str) + `"`, true
+	}
+
+	f, ok := info.Value(v)
+	if !ok {
+		return "", false
+	}
+	return strconv.FormatFloat(f, 'f', info.digits(), 64), true
+}
+
+// Write writes one record as a point. The fields are the record's valid
+// fields, in snake_case with their scale applied and positions in degrees.
+// They're always in the profile's units, such as m/s and degrees Celsius,
+// as the field keys can't say otherwise: Options.SpeedUnit and
+// Options.Units don't apply.
+// Records without a timestamp or any valid fields are skipped, as they
+// can't be written as a point.
+func (iw *InfluxWriter) Write(r *fit.RecordMsg) error {
+	if r.Timestamp.IsZero() || fit.IsBaseTime(r.Timestamp) {
+		return nil
+	}
+
+	iw.sb.Reset()
+	v := reflect.ValueOf(r).Elem()
+	for i := 0; i < recordType.NumField(); i++ {
+		name := recordType.Field(i).Name
+		if !exported(name) {
+			continue
+		}
+		info, _ := FieldInfo("Record", name)
+		value, ok := influxValue(v.Field(i), info)
+		if !ok {
+			continue
+		}
+		if iw.sb.Len() > 0 {
+			iw.sb.WriteByte(',')
+		}
+		iw.sb.WriteString(SnakeCase(name))
+		iw.sb.WriteByte('=')
+		iw.sb.WriteString(value)
+	}
+
+	if iw.sb.Len() == 0 {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(iw.w, "record%s %s %d\n", iw.tags, iw.sb.String(), r.Timestamp.UnixNano())
+	return err
+}
+
+// Flush writes out any buffered points
+func (iw *InfluxWriter) Flush() error {
+	return iw.w.Flush()
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// InvalidPolicy selects what's printed for fields holding their base type's
+// invalid value, which is how FIT marks a field as having no value
+type InvalidPolicy int
+
+const (
+	// InvalidHide leaves the fields out
+	InvalidHide InvalidPolicy = iota
+	// InvalidShow prints the raw invalid value, such as 255
+	InvalidShow
+	// InvalidMark prints InvalidMarker in the dumps, and NA in tabular
+	// output, so the field is visible without a misleading number
+	InvalidMark
+)
+
+// fitPkgPath is the import path of the fit package, whose enum types have
+// their invalid values in enumInvalids
+var fitPkgPath = reflect.TypeOf(fit.Bool(0)).PkgPath()
+
+// invalidEnum reports whether v holds the invalid value of its type. That's
+// the XxxInvalid constant for the fit package's enums, while positions say
+// for themselves. Other types are never invalid here.
+func invalidEnum(v reflect.Value) bool {
+	if p, ok := v.Interface().(interface{ Invalid() bool }); ok {
+		return p.Invalid()
+	}
+
+	t := v.Type()
+	if t.PkgPath() != fitPkgPath {
+		return false
+	}
+	invalid, ok := enumInvalids[t.Name()]
+	if !ok {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == invalid
+	}
+	return false
+}
+
+// IsInvalidEnum reports whether v, one of the fit package's enums or another
+// of its Stringers such as fit.Latitude, holds its invalid value. Only the
+// XxxInvalid constant counts: other names ending in Invalid, such as
+// AttitudeValidity's MagInvalid, are real values.
+func IsInvalidEnum(v fmt.Stringer) bool {
+	return invalidEnum(reflect.ValueOf(v))
+}
+
+// InvalidMarker is printed for invalid fields with InvalidMark
+const InvalidMarker = "<invalid>"
+
+// InvalidCell is the cell for invalid fields in tabular output with
+// InvalidMark, distinct from the empty cell of InvalidHide
+const InvalidCell = "NA"
+
+// ParseInvalidPolicy parses "hide", "show" or "mark". An empty string is
+// InvalidHide.
+func ParseInvalidPolicy(s string) (InvalidPolicy, error) {
+	switch s {
+	case "", "hide":
+		return InvalidHide, nil
+	case "show":
+		return InvalidShow, nil
+	case "mark":
+		return InvalidMark, nil
+	}
+
+	return InvalidHide, fmt.Errorf("unknown invalid value policy '%s', expected hide, show or mark", s)
+}
+
+// invalidText returns what to print for an invalid field whose raw form is
+// raw, or false if it should be left out
+func (p InvalidPolicy) invalidText(raw string) (string, bool) {
+	switch p {
+	case InvalidShow:
+		return raw, true
+	case InvalidMark:
+		return InvalidMarker, true
+	}
+	return "", false
+}
+
+// rawText returns the raw form of v, without any scaling, so that invalid
+// values show as their sentinel. Positions are in semicircles, and enums
+// are numbers rather than names.
+func rawText(v reflect.Value) string {
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return x.UTC().Format(time.RFC3339)
+	case fit.Latitude:
+		return fmt.Sprint(x.Semicircles())
+	case fit.Longitude:
+		return fmt.Sprint(x.Semicircles())
+	}
+
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprint(v.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(v.Uint())
+	}
+	return fmt.Sprintf("%+v", v)
+}
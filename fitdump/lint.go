@@ -0,0 +1,313 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// LintFinding is a suspicious value found by a LintRule
+type LintFinding struct {
+	Rule string
+	// Path locates the value, in the form Records[8812].Speed
+	Path  string
+	Value string
+	// Problem says what's wrong with the value
+	Problem string
+}
+
+func (f LintFinding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Rule, f.Path, f.Value, f.Problem)
+}
+
+// LintRule checks a decoded file for data which is out of spec, or just
+// unlikely, such as a heart rate of 300. Check is passed the value being
+// dumped: a fit.File or a file body such as fit.ActivityFile.
+type LintRule struct {
+	Name  string
+	Check func(val reflect.Value, now time.Time) []LintFinding
+}
+
+// LintRules are the rules run by Lint, in order
+var LintRules = []LintRule{
+	{"time-range", lintTimeRange},
+	{"speed", lintSpeed},
+	{"heart-rate", lintHeartRate},
+	{"negative-elapsed", lintNegativeElapsed},
+	{"record-order", lintRecordOrder},
+	{"distance-order", lintDistanceOrder},
+	{"lap-totals", lintLapTotals},
+	{"null-island", lintNullIsland},
+}
+
+// Lint runs all of the LintRules against val, which is a fit.File or a file
+// body. Times are checked against now, to catch ones in the future.
+func Lint(val reflect.Value, now time.Time) []LintFinding {
+	var findings []LintFinding
+	for _, rule := range LintRules {
+		for _, f := range rule.Check(val, now) {
+			f.Rule = rule.Name
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// walkMessages calls fn with each message in val, whether held directly, by
+// pointer or in a slice, along with its path
+func walkMessages(val reflect.Value, fn func(path string, msg reflect.Value)) {
+	isMsg := func(t reflect.Type) bool {
+		return t.Kind() == reflect.Struct && strings.HasSuffix(t.Name(), "Msg")
+	}
+
+	for i := 0; i < val.NumField(); i++ {
+		name := val.Type().Field(i).Name
+		if !exported(name) {
+			continue
+		}
+
+		field := val.Field(i)
+		switch {
+		case isMsg(field.Type()):
+			fn(name, field)
+		case field.Kind() == reflect.Ptr && isMsg(field.Type().Elem()):
+			if !field.IsNil() {
+				fn(name, field.Elem())
+			}
+		case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Ptr && isMsg(field.Type().Elem().Elem()):
+			for j := 0; j < field.Len(); j++ {
+				if !field.Index(j).IsNil() {
+					fn(fmt.Sprintf("%s[%d]", name, j), field.Index(j).Elem())
+				}
+			}
+		}
+	}
+}
+
+// walkFields calls fn with each valid field of each message in val whose
+// name matches, with its profile information. Fields missing from the
+// profile are skipped.
+func walkFields(val reflect.Value, match func(name string) bool, fn func(path string, field reflect.Value, info Info)) {
+	walkMessages(val, func(path string, msg reflect.Value) {
+		for i := 0; i < msg.NumField(); i++ {
+			name := msg.Type().Field(i).Name
+			if !exported(name) || !match(name) {
+				continue
+			}
+
+			info, ok := FieldInfo(msg.Type().Name(), name)
+			if !ok || info.IsInvalid(msg.Field(i)) {
+				continue
+			}
+			fn(path+"."+name, msg.Field(i), info)
+		}
+	})
+}
+
+// activityBody returns val as an activity file body, if it is one
+func activityBody(val reflect.Value) (fit.ActivityFile, bool) {
+	if !val.CanInterface() {
+		return fit.ActivityFile{}, false
+	}
+	activity, ok := val.Interface().(fit.ActivityFile)
+	return activity, ok
+}
+
+// lintEpoch is well before any device recording FIT files with a real clock
+var lintEpoch = time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func lintTimeRange(val reflect.Value, now time.Time) []LintFinding {
+	var findings []LintFinding
+	walkFields(val, func(string) bool { return true }, func(path string, field reflect.Value, info Info) {
+		t, ok := field.Interface().(time.Time)
+		if !ok {
+			return
+		}
+		if t.Before(lintEpoch) {
+			findings = append(findings, LintFinding{Path: path, Value: t.String(), Problem: "before 2010"})
+		} else if t.After(now) {
+			findings = append(findings, LintFinding{Path: path, Value: t.String(), Problem: "in the future"})
+		}
+	})
+	return findings
+}
+
+// lintMaxSpeed is 200 km/h, in m/s
+const lintMaxSpeed = 200 / 3.6
+
+func lintSpeed(val reflect.Value, now time.Time) []LintFinding {
+	var findings []LintFinding
+	match := func(name string) bool {
+		return strings.HasSuffix(name, "Speed") && !strings.HasSuffix(name, "VerticalSpeed") && name != "BallSpeed"
+	}
+	walkFields(val, match, func(path string, field reflect.Value, info Info) {
+		if info.Unit != "m/s" {
+			return
+		}
+		if v, ok := info.Value(field); ok && v > lintMaxSpeed {
+			findings = append(findings, LintFinding{Path: path, Value: fmt.Sprintf("%.1f km/h", v*3.6), Problem: "over 200 km/h"})
+		}
+	})
+	return findings
+}
+
+func lintHeartRate(val reflect.Value, now time.Time) []LintFinding {
+	var findings []LintFinding
+	match := func(name string) bool {
+		return strings.HasSuffix(name, "HeartRate")
+	}
+	walkFields(val, match, func(path string, field reflect.Value, info Info) {
+		if v, ok := info.Value(field); ok && v > 250 {
+			findings = append(findings, LintFinding{Path: path, Value: fmt.Sprintf("%.0f bpm", v), Problem: "over 250 bpm"})
+		}
+	})
+	return findings
+}
+
+// lintNegativeElapsed finds laps and sessions which end before they start
+func lintNegativeElapsed(val reflect.Value, now time.Time) []LintFinding {
+	var findings []LintFinding
+	walkMessages(val, func(path string, msg reflect.Value) {
+		start := msg.FieldByName("StartTime")
+		end := msg.FieldByName("Timestamp")
+		if !start.IsValid() || !end.IsValid() {
+			return
+		}
+		st, ok1 := start.Interface().(time.Time)
+		et, ok2 := end.Interface().(time.Time)
+		if !ok1 || !ok2 || fit.IsBaseTime(st) || fit.IsBaseTime(et) {
+			return
+		}
+		if et.Before(st) {
+			findings = append(findings, LintFinding{
+				Path:    path + ".Timestamp",
+				Value:   et.String(),
+				Problem: fmt.Sprintf("elapsed time %v, before StartTime", et.Sub(st)),
+			})
+		}
+	})
+	return findings
+}
+
+func lintRecordOrder(val reflect.Value, now time.Time) []LintFinding {
+	activity, ok := activityBody(val)
+	if !ok {
+		return nil
+	}
+
+	var findings []LintFinding
+	var last time.Time
+	for i, r := range activity.Records {
+		if fit.IsBaseTime(r.Timestamp) {
+			continue
+		}
+		if r.Timestamp.Before(last) {
+			findings = append(findings, LintFinding{
+				Path:    fmt.Sprintf("Records[%d].Timestamp", i),
+				Value:   r.Timestamp.String(),
+				Problem: fmt.Sprintf("%v before the record before it", last.Sub(r.Timestamp)),
+			})
+		}
+		last = r.Timestamp
+	}
+	return findings
+}
+
+// lintDistanceOrder finds records whose distance is less than the one
+// before, which breaks anything expecting it to only go up
+func lintDistanceOrder(val reflect.Value, now time.Time) []LintFinding {
+	activity, ok := activityBody(val)
+	if !ok {
+		return nil
+	}
+
+	var findings []LintFinding
+	last := -1.0
+	for i, r := range activity.Records {
+		dist := r.GetDistanceScaled()
+		if math.IsNaN(dist) {
+			continue
+		}
+		if dist < last {
+			findings = append(findings, LintFinding{
+				Path:    fmt.Sprintf("Records[%d].Distance", i),
+				Value:   fmt.Sprintf("%.2f m", dist),
+				Problem: fmt.Sprintf("%.2f m less than the record before it", last-dist),
+			})
+		}
+		last = dist
+	}
+	return findings
+}
+
+// lapSession returns the session which the lap started in, or the first if
+// it didn't start in any
+func lapSession(lap *fit.LapMsg, sessions []*fit.SessionMsg) *fit.SessionMsg {
+	for _, s := range sessions {
+		if !lap.StartTime.Before(s.StartTime) && !lap.StartTime.After(s.Timestamp) {
+			return s
+		}
+	}
+	return sessions[0]
+}
+
+func lintLapTotals(val reflect.Value, now time.Time) []LintFinding {
+	activity, ok := activityBody(val)
+	if !ok || len(activity.Sessions) == 0 {
+		return nil
+	}
+
+	var findings []LintFinding
+	for i, lap := range activity.Laps {
+		s := lapSession(lap, activity.Sessions)
+		if FieldValid(lap, "TotalElapsedTime") && FieldValid(s, "TotalElapsedTime") && lap.TotalElapsedTime > s.TotalElapsedTime {
+			findings = append(findings, LintFinding{
+				Path:    fmt.Sprintf("Laps[%d].TotalElapsedTime", i),
+				Value:   fmt.Sprintf("%.3f s", lap.GetTotalElapsedTimeScaled()),
+				Problem: fmt.Sprintf("longer than its session's %.3f s", s.GetTotalElapsedTimeScaled()),
+			})
+		}
+		if FieldValid(lap, "TotalDistance") && FieldValid(s, "TotalDistance") && lap.TotalDistance > s.TotalDistance {
+			findings = append(findings, LintFinding{
+				Path:    fmt.Sprintf("Laps[%d].TotalDistance", i),
+				Value:   fmt.Sprintf("%.2f m", lap.GetTotalDistanceScaled()),
+				Problem: fmt.Sprintf("further than its session's %.2f m", s.GetTotalDistanceScaled()),
+			})
+		}
+	}
+	return findings
+}
+
+// lintNullIsland finds positions at exactly 0,0, which devices sometimes
+// write before they have a GPS fix
+func lintNullIsland(val reflect.Value, now time.Time) []LintFinding {
+	var findings []LintFinding
+	match := func(name string) bool {
+		return strings.HasSuffix(name, "PositionLat")
+	}
+	walkMessages(val, func(path string, msg reflect.Value) {
+		for i := 0; i < msg.NumField(); i++ {
+			name := msg.Type().Field(i).Name
+			if !match(name) {
+				continue
+			}
+			lng := msg.FieldByName(strings.TrimSuffix(name, "Lat") + "Long")
+			if !lng.IsValid() {
+				continue
+			}
+			lat, ok1 := msg.Field(i).Interface().(fit.Latitude)
+			long, ok2 := lng.Interface().(fit.Longitude)
+			if ok1 && ok2 && !lat.Invalid() && !long.Invalid() && lat.Semicircles() == 0 && long.Semicircles() == 0 {
+				findings = append(findings, LintFinding{Path: path + "." + name, Value: "0, 0", Problem: "position at exactly 0,0"})
+			}
+		}
+	})
+	return findings
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SnakeCase converts a Go field or message name from the fit package into
+// the snake_case form used by the FIT profile, e.g. "HeartRate" to
+// "heart_rate". Names in the profile are looked up, as where digits start a
+// word depends on the name ("Cadence256" is "cadence256" but
+// "LeftRightBalance100" is "left_right_balance_100"). Others, such as those
+// newer than the profile the table was generated from, are guessed: digits
+// stay attached to the preceding word and runs of capitals are treated as a
+// single word ("CRC" becomes "crc").
+func SnakeCase(name string) string {
+	if snake, ok := profileNames[name]; ok {
+		return snake
+	}
+
+	runes := []rune(name)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if !unicode.IsUpper(prev) || nextLower {
+					sb.WriteByte('_')
+				}
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
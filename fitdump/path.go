@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// PathElem is one step of a Path: either a field, by name, or an element of
+// a slice, by index
+type PathElem struct {
+	// Name is the field name, or "" for a slice index
+	Name  string
+	Index int
+}
+
+// Path names a value in a decoded file, from the file or its body, such as
+// Records[8812].HeartRate. Fields are separated by dots and can be given in
+// the Go form (HeartRate) or the profile's (heart_rate), and slice elements
+// are indexed from 0, as in the dump.
+//
+// This is the one grammar for naming values: the dump prints it with
+// Options.Paths, and the tools which take values on the command line parse
+// it with ParsePath.
+type Path []PathElem
+
+// ParsePath parses a path such as "Records[8812].HeartRate" or
+// "records[8812].heart_rate"
+func ParsePath(s string) (Path, error) {
+	var p Path
+	rest := s
+	for {
+		end := strings.IndexAny(rest, ".[")
+		if end < 0 {
+			end = len(rest)
+		}
+		name := rest[:end]
+		if !isIdent(name) {
+			return nil, fmt.Errorf("path '%s' should be field names separated by dots, each optionally followed by [N], e.g. Records[5].HeartRate", s)
+		}
+		p = append(p, PathElem{Name: name})
+		rest = rest[end:]
+
+		for strings.HasPrefix(rest, "[") {
+			close := strings.IndexByte(rest, ']')
+			if close < 0 {
+				return nil, fmt.Errorf("path '%s' has an unclosed [", s)
+			}
+			index, err := strconv.Atoi(rest[1:close])
+			if err != nil || index < 0 || rest[1] == '+' {
+				return nil, fmt.Errorf("path '%s' doesn't have a valid index '%s'", s, rest[:close+1])
+			}
+			p = append(p, PathElem{Index: index})
+			rest = rest[close+1:]
+		}
+
+		if rest == "" {
+			return p, nil
+		}
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("path '%s' has '%s' after an index, expected . or [", s, rest)
+		}
+		rest = rest[1:]
+	}
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Path) String() string {
+	var sb strings.Builder
+	for i, e := range p {
+		if e.Name == "" {
+			fmt.Fprintf(&sb, "[%d]", e.Index)
+			continue
+		}
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(e.Name)
+	}
+	return sb.String()
+}
+
+// field returns p with the field name appended. It always copies, so that
+// paths handed out can't be changed by appending to p.
+func (p Path) field(name string) Path {
+	return append(p[:len(p):len(p)], PathElem{Name: name})
+}
+
+func (p Path) index(i int) Path {
+	return append(p[:len(p):len(p)], PathElem{Index: i})
+}
+
+// Lookup returns the value at p in val, which is typically a fit.File or
+// one of the fit.XXXFile types. Pointers are followed, and names match in
+// either form.
+func (p Path) Lookup(val reflect.Value) (reflect.Value, error) {
+	for i, e := range p {
+		for val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return reflect.Value{}, fmt.Errorf("%v is nil", p[:i])
+			}
+			val = val.Elem()
+		}
+
+		if e.Name == "" {
+			if val.Kind() != reflect.Slice {
+				return reflect.Value{}, fmt.Errorf("%v isn't a slice", p[:i])
+			}
+			if e.Index >= val.Len() {
+				return reflect.Value{}, fmt.Errorf("%v is out of range, %v has %d elements", p[:i+1], p[:i], val.Len())
+			}
+			val = val.Index(e.Index)
+			continue
+		}
+
+		var field reflect.Value
+		ok := false
+		if val.Kind() == reflect.Struct {
+			field, ok = fieldBySnakeName(val, SnakeCase(e.Name))
+		}
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%v: %s has no field %s", p[:i+1], val.Type(), e.Name)
+		}
+		val = field
+	}
+
+	return val, nil
+}
+
+func fieldBySnakeName(val reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i).Name
+		if exported(field) && SnakeCase(field) == name {
+			return val.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// Respiration rate and pulse oximetry are newer than the profile the fit
+// package was generated from, so they're read from the raw messages.
+const (
+	recordRespirationRate         = 99  // uint8, breaths/min
+	recordEnhancedRespirationRate = 108 // uint16, 1/100 breaths/min
+
+	mesgNumSpo2Data fit.MesgNum = 269
+	spo2ReadingSpo2             = 0 // uint8, percent
+)
+
+// Physio holds the respiration rate and SpO2 samples of a file, keyed by
+// Unix time. Samples at the invalid value are left out.
+type Physio struct {
+	// Respiration rate in breaths per minute, from the records
+	Respiration map[int64]float64
+	// SpO2 in percent, from the spo2_data messages
+	Spo2 map[int64]float64
+}
+
+func newPhysio() *Physio {
+	return &Physio{
+		Respiration: make(map[int64]float64),
+		Spo2:        make(map[int64]float64),
+	}
+}
+
+// add collects the samples of raw
+func (p *Physio) add(raw *fitstream.RawMessage) {
+	if raw.Timestamp.IsZero() {
+		return
+	}
+	t := raw.Timestamp.Unix()
+
+	switch raw.Num {
+	case fit.MesgNumRecord:
+		if v, ok := raw.Uint(recordEnhancedRespirationRate); ok && v != 0xffff {
+			p.Respiration[t] = float64(v) / 100
+		} else if v, ok := raw.Uint(recordRespirationRate); ok && v != 0xff {
+			p.Respiration[t] = float64(v)
+		}
+	case mesgNumSpo2Data:
+		if v, ok := raw.Uint(spo2ReadingSpo2); ok && v != 0xff {
+			p.Spo2[t] = float64(v)
+		}
+	}
+}
+
+// ReadPhysio reads the respiration rate and SpO2 samples from the FIT file
+// in r. Use ReadExtras or Extras to read them along with the rest.
+func ReadPhysio(r io.Reader) (*Physio, error) {
+	x, err := ReadExtras(r)
+	if err != nil {
+		return nil, err
+	}
+	return x.Physio, nil
+}
+
+func sampleColumn(name string, samples map[int64]float64) ExtraColumn {
+	return ExtraColumn{
+		Name: name,
+		Value: func(r *fit.RecordMsg) string {
+			v, ok := samples[r.Timestamp.Unix()]
+			if !ok {
+				return ""
+			}
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		},
+	}
+}
+
+// Columns returns the record columns for the samples, matched to records by
+// their timestamp. Only the ones with any samples are included.
+func (p *Physio) Columns() []ExtraColumn {
+	var cols []ExtraColumn
+	if len(p.Respiration) > 0 {
+		cols = append(cols, sampleColumn("EnhancedRespirationRate", p.Respiration))
+	}
+	if len(p.Spo2) > 0 {
+		cols = append(cols, sampleColumn("Spo2", p.Spo2))
+	}
+	return cols
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tormoder/fit"
+)
+
+// Metres per degree of latitude, near enough for sizing a grid
+const metresPerDegree = 111320
+
+// DistanceBetween returns the great-circle distance in metres between two
+// positions given in degrees, using the haversine formula.
+func DistanceBetween(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadius = 6371000
+
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLng := (lng2 - lng1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// PrivacyZone is a circle, such as around home, which positions are removed
+// from
+type PrivacyZone struct {
+	Lat, Lng float64
+	// Radius in metres
+	Radius float64
+}
+
+// ParsePrivacyZone parses "lat,lng,radius", with the position in degrees and
+// the radius in metres. The radius can have an "m" or "km" suffix.
+func ParsePrivacyZone(s string) (PrivacyZone, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 3 {
+		return PrivacyZone{}, fmt.Errorf("expected lat,lng,radius, got '%s'", s)
+	}
+
+	var z PrivacyZone
+	var err error
+	if z.Lat, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64); err != nil || math.Abs(z.Lat) > 90 {
+		return PrivacyZone{}, fmt.Errorf("invalid latitude '%s'", parts[0])
+	}
+	if z.Lng, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err != nil || math.Abs(z.Lng) > 180 {
+		return PrivacyZone{}, fmt.Errorf("invalid longitude '%s'", parts[1])
+	}
+
+	radius, scale := strings.TrimSpace(parts[2]), 1.0
+	if strings.HasSuffix(radius, "km") {
+		radius, scale = strings.TrimSuffix(radius, "km"), 1000
+	} else {
+		radius = strings.TrimSuffix(radius, "m")
+	}
+	if z.Radius, err = strconv.ParseFloat(radius, 64); err != nil || z.Radius <= 0 {
+		return PrivacyZone{}, fmt.Errorf("invalid radius '%s'", parts[2])
+	}
+	z.Radius *= scale
+
+	return z, nil
+}
+
+// Contains reports whether the position, in degrees, is inside the zone
+func (z PrivacyZone) Contains(lat, lng float64) bool {
+	return DistanceBetween(z.Lat, z.Lng, lat, lng) <= z.Radius
+}
+
+// Privacy describes how to hide where an activity happened, for sharing it.
+// The zero value changes nothing.
+type Privacy struct {
+	// FuzzPosition, if non-zero, snaps positions to a grid this many
+	// metres across
+	FuzzPosition float64
+	// Zones have their records dropped, and any other positions in them
+	// removed
+	Zones []PrivacyZone
+}
+
+func (p Privacy) inZone(lat, lng float64) bool {
+	for _, z := range p.Zones {
+		if z.Contains(lat, lng) {
+			return true
+		}
+	}
+	return false
+}
+
+// DropZones returns the records which aren't in any of the zones. Records
+// without a position are kept.
+func (p Privacy) DropZones(records []*fit.RecordMsg) []*fit.RecordMsg {
+	if len(p.Zones) == 0 {
+		return records
+	}
+
+	var kept []*fit.RecordMsg
+	for _, r := range records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() &&
+			p.inZone(r.PositionLat.Degrees(), r.PositionLong.Degrees()) {
+			continue
+		}
+		kept = append(kept, r)
+	}
+	return kept
+}
+
+// snap moves a position to the nearest point of the grid. Longitude steps
+// are sized for the snapped latitude, so the grid is the same for every
+// file.
+func (p Privacy) snap(lat, lng float64) (float64, float64) {
+	latStep := p.FuzzPosition / metresPerDegree
+	lat = math.Round(lat/latStep) * latStep
+
+	lngStep := p.FuzzPosition / (metresPerDegree * math.Max(math.Cos(lat*math.Pi/180), 0.01))
+	lng = math.Round(lng/lngStep) * lngStep
+
+	return lat, lng
+}
+
+var (
+	latitudeType  = reflect.TypeOf(fit.Latitude{})
+	longitudeType = reflect.TypeOf(fit.Longitude{})
+)
+
+// Positions walks val, like Redact, and applies the privacy settings to
+// every pair of XxxLat and XxxLong fields: positions in a zone are set to
+// invalid, and the rest are snapped to the grid. It returns the number of
+// positions changed.
+func (p Privacy) Positions(val reflect.Value) int {
+	if p.FuzzPosition <= 0 && len(p.Zones) == 0 {
+		return 0
+	}
+
+	n := 0
+	switch val.Kind() {
+	case reflect.Ptr:
+		if !val.IsNil() {
+			n += p.Positions(val.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			n += p.Positions(val.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Field(i)
+			name := val.Type().Field(i).Name
+			if field.Type() != latitudeType || !strings.HasSuffix(name, "Lat") || !field.CanSet() {
+				n += p.Positions(field)
+				continue
+			}
+
+			lngField := val.FieldByName(strings.TrimSuffix(name, "Lat") + "Long")
+			if !lngField.IsValid() || lngField.Type() != longitudeType {
+				continue
+			}
+			lat := field.Addr().Interface().(*fit.Latitude)
+			lng := lngField.Addr().Interface().(*fit.Longitude)
+			if lat.Invalid() || lng.Invalid() {
+				continue
+			}
+
+			if p.inZone(lat.Degrees(), lng.Degrees()) {
+				*lat, *lng = fit.NewLatitudeInvalid(), fit.NewLongitudeInvalid()
+				n++
+			} else if p.FuzzPosition > 0 {
+				la, lo := p.snap(lat.Degrees(), lng.Degrees())
+				*lat, *lng = fit.NewLatitudeDegrees(la), fit.NewLongitudeDegrees(lo)
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
+// RecomputeDistance sets the Distance of the records to the distance
+// travelled between their positions, so that it matches positions which
+// have been moved. Records without a position carry the last distance.
+// If none of the records has a position, such as from an indoor trainer or
+// a wheel sensor, their Distance is left as it is.
+func RecomputeDistance(records []*fit.RecordMsg) {
+	if !hasPosition(records) {
+		return
+	}
+
+	dist := 0.0
+	var last *fit.RecordMsg
+	for _, r := range records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			if last != nil {
+				dist += DistanceBetween(last.PositionLat.Degrees(), last.PositionLong.Degrees(),
+					r.PositionLat.Degrees(), r.PositionLong.Degrees())
+			}
+			last = r
+		}
+		r.Distance = uint32(math.Round(dist * 100))
+	}
+}
+
+// hasPosition reports whether any of the records has a position
+func hasPosition(records []*fit.RecordMsg) bool {
+	for _, r := range records {
+		if !r.PositionLat.Invalid() && !r.PositionLong.Invalid() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+var recordType = reflect.TypeOf(fit.RecordMsg{})
+
+// RecordColumns collects which RecordMsg fields are valid in at least one
+// record, to decide the columns of a RecordWriter. Slice fields are never
+// included, as they don't fit in a single cell.
+type RecordColumns struct {
+	valid []bool
+	extra []ExtraColumn
+}
+
+// ExtraColumn is a column whose value doesn't come from a RecordMsg field,
+// such as one read from the raw messages because the fit package doesn't
+// know it.
+type ExtraColumn struct {
+	// Name is the column name, in the same form as the Go field names
+	Name string
+	// Value returns the cell for a record, or "" if there's no value
+	Value func(r *fit.RecordMsg) string
+}
+
+// AddExtra adds a column after the RecordMsg fields
+func (c *RecordColumns) AddExtra(col ExtraColumn) {
+	c.extra = append(c.extra, col)
+}
+
+// Add marks the fields which are valid in r
+func (c *RecordColumns) Add(r *fit.RecordMsg) {
+	if c.valid == nil {
+		c.valid = make([]bool, recordType.NumField())
+	}
+
+	v := reflect.ValueOf(r).Elem()
+	for i := range c.valid {
+		f := recordType.Field(i)
+		if c.valid[i] || !exported(f.Name) || f.Type.Kind() == reflect.Slice {
+			continue
+		}
+
+		info, _ := FieldInfo("Record", f.Name)
+		if !info.IsInvalid(v.Field(i)) {
+			c.valid[i] = true
+		}
+	}
+}
+
+// indices returns the field indices of the columns, in struct order
+func (c *RecordColumns) indices() []int {
+	var columns []int
+	for i, valid := range c.valid {
+		if valid {
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
+
+// One semicircle is about 8e-8 degrees, so 7 decimal places is as precise
+// as a position can be, give or take rounding.
+const positionDigits = 7
+
+// digits returns the number of decimal places needed to show the resolution
+// of a scaled field, so that the float arithmetic doesn't add noise.
+func (i Info) digits() int {
+	if i.Scale <= 1 {
+		return -1
+	}
+	return int(math.Ceil(math.Log10(i.Scale)))
+}
+
+// FormatCell returns the text for a single field value in tabular output, or
+// "" if it's invalid. Positions are in degrees, times are RFC 3339 and scaled
+// fields have their scale and offset applied. Speeds are in opts.SpeedUnit,
+// and temperatures in opts.Units, without the unit, which is
+// opts.ConvertedUnit, so that the cells are numbers. Left/right balances are the percent from
+// one leg, e.g. "R 52" for the right.
+func FormatCell(v reflect.Value, info Info, opts Options) string {
+	if info.IsInvalid(v) {
+		return ""
+	}
+
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return x.UTC().Format(time.RFC3339)
+	case fit.Latitude:
+		return strconv.FormatFloat(x.Degrees(), 'f', positionDigits, 64)
+	case fit.Longitude:
+		return strconv.FormatFloat(x.Degrees(), 'f', positionDigits, 64)
+	case fit.LeftRightBalance:
+		return formatBalance(float64(x&fit.LeftRightBalanceMask), 0, x&fit.LeftRightBalanceRight != 0)
+	case fit.LeftRightBalance100:
+		return formatBalance(float64(x&fit.LeftRightBalance100Mask)/100, 2, x&fit.LeftRightBalance100Right != 0)
+	case fmt.Stringer:
+		return x.String()
+	}
+
+	f, ok := info.Value(v)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	if text, ok := opts.convert(f, info, false); ok {
+		return text
+	}
+
+	return strconv.FormatFloat(f, 'f', info.digits(), 64)
+}
+
+// formatBalance formats a left/right balance as the percent of the power
+// from one leg. That's the right leg, prefixed "R", if right is set,
+// otherwise the device didn't say which.
+func formatBalance(percent float64, digits int, right bool) string {
+	text := strconv.FormatFloat(percent, 'f', digits, 64)
+	if right {
+		return "R " + text
+	}
+	return text
+}
+
+// RecordWriter writes records as delimited text, one row at a time, so that
+// the records don't all need to be in memory at once.
+type RecordWriter struct {
+	cw      *csv.Writer
+	columns []int
+	extra   []ExtraColumn
+	infos   []Info
+	opts    Options
+	row     []string
+}
+
+// NewRecordWriter returns a RecordWriter which writes the fields in columns,
+// using comma as the delimiter. It writes the header row straight away.
+// Invalid values are left blank, or with opts.Invalid, printed raw or as
+// InvalidCell. opts.SnakeCase, opts.SpeedUnit, opts.Units, opts.Missing and
+// opts.Invalid apply; the other options are for the tree dump. The columns
+// converted by opts.SpeedUnit or opts.Units have the unit in their header,
+// such as "Temperature (F)".
+func NewRecordWriter(w io.Writer, comma rune, columns *RecordColumns, opts Options) *RecordWriter {
+	rw := &RecordWriter{
+		cw:      csv.NewWriter(w),
+		columns: columns.indices(),
+		extra:   columns.extra,
+		opts:    opts,
+	}
+	rw.cw.Comma = comma
+
+	rw.infos = make([]Info, len(rw.columns))
+	rw.row = make([]string, len(rw.columns)+len(rw.extra))
+	for i, c := range rw.columns {
+		name := recordType.Field(c).Name
+		rw.infos[i], _ = FieldInfo("Record", name)
+		rw.row[i] = name
+	}
+	for i, col := range rw.extra {
+		rw.row[len(rw.columns)+i] = col.Name
+	}
+	if opts.SnakeCase {
+		for i := range rw.row {
+			rw.row[i] = SnakeCase(rw.row[i])
+		}
+	}
+	for i, info := range rw.infos {
+		if unit := opts.ConvertedUnit(info); unit != "" {
+			rw.row[i] += " (" + unit + ")"
+		}
+	}
+	rw.cw.Write(rw.row)
+
+	return rw
+}
+
+// Write writes one record as a row
+func (rw *RecordWriter) Write(r *fit.RecordMsg) error {
+	v := reflect.ValueOf(r).Elem()
+	for i, c := range rw.columns {
+		if field := v.Field(c); rw.infos[i].IsInvalid(field) {
+			rw.row[i] = rw.invalidCell(rawText(field))
+		} else {
+			rw.row[i] = FormatCell(field, rw.infos[i], rw.opts)
+		}
+	}
+	for i, col := range rw.extra {
+		cell := col.Value(r)
+		if cell == "" {
+			cell = rw.invalidCell("")
+		}
+		rw.row[len(rw.columns)+i] = cell
+	}
+	return rw.cw.Write(rw.row)
+}
+
+// invalidCell returns the cell for a value which is invalid, or missing if
+// raw is ""
+func (rw *RecordWriter) invalidCell(raw string) string {
+	switch rw.opts.Invalid {
+	case InvalidShow:
+		return raw
+	case InvalidMark:
+		return InvalidCell
+	}
+	return ""
+}
+
+// Flush writes out any buffered rows
+func (rw *RecordWriter) Flush() error {
+	rw.cw.Flush()
+	return rw.cw.Error()
+}
+
+// WriteRecords writes records as delimited text with a header row, using
+// comma as the delimiter. Only columns with at least one valid value are
+// included, followed by any extra columns. See NewRecordWriter for the
+// formatting.
+func WriteRecords(w io.Writer, records []*fit.RecordMsg, comma rune, opts Options, extra ...ExtraColumn) error {
+	var columns RecordColumns
+	for _, r := range records {
+		columns.Add(r)
+	}
+	for _, col := range extra {
+		columns.AddExtra(col)
+	}
+
+	rw := NewRecordWriter(w, comma, &columns, opts)
+	for _, r := range records {
+		if err := rw.Write(r); err != nil {
+			return err
+		}
+	}
+
+	return rw.Flush()
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Fields which identify a particular device, and are removed by Redact
+var identifyingFields = map[string]bool{
+	"SerialNumber":    true,
+	"Product":         true,
+	"AntDeviceNumber": true,
+}
+
+// identifying reports whether a field identifies a device. As well as the
+// fields above, the sensor profiles in settings files hold ANT device
+// numbers in fields like HrmAntId.
+func identifying(name string) bool {
+	return identifyingFields[name] || strings.HasSuffix(name, "AntId")
+}
+
+// Redact walks val, which is typically a *fit.File or one of the fit.XXXFile
+// types, and sets every device-identifying field (serial numbers, product
+// IDs and ANT device numbers) to its invalid value. Only fields reachable
+// through pointers, or through val itself if it's addressable, can be
+// changed. It returns the number of fields which were set.
+func Redact(val reflect.Value) int {
+	n := 0
+
+	switch val.Kind() {
+	case reflect.Ptr:
+		if !val.IsNil() {
+			n += Redact(val.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			n += Redact(val.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Field(i)
+			name := val.Type().Field(i).Name
+			if !identifying(name) || !field.CanSet() {
+				n += Redact(field)
+				continue
+			}
+
+			info, ok := FieldInfo(val.Type().Name(), name)
+			if !ok || info.Invalid == nil || info.IsInvalid(field) {
+				continue
+			}
+
+			field.Set(reflect.ValueOf(info.Invalid).Convert(field.Type()))
+			n++
+		}
+	}
+
+	return n
+}
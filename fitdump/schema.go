@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// FieldPresence is how many messages of a type hold a valid value for one
+// of its fields
+type FieldPresence struct {
+	// Message and Field are in the profile's snake_case form
+	Message, Field string
+	// Messages is the number of messages of the type, and Valid the
+	// number with a valid value for the field
+	Messages, Valid int
+}
+
+// FieldsPresent returns each profile field of each type of message in val, a
+// fit.File or a file body such as fit.ActivityFile, with how many of the
+// messages hold a valid value for it. Message types are in the order
+// they're first found, and fields in the fit package's order.
+func FieldsPresent(val reflect.Value) []FieldPresence {
+	var present []FieldPresence
+	// The index of the first field of each message type in present
+	index := make(map[string]int)
+
+	walkMessages(val, func(path string, msg reflect.Value) {
+		name := SnakeCase(strings.TrimSuffix(msg.Type().Name(), "Msg"))
+		first, seen := index[name]
+		if !seen {
+			first = len(present)
+			index[name] = first
+		}
+
+		n := first
+		for i := 0; i < msg.NumField(); i++ {
+			field := msg.Type().Field(i).Name
+			info, ok := FieldInfo(msg.Type().Name(), field)
+			if !exported(field) || !ok {
+				continue
+			}
+			if !seen {
+				present = append(present, FieldPresence{Message: name, Field: SnakeCase(field)})
+			}
+			present[n].Messages++
+			if !info.IsInvalid(msg.Field(i)) {
+				present[n].Valid++
+			}
+			n++
+		}
+	})
+
+	return present
+}
+
+// SchemaEntry is a message, or a field of one, which a Schema expects
+type SchemaEntry struct {
+	// Message and Field are in the profile's snake_case form. Field is
+	// "" when only the message is expected.
+	Message, Field string
+}
+
+func (e SchemaEntry) String() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Message + "." + e.Field
+}
+
+// Schema lists the messages and fields expected in a file. A file matches
+// when each field listed has a valid value in at least one message, each
+// message listed is present, and no other field has a valid value.
+type Schema []SchemaEntry
+
+// ParseSchema reads a schema, one message or message.field per line, such as
+// "record" or "record.heart_rate". Names can be given in the Go form
+// (HeartRate) or the profile's (heart_rate). Blank lines and everything
+// after a # are ignored. Names which the fit package doesn't know are an
+// error, so that typos don't go unnoticed.
+func ParseSchema(r io.Reader) (Schema, error) {
+	// The Go names of the messages and fields, by their snake_case ones
+	goNames := make(map[string]string)
+	for msg := range fieldInfos {
+		goNames[SnakeCase(msg)] = msg
+	}
+
+	var schema Schema
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		msg, field, _ := strings.Cut(text, ".")
+		goMsg, ok := goNames[SnakeCase(msg)]
+		if !ok || !isIdent(msg) {
+			return nil, fmt.Errorf("line %d: unknown message '%s'", line, msg)
+		}
+		entry := SchemaEntry{Message: SnakeCase(msg)}
+
+		if field != "" {
+			found := false
+			for name := range fieldInfos[goMsg] {
+				if SnakeCase(name) == SnakeCase(field) {
+					found = true
+					break
+				}
+			}
+			if !found || !isIdent(field) {
+				return nil, fmt.Errorf("line %d: message %s has no field '%s'", line, entry.Message, field)
+			}
+			entry.Field = SnakeCase(field)
+		}
+
+		schema = append(schema, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// SchemaProblem is a difference between a file and a Schema
+type SchemaProblem struct {
+	SchemaEntry
+	Problem string
+}
+
+func (p SchemaProblem) String() string {
+	return fmt.Sprintf("%v: %s", p.SchemaEntry, p.Problem)
+}
+
+func messages(n int) string {
+	if n == 1 {
+		return "1 message"
+	}
+	return fmt.Sprintf("%d messages", n)
+}
+
+// Check compares the fields present in a file, from FieldsPresent, against
+// s. Expected fields which are missing or never valid come first, in the
+// schema's order, followed by the unexpected messages and fields, in the
+// order they're present.
+func (s Schema) Check(present []FieldPresence) []SchemaProblem {
+	expected := make(map[SchemaEntry]bool)
+	for _, e := range s {
+		expected[e] = true
+		// A field expects its message too
+		expected[SchemaEntry{Message: e.Message}] = true
+	}
+
+	// Totalled, in case the messages were found in more than one pass
+	counts := make(map[string]int)
+	fields := make(map[SchemaEntry]FieldPresence)
+	var order []SchemaEntry
+	for _, p := range present {
+		key := SchemaEntry{p.Message, p.Field}
+		f, ok := fields[key]
+		if !ok {
+			f = FieldPresence{Message: p.Message, Field: p.Field}
+			order = append(order, key)
+		}
+		f.Messages += p.Messages
+		f.Valid += p.Valid
+		fields[key] = f
+		if f.Messages > counts[p.Message] {
+			counts[p.Message] = f.Messages
+		}
+	}
+
+	var problems []SchemaProblem
+	for _, e := range s {
+		count := counts[e.Message]
+		switch {
+		case count == 0:
+			problems = append(problems, SchemaProblem{e, "missing, no " + e.Message + " messages"})
+		case e.Field != "" && fields[e].Valid == 0:
+			problems = append(problems, SchemaProblem{e, "invalid in all " + messages(count)})
+		}
+	}
+
+	reported := make(map[string]bool)
+	for _, key := range order {
+		f := fields[key]
+		if f.Valid == 0 || expected[key] {
+			continue
+		}
+		if !expected[SchemaEntry{Message: key.Message}] {
+			// Reported once for the whole message, not for each
+			// of its fields
+			if !reported[key.Message] {
+				reported[key.Message] = true
+				problems = append(problems, SchemaProblem{SchemaEntry{Message: key.Message},
+					"unexpected, " + messages(counts[key.Message])})
+			}
+			continue
+		}
+		problems = append(problems, SchemaProblem{key, fmt.Sprintf("unexpected, valid in %d of %s", f.Valid, messages(f.Messages))})
+	}
+
+	return problems
+}
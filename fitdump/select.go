@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Selector picks a single message out of a file, such as the lap at index 5
+type Selector struct {
+	// Message is the message name, in the profile's snake_case form
+	Message string
+	// Index counts from 0, like the indices in the dump
+	Index int
+}
+
+// ParseSelector parses a selector of the form "lap[5]", which is a Path of a
+// message and an index. The message can be given in the profile's form
+// (device_info), the fit package's (DeviceInfo) or as the field holding the
+// messages (DeviceInfos), so a path from the dump such as Laps[5] works too.
+func ParseSelector(s string) (Selector, error) {
+	p, err := ParsePath(s)
+	if err != nil || len(p) != 2 || p[1].Name != "" {
+		return Selector{}, fmt.Errorf("selector '%s' should be of the form message[N], e.g. lap[5]", s)
+	}
+
+	return Selector{Message: SnakeCase(p[0].Name), Index: p[1].Index}, nil
+}
+
+func (s Selector) String() string {
+	return fmt.Sprintf("%s[%d]", s.Message, s.Index)
+}
+
+// matches reports whether slice, a field of a file, holds the selected
+// message
+func (s Selector) matches(slice reflect.Value, field string) bool {
+	if slice.Kind() != reflect.Slice || !isStructSlice(slice) {
+		return false
+	}
+
+	t := slice.Type().Elem()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return s.Message == SnakeCase(strings.TrimSuffix(t.Name(), "Msg")) || s.Message == SnakeCase(field)
+}
+
+// Check returns an error if val, a file body such as fit.ActivityFile, doesn't
+// hold the selected message
+func (s Selector) Check(val reflect.Value) error {
+	if val.Kind() == reflect.Struct {
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Field(i)
+			if !exported(val.Type().Field(i).Name) || !s.matches(field, val.Type().Field(i).Name) {
+				continue
+			}
+			if field.Len() == 0 {
+				break
+			}
+			if s.Index >= field.Len() {
+				return fmt.Errorf("%v is out of range, the last is %s[%d]", s, s.Message, field.Len()-1)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%v: there are no %s messages", s, s.Message)
+}
+
+// Exclusion is a set of messages to leave out of the dump, by their names
+// in the profile's snake_case form
+type Exclusion map[string]bool
+
+// ParseExclusion parses a comma-separated list of messages, in any of the
+// forms ParseSelector takes, such as "record,hrv"
+func ParseExclusion(s string) (Exclusion, error) {
+	e := make(Exclusion)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("exclusion '%s' has an empty message name", s)
+		}
+		e[SnakeCase(name)] = true
+	}
+	return e, nil
+}
+
+// excludes reports whether field, a message or slice of messages held in a
+// file or message, is one of the excluded messages
+func (e Exclusion) excludes(field reflect.Value, name string) bool {
+	if len(e) == 0 {
+		return false
+	}
+
+	t := field.Type()
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || !strings.HasSuffix(t.Name(), "Msg") {
+		return false
+	}
+	return e[SnakeCase(strings.TrimSuffix(t.Name(), "Msg"))] || e[SnakeCase(name)]
+}
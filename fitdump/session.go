@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// SessionWindow returns the time from the start of s to its end, which is
+// its start plus its total elapsed time, or its timestamp if that's
+// invalid
+func SessionWindow(s *fit.SessionMsg) (start, end time.Time) {
+	start, end = s.StartTime, s.Timestamp
+	if FieldValid(s, "TotalElapsedTime") {
+		end = start.Add(time.Duration(s.GetTotalElapsedTimeScaled() * float64(time.Second)))
+	}
+	return start, end
+}
+
+// SelectSession cuts activity down to its nth session, counting from 1, such
+// as one leg of a multisport file. The records and events are those within
+// the session's window, from SessionWindow, and the laps and lengths those
+// which started in it. The activity message, device infos and HRV messages,
+// which aren't tied to a time, are left alone.
+func SelectSession(activity *fit.ActivityFile, n int) error {
+	if n < 1 || n > len(activity.Sessions) {
+		return fmt.Errorf("no session %d, the file has %s", n, sessions(len(activity.Sessions)))
+	}
+
+	s := activity.Sessions[n-1]
+	start, end := SessionWindow(s)
+	in := func(t time.Time) bool {
+		return !t.Before(start) && !t.After(end)
+	}
+
+	activity.Sessions = []*fit.SessionMsg{s}
+
+	var laps []*fit.LapMsg
+	for _, lap := range activity.Laps {
+		if in(lap.StartTime) {
+			laps = append(laps, lap)
+		}
+	}
+	activity.Laps = laps
+
+	var lengths []*fit.LengthMsg
+	for _, l := range activity.Lengths {
+		if in(l.StartTime) {
+			lengths = append(lengths, l)
+		}
+	}
+	activity.Lengths = lengths
+
+	var records []*fit.RecordMsg
+	for _, r := range activity.Records {
+		if in(r.Timestamp) {
+			records = append(records, r)
+		}
+	}
+	activity.Records = records
+
+	var events []*fit.EventMsg
+	for _, e := range activity.Events {
+		if in(e.Timestamp) {
+			events = append(events, e)
+		}
+	}
+	activity.Events = events
+
+	return nil
+}
+
+func sessions(n int) string {
+	if n == 1 {
+		return "1 session"
+	}
+	return fmt.Sprintf("%d sessions", n)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// walkTimes calls fn with each valid time in val, which is typically a
+// *fit.File or one of the fit.XXXFile types, following pointers, slices and
+// structs as Redact does
+func walkTimes(val reflect.Value, fn func(t reflect.Value)) {
+	switch val.Kind() {
+	case reflect.Ptr:
+		if !val.IsNil() {
+			walkTimes(val.Elem(), fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			walkTimes(val.Index(i), fn)
+		}
+	case reflect.Struct:
+		if val.Type() == timeType {
+			t := val.Interface().(time.Time)
+			if !t.IsZero() && !fit.IsBaseTime(t) {
+				fn(val)
+			}
+			return
+		}
+		for i := 0; i < val.NumField(); i++ {
+			if exported(val.Type().Field(i).Name) {
+				walkTimes(val.Field(i), fn)
+			}
+		}
+	}
+}
+
+// EarliestTime returns the earliest valid time in val, or false if there
+// aren't any. See walkTimes for what val can be.
+func EarliestTime(val reflect.Value) (time.Time, bool) {
+	var earliest time.Time
+	found := false
+	walkTimes(val, func(v reflect.Value) {
+		t := v.Interface().(time.Time)
+		if !found || t.Before(earliest) {
+			earliest, found = t, true
+		}
+	})
+	return earliest, found
+}
+
+// ShiftTimes moves every valid time in val by d, keeping the time between
+// them, and returns the number moved. Local times move too, staying in
+// their zone. Invalid times are left invalid. As with Redact, only times
+// reachable through pointers, or through val if it's addressable, can be
+// changed, and the others are left alone.
+//
+// Only time fields move: counts of seconds such as the hr message's event
+// timestamps, which are relative to the device's clock, are left as they
+// are.
+func ShiftTimes(val reflect.Value, d time.Duration) int {
+	n := 0
+	walkTimes(val, func(v reflect.Value) {
+		if !v.CanSet() {
+			return
+		}
+		v.Set(reflect.ValueOf(v.Interface().(time.Time).Add(d)))
+		n++
+	})
+	return n
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// SmoothRecords returns copies of records, which must be in time order, with
+// each of fields replaced by a moving average over the valid values within
+// window, centred on the record's timestamp. Values which were invalid stay
+// invalid. The records passed in aren't modified.
+func SmoothRecords(records []*fit.RecordMsg, window time.Duration, fields ...string) []*fit.RecordMsg {
+	if window <= 0 || len(fields) == 0 {
+		return records
+	}
+
+	smoothed := make([]*fit.RecordMsg, len(records))
+	for i, r := range records {
+		c := *r
+		smoothed[i] = &c
+	}
+
+	for _, field := range fields {
+		info, ok := FieldInfo("Record", field)
+		if !ok {
+			continue
+		}
+		smoothField(records, smoothed, field, info, window/2)
+	}
+
+	return smoothed
+}
+
+// smoothField averages field from src into dst over a sliding window,
+// keeping a running sum of the valid values between lo and hi.
+func smoothField(src, dst []*fit.RecordMsg, field string, info Info, half time.Duration) {
+	value := func(i int) (float64, bool) {
+		v := reflect.ValueOf(src[i]).Elem().FieldByName(field)
+		if info.IsInvalid(v) {
+			return 0, false
+		}
+		return rawValue(v)
+	}
+
+	sum, n := 0.0, 0
+	lo, hi := 0, 0
+	for i, r := range src {
+		for hi < len(src) && !src[hi].Timestamp.After(r.Timestamp.Add(half)) {
+			if v, ok := value(hi); ok {
+				sum += v
+				n++
+			}
+			hi++
+		}
+		for lo < hi && src[lo].Timestamp.Before(r.Timestamp.Add(-half)) {
+			if v, ok := value(lo); ok {
+				sum -= v
+				n--
+			}
+			lo++
+		}
+
+		if _, ok := value(i); !ok || n == 0 {
+			continue
+		}
+
+		avg := math.Round(sum / float64(n))
+		v := reflect.ValueOf(dst[i]).Elem().FieldByName(field)
+		switch v.Kind() {
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(int64(avg))
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v.SetUint(uint64(avg))
+		}
+	}
+}
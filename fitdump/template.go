@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"reflect"
+	"text/template"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// messageField returns field name of msg, which is a fit.XXXMsg or a pointer
+// to one, along with its profile information.
+func messageField(msg interface{}, name string) (reflect.Value, Info, error) {
+	v := reflect.Indirect(reflect.ValueOf(msg))
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, Info{}, fmt.Errorf("%T is not a message", msg)
+	}
+
+	field := v.FieldByName(name)
+	if !field.IsValid() {
+		return reflect.Value{}, Info{}, fmt.Errorf("%s has no field %s", v.Type().Name(), name)
+	}
+
+	info, _ := FieldInfo(v.Type().Name(), name)
+	return field, info, nil
+}
+
+// TemplateFuncs returns the helper functions for text/template templates
+// which are executed against decoded FIT data:
+//
+//	field MSG NAME    the field formatted as in the csv output: blank if
+//	                  invalid, scaled, and positions in degrees
+//	valid MSG NAME    whether the field holds a valid value
+//	degrees POS       a fit.Latitude or fit.Longitude in degrees
+//	scale V DIV       the numeric value V divided by DIV
+//	formatTime LAYOUT T
+//	                  T in UTC, formatted with the time package's LAYOUT
+//
+// opts.SpeedUnit, opts.Units and opts.Missing apply to the fields formatted
+// by field.
+func TemplateFuncs(opts Options) template.FuncMap {
+	return template.FuncMap{
+		"field": func(msg interface{}, name string) (string, error) {
+			field, info, err := messageField(msg, name)
+			if err != nil {
+				return "", err
+			}
+			return FormatCell(field, info, opts), nil
+		},
+		"valid": func(msg interface{}, name string) (bool, error) {
+			field, info, err := messageField(msg, name)
+			if err != nil {
+				return false, err
+			}
+			return !info.IsInvalid(field), nil
+		},
+		"degrees": func(pos interface{}) (float64, error) {
+			switch x := pos.(type) {
+			case fit.Latitude:
+				return x.Degrees(), nil
+			case fit.Longitude:
+				return x.Degrees(), nil
+			}
+			return 0, fmt.Errorf("%T is not a position", pos)
+		},
+		"scale": func(v interface{}, div float64) (float64, error) {
+			raw, ok := rawValue(reflect.ValueOf(v))
+			if !ok {
+				return 0, fmt.Errorf("%T is not numeric", v)
+			}
+			return raw / div, nil
+		},
+		"formatTime": func(layout string, t time.Time) string {
+			return t.UTC().Format(layout)
+		},
+	}
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// SpeedUnit selects how speed values are presented
+type SpeedUnit int
+
+const (
+	// SpeedRaw leaves speeds in the fit package's representation
+	SpeedRaw SpeedUnit = iota
+	SpeedMetersPerSecond
+	SpeedKilometersPerHour
+	SpeedMilesPerHour
+	SpeedMinutesPerKilometer
+	SpeedMinutesPerMile
+)
+
+const metersPerMile = 1609.344
+
+var speedUnitNames = map[string]SpeedUnit{
+	"":      SpeedRaw,
+	"ms":    SpeedMetersPerSecond,
+	"kmh":   SpeedKilometersPerHour,
+	"mph":   SpeedMilesPerHour,
+	"minkm": SpeedMinutesPerKilometer,
+	"minmi": SpeedMinutesPerMile,
+}
+
+// ParseSpeedUnit parses a speed unit name: one of "ms", "kmh", "mph", "minkm"
+// or "minmi". The empty string selects SpeedRaw.
+func ParseSpeedUnit(s string) (SpeedUnit, error) {
+	u, ok := speedUnitNames[s]
+	if !ok {
+		return SpeedRaw, fmt.Errorf("unknown speed unit '%s'", s)
+	}
+	return u, nil
+}
+
+// UnitSystem selects the units of the values which don't have a flag of
+// their own, such as temperature. Speeds have SpeedUnit.
+type UnitSystem int
+
+const (
+	// Metric leaves values in the FIT profile's units, which are metric
+	Metric UnitSystem = iota
+	// Imperial shows temperatures in degrees Fahrenheit
+	Imperial
+)
+
+// ParseUnits parses a unit system name: "metric" or "imperial"
+func ParseUnits(s string) (UnitSystem, error) {
+	switch s {
+	case "metric":
+		return Metric, nil
+	case "imperial":
+		return Imperial, nil
+	}
+	return Metric, fmt.Errorf("units must be 'metric' or 'imperial'")
+}
+
+// ConvertTemperature converts a temperature in degrees Celsius to o.Units,
+// returning it with the unit's name
+func (o Options) ConvertTemperature(c float64) (float64, string) {
+	if o.Units == Imperial {
+		return c*9/5 + 32, "F"
+	}
+	return c, "C"
+}
+
+// FormatTemperature formats a temperature in degrees Celsius in o.Units,
+// with prec decimal places and the unit, such as "21 C" or "69.8 F". It's
+// o.Missing if c is NaN or infinite.
+func (o Options) FormatTemperature(c float64, prec int) string {
+	v, unit := o.ConvertTemperature(c)
+	return o.FormatFloat(v, prec, unit)
+}
+
+// IsTemperature reports whether the field described by info holds a
+// temperature
+func (i Info) IsTemperature() bool {
+	return i.Unit == "C"
+}
+
+// converts reports whether o.SpeedUnit or o.Units change how the field
+// described by info is shown, with its unit, rather than as it is
+func (o Options) converts(info Info) bool {
+	return o.SpeedUnit != SpeedRaw && info.IsSpeed() || o.Units == Imperial && info.IsTemperature()
+}
+
+// convert formats v, the value of a field described by info, if converts.
+// The unit follows if withUnit, which tabular output leaves to its header,
+// see ConvertedUnit. Values which can't be shown, such as the pace when
+// stopped, are o.Missing.
+func (o Options) convert(v float64, info Info, withUnit bool) (string, bool) {
+	if !o.converts(info) {
+		return "", false
+	}
+
+	var text, unit string
+	if info.IsSpeed() {
+		var ok bool
+		if text, unit, ok = o.speedValue(v); !ok {
+			return o.missing(), true
+		}
+	} else {
+		var t float64
+		t, unit = o.ConvertTemperature(v)
+		if !finite(t) {
+			return o.missing(), true
+		}
+		text = strconv.FormatFloat(t, 'f', 1, 64)
+	}
+
+	if withUnit {
+		text += " " + unit
+	}
+	return text, true
+}
+
+// ConvertedUnit returns the unit which o.SpeedUnit or o.Units show the field
+// described by info in, or "" if they leave it as it is. Tabular output
+// puts it in the column's header, as the cells are bare numbers.
+func (o Options) ConvertedUnit(info Info) string {
+	switch {
+	case !o.converts(info):
+		return ""
+	case info.IsSpeed():
+		return speedUnitSymbols[o.SpeedUnit]
+	}
+	_, unit := o.ConvertTemperature(0)
+	return unit
+}
+
+// speedFields are the fields, by message, which hold the speed over the
+// ground. Other fields in m/s, such as vertical speeds, can be negative or
+// aren't meaningful as a pace, so they're left as they are.
+var speedFields = map[string]map[string]bool{
+	"Record": {"Speed": true, "EnhancedSpeed": true},
+	"Lap": {
+		"AvgSpeed": true, "MaxSpeed": true,
+		"EnhancedAvgSpeed": true, "EnhancedMaxSpeed": true,
+	},
+	"Session": {
+		"AvgSpeed": true, "MaxSpeed": true,
+		"EnhancedAvgSpeed": true, "EnhancedMaxSpeed": true,
+	},
+	"SegmentLap": {"AvgSpeed": true, "MaxSpeed": true},
+	"Length":     {"AvgSpeed": true},
+}
+
+// IsSpeed reports whether the field described by info holds a speed over the
+// ground, such as a Record's Speed or a Lap's AvgSpeed
+func (i Info) IsSpeed() bool {
+	return i.speed
+}
+
+// speedUnitSymbols are the units speeds are shown in, for each SpeedUnit
+var speedUnitSymbols = map[SpeedUnit]string{
+	SpeedRaw:                 "m/s",
+	SpeedMetersPerSecond:     "m/s",
+	SpeedKilometersPerHour:   "km/h",
+	SpeedMilesPerHour:        "mph",
+	SpeedMinutesPerKilometer: "min/km",
+	SpeedMinutesPerMile:      "min/mi",
+}
+
+// FormatSpeed formats a speed in meters per second in o.SpeedUnit, or in
+// m/s for SpeedRaw. Paces are formatted as minutes:seconds. Speeds which are
+// NaN or infinite, and paces when stopped, are o.Missing.
+func (o Options) FormatSpeed(mps float64) string {
+	text, unit, ok := o.speedValue(mps)
+	if !ok {
+		return o.missing()
+	}
+	return text + " " + unit
+}
+
+// speedValue formats a speed as FormatSpeed does, but without its unit,
+// which it returns separately. It's false for the speeds FormatSpeed shows
+// as o.Missing.
+func (o Options) speedValue(mps float64) (string, string, bool) {
+	if !finite(mps) {
+		return "", "", false
+	}
+
+	unit := speedUnitSymbols[o.SpeedUnit]
+	switch o.SpeedUnit {
+	case SpeedKilometersPerHour:
+		return fmt.Sprintf("%.2f", mps*3.6), unit, true
+	case SpeedMilesPerHour:
+		return fmt.Sprintf("%.2f", mps*3600/metersPerMile), unit, true
+	case SpeedMinutesPerKilometer:
+		pace, ok := formatPace(mps, 1000)
+		return pace, unit, ok
+	case SpeedMinutesPerMile:
+		pace, ok := formatPace(mps, metersPerMile)
+		return pace, unit, ok
+	}
+
+	return fmt.Sprintf("%.3f", mps), unit, true
+}
+
+// formatPace formats the time to cover meters at mps as minutes:seconds,
+// or returns false if stationary, when the pace is infinite
+func formatPace(mps, meters float64) (string, bool) {
+	if mps <= 0 {
+		return "", false
+	}
+
+	secs := int(math.Round(meters / mps))
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60), true
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitdump
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+)
+
+// XMLDumper writes values as XML, in the same tree as the text dump: each
+// struct is an element with an element for each of its fields, and each
+// slice is the field's element repeated, once for each value. Invalid
+// fields are left out, Stringers are written in their string form and
+// times in RFC 3339. The values are all written inside a <fit> element.
+//
+// opts.SnakeCase, opts.SpeedUnit, opts.Units, opts.Missing, opts.Location,
+// opts.Enhanced, opts.Invalid and opts.Exclude apply; the other options are
+// for the text dump.
+type XMLDumper struct {
+	enc  *xml.Encoder
+	opts Options
+	err  error
+}
+
+// NewXMLDumper returns an XMLDumper which writes to w, starting with the
+// XML declaration and the <fit> element
+func NewXMLDumper(w io.Writer, opts Options) *XMLDumper {
+	x := &XMLDumper{enc: xml.NewEncoder(w), opts: opts}
+	x.enc.Indent("", "\t")
+	_, x.err = io.WriteString(w, xml.Header)
+	x.token(xml.StartElement{Name: xml.Name{Local: "fit"}})
+	return x
+}
+
+func (x *XMLDumper) token(t xml.Token) {
+	if x.err == nil {
+		x.err = x.enc.EncodeToken(t)
+	}
+}
+
+// Dump writes val as an element called name
+func (x *XMLDumper) Dump(val reflect.Value, name string) {
+	x.dump(val, nil, name)
+}
+
+// Close ends the <fit> element, and returns the first error from writing
+func (x *XMLDumper) Close() error {
+	x.token(xml.EndElement{Name: xml.Name{Local: "fit"}})
+	if x.err == nil {
+		x.err = x.enc.Flush()
+	}
+	return x.err
+}
+
+// value returns the text for a leaf field, or false if it's invalid and
+// should be left out
+func (x *XMLDumper) value(field reflect.Value, info *Info) (string, bool) {
+	text, ok := x.validValue(field, info)
+	if !ok {
+		return x.opts.Invalid.invalidText(rawText(field))
+	}
+	return text, true
+}
+
+// validValue returns the text for a leaf field, or false if it's invalid
+func (x *XMLDumper) validValue(field reflect.Value, info *Info) (string, bool) {
+	if t, ok := field.Interface().(time.Time); ok {
+		if fit.IsBaseTime(t) {
+			return "", false
+		}
+		if x.opts.Location != nil && t.Location() == time.UTC {
+			t = t.In(x.opts.Location)
+		}
+		return t.Format(time.RFC3339), true
+	}
+
+	if s, ok := field.Interface().(fmt.Stringer); ok {
+		str := s.String()
+		return str, !invalidEnum(field)
+	}
+
+	if info != nil {
+		if info.IsInvalid(field) {
+			return "", false
+		}
+		if v, ok := info.Value(field); ok {
+			if text, ok := x.opts.convert(v, *info, true); ok {
+				return text, true
+			}
+		}
+		return fmt.Sprint(field), true
+	}
+
+	if IsInvalid(field) {
+		return "", false
+	}
+	return fmt.Sprintf("%+v", field), true
+}
+
+func (x *XMLDumper) dump(val reflect.Value, info *Info, name string) {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+
+	_, stringer := val.Interface().(fmt.Stringer)
+	switch {
+	case stringer || (val.Kind() != reflect.Struct && val.Kind() != reflect.Ptr && val.Kind() != reflect.Slice):
+		text, ok := x.value(val, info)
+		if !ok {
+			return
+		}
+		x.token(start)
+		x.token(xml.CharData(text))
+		x.token(start.End())
+	case val.Kind() == reflect.Struct:
+		x.token(start)
+		for i := 0; i < val.NumField(); i++ {
+			fieldName := val.Type().Field(i).Name
+			if !exported(fieldName) || x.opts.Enhanced.redundant(val, fieldName) || x.opts.Exclude.excludes(val.Field(i), fieldName) {
+				continue
+			}
+			var fieldInfo *Info
+			if fi, ok := FieldInfo(val.Type().Name(), fieldName); ok {
+				fieldInfo = &fi
+			}
+			if x.opts.SnakeCase {
+				fieldName = SnakeCase(fieldName)
+			}
+			x.dump(val.Field(i), fieldInfo, fieldName)
+		}
+		x.token(start.End())
+	case val.Kind() == reflect.Ptr:
+		if !val.IsNil() {
+			x.dump(val.Elem(), info, name)
+		}
+	case val.Kind() == reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			if elem := reflect.Indirect(val.Index(i)); elem.IsValid() {
+				x.dump(elem, nil, name)
+			}
+		}
+	}
+}
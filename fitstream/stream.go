@@ -0,0 +1,916 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// Package fitstream decodes FIT files one message at a time, so that very
+// large files can be processed without holding all of their messages in
+// memory, as fit.Decode does.
+//
+// The fit package has no streaming mode, so this splits the file into its
+// messages itself, and hands them to fit.Decode wrapped in a tiny FIT file
+// of their own: the original header and FileId, then the messages with their
+// definitions. That means each message is decoded by exactly the same code
+// as in a full decode. fit.Decode has a fixed cost of a few kilobytes for
+// each file, so a run of messages of the same type, such as the records, is
+// decoded in batches of up to batchSize in one tiny file, which bounds the
+// memory used while sharing that cost between them.
+//
+// Developer fields are dropped from the decoded messages, as their
+// descriptions would otherwise have to be carried along too, but their raw
+// bytes are kept in the RawMessage. The fit package accumulates the distance
+// in compressed_speed_distance across messages in a variable of its own,
+// which carries on from one decode to the next, so it's accumulated here
+// instead, from zero at the start of the file, as the first full decode in
+// a program does.
+package fitstream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/dyncrc16"
+)
+
+const (
+	headerCompressed = 0x80
+	headerDefinition = 0x40
+	headerDevData    = 0x20
+
+	// batchSize is the most messages Next decodes in one tiny file
+	batchSize = 128
+
+	fieldNumTimestamp = 253
+	baseTypeUint32    = 0x86
+
+	// FIT timestamps count seconds from 1989-12-31 00:00:00 UTC
+	fitEpoch = 631065600
+)
+
+type definition struct {
+	order     binary.ByteOrder
+	globalNum uint16
+	// The definition message as it's written to the mini files: local
+	// type 0, no developer fields
+	raw []byte
+	// The field definitions: number, size and base type of each field
+	fields []byte
+	// The developer field definitions: number, size and developer data
+	// index of each field
+	devFields []byte
+	// Size of the fixed fields, and of the developer fields which follow
+	size, devSize int
+	// Offset of the timestamp field, or -1 if there isn't one
+	timestamp int
+
+	// The definition message as it is in the file, and its offset from
+	// the start of the file
+	file   []byte
+	offset int64
+}
+
+// msgKind is how the fit package keeps a type of message in a file
+type msgKind int
+
+const (
+	// Not decoded yet, so not known
+	kindUnknown msgKind = iota
+	// Not kept, so Next returns them as they are
+	kindDropped
+	// Kept in a single field, so a file only has the last one
+	kindSingle
+	// Kept in a slice, so several can be decoded in one file
+	kindSlice
+)
+
+// decoded is a message decoded by Next, waiting to be returned
+type decoded struct {
+	msg interface{}
+	raw *RawMessage
+}
+
+// Reader returns the messages of a FIT file one at a time
+type Reader struct {
+	r         *bufio.Reader
+	header    fit.Header
+	fileId    fit.FileIdMsg
+	remaining int
+
+	defs [16]*definition
+
+	// The header, FileId definition and FileId message which start each
+	// mini file
+	prefix []byte
+
+	lastTimestamp uint32
+
+	// The distance accumulated from the records' compressed_speed_distance,
+	// and the last value it was accumulated from
+	distance, lastDistance uint32
+
+	// The tiny file being decoded, and the messages in it, kept to be
+	// reused
+	buf   bytes.Buffer
+	msg   []byte
+	batch []*RawMessage
+
+	kinds map[fit.MesgNum]msgKind
+	// Messages decoded by Next and not yet returned, and the storage
+	// for them
+	queue, decoded []decoded
+	// The message or error read after the last batch, which belongs to
+	// the next one
+	peeked  *RawMessage
+	peekErr error
+
+	// The last data message returned
+	raw       *RawMessage
+	fileIdRaw *RawMessage
+}
+
+// RawMessage is a data message as it appears in the file, for reading the
+// fields which the fit package doesn't know.
+type RawMessage struct {
+	Num fit.MesgNum
+	// Timestamp is the message's timestamp, either from its timestamp
+	// field or its compressed timestamp header. It's the zero time if the
+	// message has neither.
+	Timestamp time.Time
+	// Offset is the offset of the data message from the start of the
+	// file, and DefinitionOffset that of the definition it uses
+	Offset           int64
+	DefinitionOffset int64
+
+	header     byte
+	definition []byte
+	def        *definition
+
+	order  binary.ByteOrder
+	fields []byte
+	data   []byte
+
+	devFields []byte
+	devData   []byte
+}
+
+// FieldDef is a field definition from a definition message
+type FieldDef struct {
+	Num, Size, BaseType byte
+}
+
+// DevFieldDef is a developer field definition from a definition message,
+// with the developer data index of the developer which defined it
+type DevFieldDef struct {
+	Num, Size, DevIndex byte
+}
+
+// NewRawMessage returns a little-endian data message with global number num
+// and the fields, whose values are data in the same order, followed by the
+// developer fields, whose values are devData. data and devData must be the
+// total size of their fields. It's for adding messages which
+// the fit package can't write to a file, with fitbuild.Encode. Its Offset and
+// DefinitionOffset are -1, as it isn't from a file.
+func NewRawMessage(num fit.MesgNum, fields []FieldDef, data []byte, devFields []DevFieldDef, devData []byte) *RawMessage {
+	def := &definition{
+		order:     binary.LittleEndian,
+		globalNum: uint16(num),
+		offset:    -1,
+	}
+	for _, f := range fields {
+		def.fields = append(def.fields, f.Num, f.Size, f.BaseType)
+		def.size += int(f.Size)
+	}
+	for _, f := range devFields {
+		def.devFields = append(def.devFields, f.Num, f.Size, f.DevIndex)
+		def.devSize += int(f.Size)
+	}
+
+	hdr := byte(headerDefinition)
+	if len(devFields) > 0 {
+		hdr |= headerDevData
+	}
+	def.file = []byte{hdr, 0, 0, 0, 0, byte(len(fields))}
+	binary.LittleEndian.PutUint16(def.file[3:5], uint16(num))
+	def.file = append(def.file, def.fields...)
+	if len(devFields) > 0 {
+		def.file = append(def.file, byte(len(devFields)))
+		def.file = append(def.file, def.devFields...)
+	}
+
+	msg := append(append([]byte(nil), data...), devData...)
+	return def.rawMessage(0, -1, msg)
+}
+
+// Fields returns the definitions of the message's fields, in the order
+// their data is in the message
+func (m *RawMessage) Fields() []FieldDef {
+	var defs []FieldDef
+	for i := 0; i < len(m.fields); i += 3 {
+		defs = append(defs, FieldDef{m.fields[i], m.fields[i+1], m.fields[i+2]})
+	}
+	return defs
+}
+
+// DevFields returns the definitions of the message's developer fields, in
+// the order their data is in the message, after the other fields
+func (m *RawMessage) DevFields() []DevFieldDef {
+	var defs []DevFieldDef
+	for i := 0; i < len(m.devFields); i += 3 {
+		defs = append(defs, DevFieldDef{m.devFields[i], m.devFields[i+1], m.devFields[i+2]})
+	}
+	return defs
+}
+
+// Definition returns the bytes of the definition message which the message
+// uses, as they are in the file, from its record header to its developer
+// field definitions
+func (m *RawMessage) Definition() []byte {
+	return m.definition
+}
+
+// Bytes returns the bytes of the data message as they are in the file: its
+// record header, its fields and then its developer fields
+func (m *RawMessage) Bytes() []byte {
+	b := append([]byte{m.header}, m.data...)
+	return append(b, m.devData...)
+}
+
+// Field returns the raw bytes of field num, or false if the message doesn't
+// have it.
+func (m *RawMessage) Field(num byte) ([]byte, bool) {
+	offset := 0
+	for i := 0; i < len(m.fields); i += 3 {
+		size := int(m.fields[i+1])
+		if m.fields[i] == num {
+			return m.data[offset : offset+size], true
+		}
+		offset += size
+	}
+	return nil, false
+}
+
+// DevField returns the raw bytes of developer field num of the developer
+// with data index devIndex, or false if the message doesn't have it.
+func (m *RawMessage) DevField(devIndex, num byte) ([]byte, bool) {
+	offset := 0
+	for i := 0; i < len(m.devFields); i += 3 {
+		size := int(m.devFields[i+1])
+		if m.devFields[i] == num && m.devFields[i+2] == devIndex {
+			return m.devData[offset : offset+size], true
+		}
+		offset += size
+	}
+	return nil, false
+}
+
+// ByteOrder returns the byte order of the message's fields
+func (m *RawMessage) ByteOrder() binary.ByteOrder {
+	return m.order
+}
+
+// Uint returns the value of field num as an unsigned integer of the field's
+// size, or false if the message doesn't have it or it isn't 1, 2, 4 or 8
+// bytes. It's up to the caller to check for the field's invalid value.
+func (m *RawMessage) Uint(num byte) (uint64, bool) {
+	b, ok := m.Field(num)
+	if !ok {
+		return 0, false
+	}
+
+	switch len(b) {
+	case 1:
+		return uint64(b[0]), true
+	case 2:
+		return uint64(m.order.Uint16(b)), true
+	case 4:
+		return uint64(m.order.Uint32(b)), true
+	case 8:
+		return m.order.Uint64(b), true
+	}
+	return 0, false
+}
+
+// NewReader reads the header and FileId of a FIT file from r, ready for the
+// rest of the messages to be read with Next.
+func NewReader(r io.Reader) (*Reader, error) {
+	sr := &Reader{r: bufio.NewReader(r), kinds: make(map[fit.MesgNum]msgKind)}
+
+	if err := sr.readHeader(); err != nil {
+		return nil, fmt.Errorf("error decoding header: %v", err)
+	}
+
+	// The FileId is the first message, and it's needed by every mini file
+	hdr, err := sr.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if hdr&headerDefinition == 0 || hdr&headerCompressed != 0 {
+		return nil, errors.New("expected a definition message for file_id first")
+	}
+	def, err := sr.readDefinition(hdr)
+	if err != nil {
+		return nil, err
+	}
+	local := hdr & 0xf
+
+	offset := sr.offset()
+	hdr, err = sr.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if hdr&(headerDefinition|headerCompressed) != 0 || hdr&0xf != local {
+		return nil, errors.New("expected a data message for file_id second")
+	}
+	data, err := sr.read(def.size + def.devSize)
+	if err != nil {
+		return nil, err
+	}
+
+	sr.fileIdRaw = def.rawMessage(hdr, offset, data)
+
+	sr.prefix = append(sr.prefix, def.raw...)
+	sr.prefix = append(sr.prefix, 0)
+	sr.prefix = append(sr.prefix, data[:def.size]...)
+
+	fitf, err := sr.decodeMini(nil)
+	if err != nil {
+		return nil, err
+	}
+	sr.fileId = fitf.FileId
+
+	return sr, nil
+}
+
+// NewRawReader reads the header of a FIT file from r, ready for all of its
+// messages, the FileId included, to be read with NextRaw. Unlike NewReader it
+// doesn't need the file to start with a FileId, but Next can't be used.
+func NewRawReader(r io.Reader) (*Reader, error) {
+	sr := &Reader{r: bufio.NewReader(r)}
+
+	if err := sr.readHeader(); err != nil {
+		return nil, fmt.Errorf("error decoding header: %v", err)
+	}
+
+	return sr, nil
+}
+
+// Header returns the file header
+func (sr *Reader) Header() fit.Header {
+	return sr.header
+}
+
+// FileId returns the file's FileId message
+func (sr *Reader) FileId() fit.FileIdMsg {
+	return sr.fileId
+}
+
+// Raw returns the raw form of the message last returned by Next or NextRaw
+func (sr *Reader) Raw() *RawMessage {
+	return sr.raw
+}
+
+// FileIdRaw returns the raw form of the file's FileId message
+func (sr *Reader) FileIdRaw() *RawMessage {
+	return sr.fileIdRaw
+}
+
+// offset returns the offset from the start of the file of the next byte to
+// be read
+func (sr *Reader) offset() int64 {
+	return int64(sr.header.Size) + int64(sr.header.DataSize) - int64(sr.remaining)
+}
+
+func (sr *Reader) read(n int) ([]byte, error) {
+	if n > sr.remaining {
+		return nil, errors.New("message runs past the end of the data")
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(sr.r, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	sr.remaining -= n
+
+	return b, nil
+}
+
+func (sr *Reader) readByte() (byte, error) {
+	b, err := sr.read(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (sr *Reader) readHeader() error {
+	size, err := sr.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if size != 12 && size != 14 {
+		return fmt.Errorf("illegal header size %d", size)
+	}
+
+	b := make([]byte, size-1)
+	if _, err := io.ReadFull(sr.r, b); err != nil {
+		return err
+	}
+
+	sr.header = fit.Header{
+		Size:            size,
+		ProtocolVersion: b[0],
+		ProfileVersion:  binary.LittleEndian.Uint16(b[1:3]),
+		DataSize:        binary.LittleEndian.Uint32(b[3:7]),
+	}
+	copy(sr.header.DataType[:], b[7:11])
+	if size == 14 {
+		sr.header.CRC = binary.LittleEndian.Uint16(b[11:13])
+	}
+
+	if string(sr.header.DataType[:]) != ".FIT" {
+		return errors.New("data type is not .FIT")
+	}
+
+	sr.remaining = int(sr.header.DataSize)
+
+	return nil
+}
+
+func (sr *Reader) readDefinition(hdr byte) (*definition, error) {
+	// The header byte has already been read
+	offset := sr.offset() - 1
+	fixed, err := sr.read(5)
+	if err != nil {
+		return nil, err
+	}
+
+	def := &definition{
+		order:     binary.ByteOrder(binary.LittleEndian),
+		timestamp: -1,
+		offset:    offset,
+	}
+	if fixed[1] == 1 {
+		def.order = binary.BigEndian
+	}
+	def.globalNum = def.order.Uint16(fixed[2:4])
+
+	fields, err := sr.read(int(fixed[4]) * 3)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(fields); i += 3 {
+		if fields[i] == fieldNumTimestamp && fields[i+1] == 4 {
+			def.timestamp = def.size
+		}
+		def.size += int(fields[i+1])
+	}
+
+	if hdr&headerDevData != 0 {
+		n, err := sr.readByte()
+		if err != nil {
+			return nil, err
+		}
+		devFields, err := sr.read(int(n) * 3)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(devFields); i += 3 {
+			def.devSize += int(devFields[i+1])
+		}
+		def.devFields = devFields
+	}
+
+	def.fields = fields
+	def.raw = append([]byte{headerDefinition}, fixed...)
+	def.raw = append(def.raw, fields...)
+
+	def.file = append([]byte{hdr}, fixed...)
+	def.file = append(def.file, fields...)
+	if hdr&headerDevData != 0 {
+		def.file = append(def.file, byte(len(def.devFields)/3))
+		def.file = append(def.file, def.devFields...)
+	}
+
+	return def, nil
+}
+
+// rawMessage returns the raw form of a data message using the definition,
+// which starts with the record header hdr at offset
+func (def *definition) rawMessage(hdr byte, offset int64, data []byte) *RawMessage {
+	return &RawMessage{
+		Num:              fit.MesgNum(def.globalNum),
+		Offset:           offset,
+		DefinitionOffset: def.offset,
+		header:           hdr,
+		definition:       def.file,
+		def:              def,
+		order:            def.order,
+		fields:           def.fields,
+		data:             data[:def.size],
+		devFields:        def.devFields,
+		devData:          data[def.size:],
+	}
+}
+
+// decodeMini decodes a FIT file made up of the header, the FileId and then
+// msg, which is data messages, each after its definition if it differs.
+func (sr *Reader) decodeMini(msg []byte) (*fit.File, error) {
+	sr.buf.Reset()
+
+	// Always a 12-byte header, which doesn't need a CRC of its own
+	var header [12]byte
+	header[0] = 12
+	header[1] = sr.header.ProtocolVersion
+	binary.LittleEndian.PutUint16(header[2:4], sr.header.ProfileVersion)
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(sr.prefix)+len(msg)))
+	copy(header[8:12], ".FIT")
+
+	sr.buf.Write(header[:])
+	sr.buf.Write(sr.prefix)
+	sr.buf.Write(msg)
+
+	var crc [2]byte
+	binary.LittleEndian.PutUint16(crc[:], dyncrc16.Checksum(sr.buf.Bytes()))
+	sr.buf.Write(crc[:])
+
+	return fit.Decode(&sr.buf)
+}
+
+// compressedTimestamp returns the full timestamp for a compressed timestamp
+// header's 5-bit offset, relative to the last full timestamp.
+func (sr *Reader) compressedTimestamp(offset uint32) uint32 {
+	ts := sr.lastTimestamp&^0x1f + offset
+	if offset < sr.lastTimestamp&0x1f {
+		ts += 0x20
+	}
+	return ts
+}
+
+// Next returns the next message, as a pointer to one of the fit.XXXMsg
+// types. Messages which the fit package doesn't know, or doesn't keep for
+// this type of file, are returned as a *RawMessage instead. It returns
+// io.EOF once all of the messages have been read.
+func (sr *Reader) Next() (interface{}, error) {
+	if sr.prefix == nil {
+		return nil, errors.New("Next needs a Reader from NewReader")
+	}
+
+	if len(sr.queue) == 0 {
+		if err := sr.decodeBatch(); err != nil {
+			return nil, err
+		}
+	}
+
+	d := sr.queue[0]
+	sr.queue[0] = decoded{}
+	sr.queue = sr.queue[1:]
+	sr.raw = d.raw
+	if rec, ok := d.msg.(*fit.RecordMsg); ok {
+		sr.accumulateDistance(rec)
+	}
+	return d.msg, nil
+}
+
+// accumulateDistance sets the distance of a record with
+// compressed_speed_distance, as the fit package does, but from the
+// Reader's own total. Otherwise it would go from wherever the last decode of
+// any file had left the fit package's.
+func (sr *Reader) accumulateDistance(rec *fit.RecordMsg) {
+	csd := rec.CompressedSpeedDistance
+	if len(csd) != 3 || csd[0] == 0xff && csd[1] == 0xff && csd[2] == 0xff {
+		return
+	}
+
+	// The same expression as the fit package, where csd[2]<<4 is a byte
+	v := uint32(csd[1]>>4) | uint32(csd[2]<<4)
+	sr.distance += (v - sr.lastDistance) & (1<<12 - 1)
+	sr.lastDistance = v
+	rec.Distance = sr.distance
+}
+
+// decodeBatch decodes the next message, along with those of the same type
+// which follow it if the fit package keeps them in a slice, and queues them
+// for Next.
+func (sr *Reader) decodeBatch() error {
+	first, err := sr.nextRaw()
+	if err != nil {
+		return err
+	}
+
+	sr.batch = append(sr.batch[:0], first)
+	defer func() {
+		// Don't hold on to the messages once they're queued
+		for i := range sr.batch {
+			sr.batch[i] = nil
+		}
+	}()
+	sr.queue = sr.decoded[:0]
+	defer func() {
+		// Keep the storage grown for the queue
+		sr.decoded = sr.queue
+	}()
+
+	kind := sr.kinds[first.Num]
+	switch kind {
+	case kindDropped:
+		sr.queue = append(sr.queue, decoded{first, first})
+		return nil
+	case kindSlice:
+		for len(sr.batch) < batchSize {
+			raw, err := sr.nextRaw()
+			if err != nil || raw.Num != first.Num {
+				sr.peeked, sr.peekErr = raw, err
+				break
+			}
+			sr.batch = append(sr.batch, raw)
+		}
+	}
+
+	msgs, found, err := sr.decodeMessages(sr.batch)
+	if err != nil {
+		return err
+	}
+	if kind == kindUnknown {
+		sr.kinds[first.Num] = found
+	}
+
+	if len(msgs) == len(sr.batch) {
+		for i, raw := range sr.batch {
+			sr.queue = append(sr.queue, decoded{msgs[i], raw})
+		}
+		return nil
+	}
+	if len(sr.batch) == 1 {
+		// Not kept
+		sr.queue = append(sr.queue, decoded{first, first})
+		return nil
+	}
+
+	// Not all of them were kept, so go one at a time
+	for _, raw := range sr.batch {
+		msgs, _, err := sr.decodeMessages([]*RawMessage{raw})
+		if err != nil {
+			return err
+		}
+		d := decoded{raw, raw}
+		if len(msgs) == 1 {
+			d.msg = msgs[0]
+		}
+		sr.queue = append(sr.queue, d)
+	}
+	return nil
+}
+
+// decodeMessages decodes the messages, which are all of the same type, in
+// one tiny file, and returns them, and how the fit package keeps them
+func (sr *Reader) decodeMessages(raws []*RawMessage) ([]interface{}, msgKind, error) {
+	msg := sr.msg[:0]
+	var last *definition
+	lastCompressed := false
+	for i, raw := range raws {
+		compressed := raw.header&headerCompressed != 0
+		if i == 0 || raw.def != last || compressed != lastCompressed {
+			start := len(msg)
+			msg = append(msg, raw.def.raw...)
+			if compressed {
+				// Make it a normal message with a timestamp
+				// field, as the tiny file has no previous
+				// timestamp to go from
+				msg[start+5]++
+				msg = append(msg, fieldNumTimestamp, 4, baseTypeUint32)
+			}
+			last, lastCompressed = raw.def, compressed
+		}
+
+		msg = append(msg, 0)
+		msg = append(msg, raw.data...)
+		if compressed {
+			var b [4]byte
+			raw.def.order.PutUint32(b[:], uint32(raw.Timestamp.Unix()-fitEpoch))
+			msg = append(msg, b[:]...)
+		}
+	}
+	sr.msg = msg
+
+	fitf, err := sr.decodeMini(msg)
+	if err != nil {
+		return nil, kindUnknown, err
+	}
+
+	msgs, kind := fileMessages(fitf)
+	return msgs, kind, nil
+}
+
+// nextRaw returns the message or error read after the last batch, if there
+// is one, and otherwise reads the next data message from the file
+func (sr *Reader) nextRaw() (*RawMessage, error) {
+	if sr.peeked != nil || sr.peekErr != nil {
+		raw, err := sr.peeked, sr.peekErr
+		sr.peeked, sr.peekErr = nil, nil
+		return raw, err
+	}
+	return sr.readRaw()
+}
+
+// NextRaw returns the next data message as it is in the file, without
+// decoding it, which is much quicker than Next when only the raw fields are
+// needed, such as those the fit package doesn't know. It returns io.EOF once
+// all of the messages have been read.
+func (sr *Reader) NextRaw() (*RawMessage, error) {
+	if len(sr.queue) > 0 {
+		// Already read by Next
+		raw := sr.queue[0].raw
+		sr.queue[0] = decoded{}
+		sr.queue = sr.queue[1:]
+		sr.raw = raw
+		return raw, nil
+	}
+
+	raw, err := sr.nextRaw()
+	if err != nil {
+		return nil, err
+	}
+	sr.raw = raw
+	return raw, nil
+}
+
+// Dropped reads the rest of the messages, returning those which fit.Decode
+// leaves out of a fit.File: the ones the fit package doesn't know, the
+// developer data IDs and field descriptions, and the ones it doesn't keep
+// for this type of file, such as sport messages in an activity. devFields is
+// how many of the other messages had developer fields, which fit.Decode
+// drops too. Only the first message of each type is decoded, to find out
+// whether it's kept, so it's nearly as quick as NextRaw. It needs a Reader
+// from NewReader.
+func (sr *Reader) Dropped() (dropped []*RawMessage, devFields int, err error) {
+	if sr.prefix == nil {
+		return nil, 0, errors.New("Dropped needs a Reader from NewReader")
+	}
+
+	for {
+		raw, err := sr.NextRaw()
+		if err == io.EOF {
+			return dropped, devFields, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		kind := sr.kinds[raw.Num]
+		if kind == kindUnknown {
+			if _, kind, err = sr.decodeMessages([]*RawMessage{raw}); err != nil {
+				return nil, 0, err
+			}
+			sr.kinds[raw.Num] = kind
+		}
+
+		if kind == kindDropped {
+			dropped = append(dropped, raw)
+		} else if len(raw.devFields) > 0 {
+			devFields++
+		}
+	}
+}
+
+// readRaw reads the next data message from the file, and the definitions
+// before it
+func (sr *Reader) readRaw() (*RawMessage, error) {
+	for sr.remaining > 0 {
+		offset := sr.offset()
+		hdr, err := sr.readByte()
+		if err != nil {
+			return nil, err
+		}
+
+		if hdr&headerCompressed == 0 && hdr&headerDefinition != 0 {
+			def, err := sr.readDefinition(hdr)
+			if err != nil {
+				return nil, err
+			}
+			sr.defs[hdr&0xf] = def
+			continue
+		}
+
+		local := hdr & 0xf
+		compressed := hdr&headerCompressed != 0
+		if compressed {
+			local = (hdr >> 5) & 0x3
+		}
+
+		def := sr.defs[local]
+		if def == nil {
+			return nil, fmt.Errorf("data message for undefined local type %d", local)
+		}
+
+		data, err := sr.read(def.size + def.devSize)
+		if err != nil {
+			return nil, err
+		}
+		raw := def.rawMessage(hdr, offset, data)
+
+		if compressed {
+			sr.lastTimestamp = sr.compressedTimestamp(uint32(hdr & 0x1f))
+			raw.Timestamp = time.Unix(int64(sr.lastTimestamp)+fitEpoch, 0).UTC()
+		} else if def.timestamp >= 0 {
+			sr.lastTimestamp = def.order.Uint32(raw.data[def.timestamp:])
+			raw.Timestamp = time.Unix(int64(sr.lastTimestamp)+fitEpoch, 0).UTC()
+		}
+
+		return raw, nil
+	}
+
+	return nil, io.EOF
+}
+
+// fileMessages returns the messages in a tiny file after the FileId, and
+// how the fit package keeps them. They're all of the same type.
+func fileMessages(fitf *fit.File) ([]interface{}, msgKind) {
+	if fitf.FileCreator != nil {
+		return []interface{}{fitf.FileCreator}, kindSingle
+	}
+	if fitf.TimestampCorrelation != nil {
+		return []interface{}{fitf.TimestampCorrelation}, kindSingle
+	}
+
+	body := fileBody(fitf)
+	if body == nil {
+		return nil, kindDropped
+	}
+
+	v := reflect.ValueOf(body).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Ptr:
+			if !field.IsNil() {
+				return []interface{}{field.Interface()}, kindSingle
+			}
+		case reflect.Slice:
+			if n := field.Len(); n > 0 {
+				msgs := make([]interface{}, n)
+				for j := range msgs {
+					msgs[j] = field.Index(j).Interface()
+				}
+				return msgs, kindSlice
+			}
+		}
+	}
+
+	return nil, kindDropped
+}
+
+// fileBody returns the fit.XXXFile holding the messages of fitf
+func fileBody(fitf *fit.File) interface{} {
+	var body interface{}
+	var err error
+
+	switch fitf.Type() {
+	case fit.FileTypeActivity:
+		body, err = fitf.Activity()
+	case fit.FileTypeDevice:
+		body, err = fitf.Device()
+	case fit.FileTypeSettings:
+		body, err = fitf.Settings()
+	case fit.FileTypeSport:
+		body, err = fitf.Sport()
+	case fit.FileTypeWorkout:
+		body, err = fitf.Workout()
+	case fit.FileTypeCourse:
+		body, err = fitf.Course()
+	case fit.FileTypeSchedules:
+		body, err = fitf.Schedules()
+	case fit.FileTypeWeight:
+		body, err = fitf.Weight()
+	case fit.FileTypeTotals:
+		body, err = fitf.Totals()
+	case fit.FileTypeGoals:
+		body, err = fitf.Goals()
+	case fit.FileTypeBloodPressure:
+		body, err = fitf.BloodPressure()
+	case fit.FileTypeMonitoringA:
+		body, err = fitf.MonitoringA()
+	case fit.FileTypeActivitySummary:
+		body, err = fitf.ActivitySummary()
+	case fit.FileTypeMonitoringDaily:
+		body, err = fitf.MonitoringDaily()
+	case fit.FileTypeMonitoringB:
+		body, err = fitf.MonitoringB()
+	case fit.FileTypeSegment:
+		body, err = fitf.Segment()
+	case fit.FileTypeSegmentList:
+		body, err = fitf.SegmentList()
+	}
+
+	if err != nil {
+		return nil
+	}
+	return body
+}
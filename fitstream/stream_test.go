@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package fitstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/dyncrc16"
+)
+
+// readAll streams every message from data
+func readAll(t testing.TB, data []byte) (*Reader, []interface{}) {
+	sr, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var msgs []interface{}
+	for {
+		msg, err := sr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return sr, msgs
+}
+
+func TestStreamMatchesDecode(t *testing.T) {
+	for _, name := range []string{"Activity.fit", "Course.fit", "Settings.fit"} {
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile("../fitdump/testdata/" + name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fitf, err := fit.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sr, msgs := readAll(t, data)
+
+			if !reflect.DeepEqual(sr.FileId(), fitf.FileId) {
+				t.Errorf("FileId: got %+v, want %+v", sr.FileId(), fitf.FileId)
+			}
+
+			// Group the streamed messages by type, to compare with
+			// the slices in the decoded file
+			streamed := make(map[reflect.Type][]interface{})
+			for _, m := range msgs {
+				streamed[reflect.TypeOf(m)] = append(streamed[reflect.TypeOf(m)], m)
+			}
+
+			body := reflect.ValueOf(fileBody(fitf)).Elem()
+			for i := 0; i < body.NumField(); i++ {
+				field := body.Field(i)
+				var want []interface{}
+				switch field.Kind() {
+				case reflect.Ptr:
+					if !field.IsNil() {
+						want = append(want, field.Interface())
+					}
+				case reflect.Slice:
+					for j := 0; j < field.Len(); j++ {
+						want = append(want, field.Index(j).Interface())
+					}
+				}
+				if len(want) == 0 {
+					continue
+				}
+
+				got := streamed[reflect.TypeOf(want[0])]
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s: streamed messages differ from decoded ones", body.Type().Field(i).Name)
+				}
+			}
+		})
+	}
+}
+
+func TestNextRawMatchesNext(t *testing.T) {
+	data, err := os.ReadFile("../fitdump/testdata/Activity.fit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sr, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []*RawMessage
+	for {
+		if _, err := sr.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, sr.Raw())
+	}
+
+	sr, err = NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*RawMessage
+	for {
+		raw, err := sr.NextRaw()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, raw)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d raw messages, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("message %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDropped(t *testing.T) {
+	data, err := os.ReadFile("../fitdump/testdata/DevFields.fit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sr, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dropped, devFields, err := sr.Dropped()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var nums []fit.MesgNum
+	for _, m := range dropped {
+		nums = append(nums, m.Num)
+	}
+	want := []fit.MesgNum{fit.MesgNumDeveloperDataId, fit.MesgNumFieldDescription}
+	if !reflect.DeepEqual(nums, want) {
+		t.Errorf("dropped messages %v, want %v", nums, want)
+	}
+	// Every record has developer fields
+	if devFields != 6 {
+		t.Errorf("got %d messages with developer fields, want 6", devFields)
+	}
+}
+
+// compressedFile returns an activity whose records, after the first, have
+// compressed timestamp headers, more of them than fit in a batch
+func compressedFile() []byte {
+	body := []byte{
+		// file_id with only its type, activity
+		0x40, 0, 0, 0, 0, 1, 0, 1, 0,
+		0x00, 4,
+		// record with timestamp and heart rate as local type 1, and
+		// with only the heart rate as local type 2
+		0x41, 0, 0, 20, 0, 2, 253, 4, 0x86, 3, 1, 0x02,
+		0x42, 0, 0, 20, 0, 1, 3, 1, 0x02,
+	}
+
+	ts := uint32(1000)
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], ts)
+	body = append(body, 0x01)
+	body = append(body, b[:]...)
+	body = append(body, 100)
+	for i := 0; i < 300; i++ {
+		ts++
+		body = append(body, 0x80|2<<5|byte(ts&0x1f), byte(100+i%50))
+	}
+
+	header := []byte{12, 0x10, 0, 0, 0, 0, 0, 0, '.', 'F', 'I', 'T'}
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(body)))
+	data := append(header, body...)
+	var crc [2]byte
+	binary.LittleEndian.PutUint16(crc[:], dyncrc16.Checksum(data))
+	return append(data, crc[:]...)
+}
+
+func TestStreamCompressedTimestamps(t *testing.T) {
+	data := compressedFile()
+	fitf, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, msgs := readAll(t, data)
+	if len(msgs) != len(activity.Records) {
+		t.Fatalf("got %d messages, want %d records", len(msgs), len(activity.Records))
+	}
+	for i, m := range msgs {
+		if !reflect.DeepEqual(m, activity.Records[i]) {
+			t.Errorf("record %d: got %+v, want %+v", i, m, activity.Records[i])
+		}
+	}
+}
+
+// benchmarkFile encodes an activity with n records
+func benchmarkFile(b *testing.B, n int) []byte {
+	fitf, err := fit.NewFile(fit.FileTypeActivity, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		b.Fatal(err)
+	}
+	activity, _ := fitf.Activity()
+
+	start := time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC)
+	for i := 0; i < n; i++ {
+		r := fit.NewRecordMsg()
+		r.Timestamp = start.Add(time.Duration(i) * time.Second)
+		r.HeartRate = uint8(100 + i%80)
+		r.Power = uint16(150 + i%200)
+		r.Distance = uint32(i * 700)
+		activity.Records = append(activity.Records, r)
+	}
+
+	var buf bytes.Buffer
+	if err := fit.Encode(&buf, fitf, binary.LittleEndian); err != nil {
+		b.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// benchmarkSizes are the numbers of records in the benchmark files
+var benchmarkSizes = []int{1000, 10000, 100000}
+
+// heapInUse returns the bytes of heap in use by live objects, after a
+// garbage collection
+func heapInUse() uint64 {
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapInuse
+}
+
+// heapInterval is how many messages are read between measurements of the
+// heap in use while streaming
+const heapInterval = 1000
+
+func BenchmarkDecode(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			data := benchmarkFile(b, n)
+
+			decode := func() (*fit.File, int) {
+				fitf, err := fit.Decode(bytes.NewReader(data))
+				if err != nil {
+					b.Fatal(err)
+				}
+				activity, _ := fitf.Activity()
+				power := 0
+				for _, r := range activity.Records {
+					power += int(r.Power)
+				}
+				return fitf, power
+			}
+
+			// The most heap in use is once the whole file has
+			// been decoded
+			base := heapInUse()
+			fitf, _ := decode()
+			peak := heapInUse() - base
+			runtime.KeepAlive(fitf)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				decode()
+			}
+			b.ReportMetric(float64(peak), "heap-B")
+		})
+	}
+}
+
+func BenchmarkStream(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			data := benchmarkFile(b, n)
+
+			// stream reads every message, calling measure every
+			// heapInterval messages if it isn't nil
+			stream := func(measure func()) int {
+				sr, err := NewReader(bytes.NewReader(data))
+				if err != nil {
+					b.Fatal(err)
+				}
+				power := 0
+				for i := 0; ; i++ {
+					msg, err := sr.Next()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						b.Fatal(err)
+					}
+					if r, ok := msg.(*fit.RecordMsg); ok {
+						power += int(r.Power)
+					}
+					if measure != nil && i%heapInterval == 0 {
+						measure()
+					}
+				}
+				return power
+			}
+
+			base := heapInUse()
+			var peak uint64
+			stream(func() {
+				if h := heapInUse() - base; h > peak {
+					peak = h
+				}
+			})
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				stream(nil)
+			}
+			b.ReportMetric(float64(peak), "heap-B")
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// element is any XML element, for the extensions, which every exporter
+// writes a little differently
+type element struct {
+	XMLName  xml.Name
+	Text     string    `xml:",chardata"`
+	Children []element `xml:",any"`
+}
+
+// gpxPoint is a trkpt, rtept or wpt. The tags have no namespace, so they
+// match whichever one the file uses, or none.
+type gpxPoint struct {
+	Lat        float64  `xml:"lat,attr"`
+	Lon        float64  `xml:"lon,attr"`
+	Ele        *float64 `xml:"ele"`
+	Time       string   `xml:"time"`
+	Extensions element  `xml:"extensions"`
+}
+
+type gpxFile struct {
+	Metadata struct {
+		Name string `xml:"name"`
+		Time string `xml:"time"`
+	} `xml:"metadata"`
+	Tracks []struct {
+		Name     string `xml:"name"`
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+	Routes []struct {
+		Name   string     `xml:"name"`
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+}
+
+// point is a GPX point with its extensions picked out. The sensor values
+// are -1 if the point doesn't have them.
+type point struct {
+	lat, lon float64
+	ele      *float64
+	time     time.Time
+
+	hr, cad, power float64
+}
+
+// extensionNames maps the local names of the extension elements used by
+// the common exporters to the sensor they hold
+var extensionNames = map[string]string{
+	"hr":           "hr",
+	"heartrate":    "hr",
+	"cad":          "cad",
+	"cadence":      "cad",
+	"power":        "power",
+	"powerinwatts": "power",
+	"watts":        "power",
+}
+
+// readExtensions fills in the sensor values of p from the extensions, at
+// any depth, ignoring namespaces
+func (p *point) readExtensions(elems []element) {
+	for _, e := range elems {
+		if len(e.Children) > 0 {
+			p.readExtensions(e.Children)
+			continue
+		}
+
+		v, err := strconv.ParseFloat(strings.TrimSpace(e.Text), 64)
+		if err != nil || v < 0 {
+			continue
+		}
+		switch extensionNames[strings.ToLower(e.XMLName.Local)] {
+		case "hr":
+			p.hr = v
+		case "cad":
+			p.cad = v
+		case "power":
+			p.power = v
+		}
+	}
+}
+
+func parseTime(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, strings.TrimSpace(s))
+}
+
+// track is what's read from a GPX file
+type track struct {
+	name string
+	// time is the time in the metadata, or the zero time
+	time   time.Time
+	points []point
+}
+
+// readGPX reads the points of the tracks of a GPX file, or of its routes if
+// it has no tracks
+func readGPX(r io.Reader) (*track, error) {
+	var doc gpxFile
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	trk := &track{name: doc.Metadata.Name}
+	if doc.Metadata.Time != "" {
+		t, err := parseTime(doc.Metadata.Time)
+		if err != nil {
+			return nil, fmt.Errorf("metadata: %v", err)
+		}
+		trk.time = t
+	}
+
+	name := trk.name
+	var raw []gpxPoint
+	for _, t := range doc.Tracks {
+		if name == "" {
+			name = t.Name
+		}
+		for _, seg := range t.Segments {
+			raw = append(raw, seg.Points...)
+		}
+	}
+	if len(raw) == 0 {
+		for _, rte := range doc.Routes {
+			if name == "" {
+				name = rte.Name
+			}
+			raw = append(raw, rte.Points...)
+		}
+	}
+
+	trk.name = name
+	trk.points = make([]point, 0, len(raw))
+	for i, gp := range raw {
+		p := point{lat: gp.Lat, lon: gp.Lon, ele: gp.Ele, hr: -1, cad: -1, power: -1}
+		if gp.Time != "" {
+			t, err := parseTime(gp.Time)
+			if err != nil {
+				return nil, fmt.Errorf("point %d: %v", i, err)
+			}
+			p.time = t
+		}
+		p.readExtensions(gp.Extensions.Children)
+		trk.points = append(trk.points, p)
+	}
+
+	return trk, nil
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// gpx2fit converts the tracks or routes of a GPX file into a FIT course or
+// activity file. The positions, elevations and times become records, along
+// with the heart rate, cadence and power from the extensions written by
+// Garmin, Strava and others. The distance is computed from the positions,
+// and the laps, session and activity from the records. Points without
+// times are given times at a steady speed. Courses can be given course
+// points at the turns, for turn warnings.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitdump"
+	"github.com/usedbytes/fit-tools/internal/cli"
+)
+
+var as = flag.String("as", "course", "Type of FIT file to write: course or activity")
+var speed = flag.Float64("speed", 20, "Speed in km/h to give the points times at, if any of them don't have one")
+var out = flag.String("o", "", "Path to write the FIT file to, gzipped if it ends in .gz")
+var generateTurns = flag.Bool("generate-turns", false, "With -as course, add Left, Right and Straight course points where the track turns, for turn warnings on a device")
+var turnThreshold = flag.Float64("turn-threshold", 30, "With -generate-turns, the smallest change of bearing in degrees which counts as a turn")
+var turnOver = flag.Float64("turn-over", 20, "With -generate-turns, the distance in metres the bearing is measured over before and after each point, so that the turn is sustained")
+var turnSpacing = flag.Float64("turn-spacing", 50, "With -generate-turns, the least distance in metres between the course points. Closer turns are combined into one")
+
+// setTimes gives the points times at a steady speed in km/h from start,
+// going by the distance between them
+func setTimes(points []point, start time.Time, speed float64) {
+	mps := speed / 3.6
+	dist := 0.0
+	for i := range points {
+		if i > 0 {
+			dist += fitdump.DistanceBetween(points[i-1].lat, points[i-1].lon, points[i].lat, points[i].lon)
+		}
+		points[i].time = start.Add(time.Duration(dist / mps * float64(time.Second)))
+	}
+}
+
+// record returns the record for a point, with the distance left to be
+// filled in
+func record(p point) *fit.RecordMsg {
+	r := fit.NewRecordMsg()
+	// FIT timestamps are whole seconds
+	r.Timestamp = p.time.Truncate(time.Second)
+	r.PositionLat = fit.NewLatitudeDegrees(p.lat)
+	r.PositionLong = fit.NewLongitudeDegrees(p.lon)
+
+	if p.ele != nil {
+		// Scale 5, offset 500
+		raw := math.Round((*p.ele + 500) * 5)
+		if raw >= 0 && raw < 0xFFFF {
+			r.Altitude = uint16(raw)
+		}
+		if raw >= 0 && raw < 0xFFFFFFFF {
+			r.EnhancedAltitude = uint32(raw)
+		}
+	}
+	if p.hr >= 0 && p.hr < 0xFF {
+		r.HeartRate = uint8(math.Round(p.hr))
+	}
+	if p.cad >= 0 && p.cad < 0xFF {
+		r.Cadence = uint8(math.Round(p.cad))
+	}
+	if p.power >= 0 && p.power < 0xFFFF {
+		r.Power = uint16(math.Round(p.power))
+	}
+
+	return r
+}
+
+// timerEvents returns the events starting the timer at the first record and
+// stopping it at the last
+func timerEvents(records []*fit.RecordMsg) []*fit.EventMsg {
+	start := fit.NewEventMsg()
+	start.Timestamp = records[0].Timestamp
+	start.Event = fit.EventTimer
+	start.EventType = fit.EventTypeStart
+
+	stop := fit.NewEventMsg()
+	stop.Timestamp = records[len(records)-1].Timestamp
+	stop.Event = fit.EventTimer
+	stop.EventType = fit.EventTypeStopAll
+
+	return []*fit.EventMsg{start, stop}
+}
+
+func run(args []string) error {
+	if err := cli.Check(); err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if len(args) != 1 {
+		return cli.Usagef("Expected a single argument: FILE")
+	}
+
+	if *out == "" {
+		return cli.Usagef("-o is required")
+	}
+
+	var fileType fit.FileType
+	switch *as {
+	case "course":
+		fileType = fit.FileTypeCourse
+	case "activity":
+		fileType = fit.FileTypeActivity
+	default:
+		return cli.Usagef("-as must be 'course' or 'activity'")
+	}
+
+	if *speed <= 0 {
+		return cli.Usagef("-speed must be positive")
+	}
+
+	if *generateTurns && fileType != fit.FileTypeCourse {
+		return cli.Usagef("-generate-turns only works with -as course")
+	}
+	if *turnThreshold <= 0 || *turnThreshold > 180 {
+		return cli.Usagef("-turn-threshold must be between 0 and 180 degrees")
+	}
+	if *turnOver <= 0 {
+		return cli.Usagef("-turn-over must be positive")
+	}
+	if *turnSpacing < 0 {
+		return cli.Usagef("-turn-spacing can't be negative")
+	}
+
+	path := args[0]
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	trk, err := readGPX(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if len(trk.points) == 0 {
+		return fmt.Errorf("%s: no track or route points", path)
+	}
+
+	timed := true
+	for _, p := range trk.points {
+		timed = timed && !p.time.IsZero()
+	}
+	if !timed {
+		start := trk.time
+		if start.IsZero() {
+			start = time.Now()
+		}
+		setTimes(trk.points, start.Truncate(time.Second), *speed)
+		cli.Warnf("%s: not all of the points have a time, so they're given times at %g km/h", path, *speed)
+	}
+
+	records := make([]*fit.RecordMsg, 0, len(trk.points))
+	for _, p := range trk.points {
+		records = append(records, record(p))
+	}
+	fitdump.RecomputeDistance(records)
+
+	fitf, err := fit.NewFile(fileType, fit.NewHeader(fit.V20, true))
+	if err != nil {
+		return err
+	}
+	fitf.FileId.Manufacturer = fit.ManufacturerDevelopment
+	fitf.FileId.TimeCreated = records[0].Timestamp
+
+	laps, session, activityMsg := fitbuild.Summarize(records, fitbuild.Options{})
+
+	switch fileType {
+	case fit.FileTypeCourse:
+		course, _ := fitf.Course()
+		course.Course = fit.NewCourseMsg()
+		course.Course.Name = trk.name
+		course.Laps = laps
+		course.Events = timerEvents(records)
+		course.Records = records
+		if *generateTurns {
+			course.CoursePoints = fitbuild.Turns(records, fitbuild.TurnOptions{
+				Threshold: *turnThreshold,
+				Over:      *turnOver,
+				Spacing:   *turnSpacing,
+			})
+			fmt.Printf("Generated %d turns\n", len(course.CoursePoints))
+		}
+	case fit.FileTypeActivity:
+		activity, _ := fitf.Activity()
+		activity.Activity = activityMsg
+		activity.Sessions = []*fit.SessionMsg{session}
+		activity.Laps = laps
+		activity.Events = timerEvents(records)
+		activity.Records = records
+	}
+
+	if err := cli.WriteFIT(*out, fitf); err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote %s %s: %d records, %.2f km\n", *as, *out, len(records), session.GetTotalDistanceScaled()/1000)
+
+	return nil
+}
+
+func main() {
+
+	err := run(cli.ParseArgs())
+	if err != nil {
+		cli.Error(err)
+	}
+
+	os.Exit(cli.ExitCode(err))
+}
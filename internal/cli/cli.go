@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+// Package cli holds the logging and flags shared by all of the fit-tools
+// commands. Importing it registers -quiet, -v/-verbose, -color, -missing and
+// -units on the default flag set, along with the decode flags used by Decode.
+// Commands which write a changed copy of a FIT file add -unknown with
+// RegisterUnknown.
+//
+// Warnings and debug output go to stderr, so they never mix with the data
+// written to stdout. The verbosity flags only change what gets printed, never
+// the exit code.
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+var quiet = flag.Bool("quiet", false, "Suppress warnings. Errors are still printed")
+var colorFlag = flag.String("color", "auto", "When to use colour: auto, always or never. auto respects NO_COLOR")
+var missing = flag.String("missing", "-", "Text printed for values which can't be computed, such as the pace when stopped: - or n/a. They're null in JSON")
+var units = flag.String("units", "metric", "Units for values without a flag of their own: metric, or imperial for temperatures in Fahrenheit. Speeds have -speed-unit where it's supported")
+var verbose bool
+
+func init() {
+	flag.BoolVar(&verbose, "v", false, "Print debug detail, such as skipped fields (shorthand for -verbose)")
+	flag.BoolVar(&verbose, "verbose", false, "Print debug detail, such as skipped fields")
+}
+
+const (
+	colorRed    = "31"
+	colorYellow = "33"
+	colorGrey   = "90"
+)
+
+// Verbose reports whether -v/-verbose was given
+func Verbose() bool {
+	return verbose
+}
+
+// Color reports whether colour should be used when writing to f. An explicit
+// -color=always or -color=never wins, otherwise colour is used only if f is
+// a terminal and NO_COLOR isn't set (https://no-color.org).
+func Color(f *os.File) bool {
+	switch *colorFlag {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Colorize wraps s in the ANSI escape for code, if colour is enabled for f
+func Colorize(f *os.File, code, s string) string {
+	if !Color(f) {
+		return s
+	}
+	return "\x1b[" + code + "m" + s + "\x1b[0m"
+}
+
+func printf(code, prefix, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	fmt.Fprintln(os.Stderr, Colorize(os.Stderr, code, prefix+strings.TrimSuffix(msg, "\n")))
+}
+
+// Error prints err to stderr. It's printed regardless of -quiet.
+func Error(err error) {
+	printf(colorRed, "", "%v", err)
+}
+
+// ErrorJSON prints err to stderr as {"error": "..."}, for commands writing
+// JSON, whose consumers would choke on plain text. Like Error, it ignores
+// -quiet.
+func ErrorJSON(err error) {
+	json.NewEncoder(os.Stderr).Encode(struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+// Warnf prints a warning to stderr, unless -quiet was given
+func Warnf(format string, args ...interface{}) {
+	if *quiet {
+		return
+	}
+	printf(colorYellow, "warning: ", format, args...)
+}
+
+// Debugf prints debug detail to stderr, only if -verbose was given
+func Debugf(format string, args ...interface{}) {
+	if !verbose {
+		return
+	}
+	printf(colorGrey, "", format, args...)
+}
+
+// DebugFunc returns Debugf if -verbose was given, otherwise nil. It suits
+// optional callbacks, like fitdump.Options.Debugf, where nil means nothing
+// gets formatted at all.
+func DebugFunc() func(format string, args ...interface{}) {
+	if !verbose {
+		return nil
+	}
+	return Debugf
+}
+
+// debugLogger prints the decoder's log like Debugf, but whether or not
+// -verbose was given, so that -logging can enable it alone
+type debugLogger struct{}
+
+func (debugLogger) Print(args ...interface{})                 { printf(colorGrey, "", "%s", fmt.Sprint(args...)) }
+func (debugLogger) Printf(format string, args ...interface{}) { printf(colorGrey, "", format, args...) }
+func (debugLogger) Println(args ...interface{})               { printf(colorGrey, "", "%s", fmt.Sprintln(args...)) }
+
+// ParseArgs parses the command line, allowing flags after the arguments
+// too, as in "fit-fix in.fit -o out.fit", and returns the arguments.
+// Commands call it instead of flag.Parse(), and use what it returns rather
+// than flag.Args().
+func ParseArgs() []string {
+	return parseArgs(os.Args[1:])
+}
+
+// parseArgs parses cmdline with the default flag set, like ParseArgs
+func parseArgs(cmdline []string) []string {
+	// The default flag set exits on errors, like flag.Parse
+	flag.CommandLine.Parse(cmdline)
+
+	var args []string
+	for rest := flag.Args(); len(rest) > 0; rest = flag.Args() {
+		args = append(args, rest[0])
+		// The default flag set exits on errors, like flag.Parse
+		flag.CommandLine.Parse(rest[1:])
+	}
+	return args
+}
+
+// Check validates the shared flags.
+// Commands should call it after parsing the command line.
+func Check() error {
+	switch *colorFlag {
+	case "auto", "always", "never":
+	default:
+		return fmt.Errorf("-color must be 'auto', 'always' or 'never'")
+	}
+
+	if _, err := fitdump.ParseMissing(*missing); err != nil {
+		return fmt.Errorf("-missing: %v", err)
+	}
+	if _, err := fitdump.ParseUnits(*units); err != nil {
+		return fmt.Errorf("-units: %v", err)
+	}
+	if unknownFlag != nil {
+		switch *unknownFlag {
+		case "keep", "append", "drop":
+		default:
+			return fmt.Errorf("-unknown must be 'keep' or 'drop'")
+		}
+	}
+	return nil
+}
+
+// Options returns the fitdump.Options set by the shared flags, -missing and
+// -units, for commands to add their own options to. Check must have
+// accepted the flags.
+func Options() fitdump.Options {
+	text, _ := fitdump.ParseMissing(*missing)
+	system, _ := fitdump.ParseUnits(*units)
+	return fitdump.Options{Missing: text, Units: system}
+}
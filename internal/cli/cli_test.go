@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseArgsInterspersed(t *testing.T) {
+	defer func() { *quiet, *units = false, "metric" }()
+
+	args := parseArgs([]string{"-quiet", "a.fit", "-units", "imperial", "b.fit"})
+	if want := []string{"a.fit", "b.fit"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("got arguments %q, want %q", args, want)
+	}
+	if !*quiet || *units != "imperial" {
+		t.Errorf("flags after the first argument weren't parsed: -quiet %v, -units %q", *quiet, *units)
+	}
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package cli
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tormoder/fit"
+	"github.com/tormoder/fit/dyncrc16"
+	"github.com/usedbytes/fit-tools/fitdump"
+)
+
+var unknownMessages = flag.Bool("unknown-messages", false, "Keep the messages the fit library doesn't know, where a command shows them")
+var unknownFields = flag.Bool("unknown-fields", false, "Keep the fields the fit library doesn't know, where a command shows them")
+var brokenCRC = flag.Bool("broken-crc", false, "Decode files whose header or file CRC doesn't match, with a warning, instead of failing")
+var logging = flag.Bool("logging", false, "Print the fit library's decoder log to stderr, without the rest of -verbose")
+
+// fixCRC corrects the header and file CRCs of the FIT file in data, so that
+// the decoder accepts it. It reports whether either was wrong. Truncated
+// files are left alone, as they'd fail anyway.
+func fixCRC(data []byte) bool {
+	if len(data) < 12 {
+		return false
+	}
+	hdrSize := int(data[0])
+	size := hdrSize + int(binary.LittleEndian.Uint32(data[4:8]))
+	if hdrSize < 12 || len(data) < size+2 {
+		return false
+	}
+
+	fixed := false
+	// A header CRC of 0 means it wasn't computed, which is always valid
+	if hdrSize >= 14 {
+		crc := binary.LittleEndian.Uint16(data[12:14])
+		if crc != 0 && crc != dyncrc16.Checksum(data[:12]) {
+			binary.LittleEndian.PutUint16(data[12:14], 0)
+			fixed = true
+		}
+	}
+
+	crc := dyncrc16.Checksum(data[:size])
+	if binary.LittleEndian.Uint16(data[size:size+2]) != crc {
+		binary.LittleEndian.PutUint16(data[size:size+2], crc)
+		fixed = true
+	}
+
+	return fixed
+}
+
+// warningLogger collects the warnings from the decoder's log, such as a
+// compressed timestamp without a time to follow on from, which the fit
+// library has no other way to report. The whole log is passed on to next, if
+// it isn't nil.
+type warningLogger struct {
+	next     fit.Logger
+	warnings []string
+}
+
+func (l *warningLogger) add(msg string) {
+	if before, after, ok := strings.Cut(msg, "warning: "); ok {
+		l.warnings = append(l.warnings, strings.TrimSpace(before+after))
+	}
+}
+
+func (l *warningLogger) Print(args ...interface{}) {
+	l.add(fmt.Sprint(args...))
+	if l.next != nil {
+		l.next.Print(args...)
+	}
+}
+
+func (l *warningLogger) Printf(format string, args ...interface{}) {
+	l.add(fmt.Sprintf(format, args...))
+	if l.next != nil {
+		l.next.Printf(format, args...)
+	}
+}
+
+func (l *warningLogger) Println(args ...interface{}) {
+	l.add(fmt.Sprintln(args...))
+	if l.next != nil {
+		l.next.Println(args...)
+	}
+}
+
+// printWarnings prints the decoder's warnings to stderr as a Warnings:
+// section, each once with how many times it was repeated, unless -quiet was
+// given
+func printWarnings(warnings []string) {
+	if *quiet || len(warnings) == 0 {
+		return
+	}
+
+	var order []string
+	counts := make(map[string]int)
+	for _, w := range warnings {
+		if counts[w] == 0 {
+			order = append(order, w)
+		}
+		counts[w]++
+	}
+
+	printf(colorYellow, "", "Warnings:")
+	for _, w := range order {
+		if n := counts[w]; n > 1 {
+			w = fmt.Sprintf("%s (%d times)", w, n)
+		}
+		printf(colorYellow, "\t", "%s", w)
+	}
+}
+
+// Decode decodes a FIT file with the options from the decode flags, plus
+// any extra ones the command needs. The warnings from the decoder, for files
+// which decode but not cleanly, are printed to stderr afterwards.
+func Decode(r io.Reader, extra ...fit.DecodeOption) (*fit.File, error) {
+	return decode(r, nil, extra...)
+}
+
+// DecodeExtras decodes a FIT file as Decode does, and collects the
+// fitdump.Extras from the same read of it, rather than reading it again.
+// The extras are nil, with a warning, if they can't be read.
+func DecodeExtras(r io.Reader, extra ...fit.DecodeOption) (*fit.File, *fitdump.Extras, error) {
+	pr, pw := io.Pipe()
+	var extras *fitdump.Extras
+	var xerr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		extras, xerr = fitdump.ReadExtras(pr)
+		// Keep reading after an error, so that the decode never blocks
+		// on the pipe
+		io.Copy(io.Discard, pr)
+	}()
+
+	fitf, err := decode(r, pw, extra...)
+	pw.Close()
+	<-done
+	if err != nil {
+		return fitf, nil, err
+	}
+
+	if xerr != nil {
+		Warnf("can't read respiration rate, SpO2 and developer fields: %v", xerr)
+		extras = nil
+	}
+	return fitf, extras, nil
+}
+
+// decode is Decode, copying what the decoder reads to tee if it isn't nil
+func decode(r io.Reader, tee io.Writer, extra ...fit.DecodeOption) (*fit.File, error) {
+	logger := &warningLogger{}
+	if verbose || *logging {
+		logger.next = debugLogger{}
+	}
+	opts := append([]fit.DecodeOption{fit.WithLogger(logger)}, extra...)
+	if *unknownMessages {
+		opts = append(opts, fit.WithUnknownMessages())
+	}
+	if *unknownFields {
+		opts = append(opts, fit.WithUnknownFields())
+	}
+
+	if *brokenCRC {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		if fixCRC(data) {
+			Warnf("ignoring a CRC which doesn't match")
+		}
+		r = bytes.NewReader(data)
+	}
+	if tee != nil {
+		r = io.TeeReader(r, tee)
+	}
+
+	fitf, err := fit.Decode(r, opts...)
+	printWarnings(logger.warnings)
+	return fitf, err
+}
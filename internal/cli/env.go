@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// splitArgs splits s into arguments like a shell would: on whitespace,
+// except inside single or double quotes, and with backslash escaping the
+// next character outside single quotes.
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			arg.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+
+	return args, nil
+}
+
+// ParseWithEnv parses the command line like ParseArgs, after the flags in
+// the environment variable env, so that it can hold defaults. Flags given
+// on the command line come later, so they override the defaults.
+func ParseWithEnv(env string) []string {
+	defaults, err := splitArgs(os.Getenv(env))
+	if err != nil {
+		Error(fmt.Errorf("%s: %v", env, err))
+		os.Exit(ExitUsage)
+	}
+
+	return parseArgs(append(defaults, os.Args[1:]...))
+}
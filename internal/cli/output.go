@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitbuild"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+// unknownFlag is -unknown, or nil for commands which don't register it
+var unknownFlag *string
+
+// RegisterUnknown registers -unknown on the default flag set, for the
+// commands which write a changed copy of a FIT file with DecodeForWrite. It
+// must be called before the command line is parsed. The other commands
+// don't have it, as it would do nothing for them.
+func RegisterUnknown() {
+	unknownFlag = flag.String("unknown", "keep", "What to do with the messages the fit library can't write back, such as those it doesn't know and developer data: "+
+		"keep them, appended after the others, so they're no longer in their original order, and re-encoded with a timestamp field "+
+		"if they had a compressed timestamp; or drop them. append is the same as keep")
+}
+
+// output is a file being written, through gzip if its name ends in .gz
+type output struct {
+	f  *os.File
+	gz *gzip.Writer
+}
+
+func (o *output) Write(p []byte) (int, error) {
+	if o.gz != nil {
+		return o.gz.Write(p)
+	}
+	return o.f.Write(p)
+}
+
+// Close finishes the gzip stream, if there is one, and closes the file
+func (o *output) Close() error {
+	if o.gz != nil {
+		if err := o.gz.Close(); err != nil {
+			o.f.Close()
+			return err
+		}
+	}
+	return o.f.Close()
+}
+
+// Create creates the output file at path. If path ends in .gz, what's
+// written to it is compressed with gzip. The gzip header has no name or
+// modification time, so the same data always gives the same file. Close
+// must be called to finish the file.
+func Create(path string) (io.WriteCloser, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	o := &output{f: f}
+	if strings.HasSuffix(path, ".gz") {
+		o.gz = gzip.NewWriter(f)
+	}
+	return o, nil
+}
+
+// WriteFIT writes fitf to the file at path, gzipped if it ends in .gz, with
+// the raw messages after its own, such as the unknown ones from
+// DecodeForWrite. The same file always gives the same bytes, see
+// fitbuild.Encode.
+func WriteFIT(path string, fitf *fit.File, raw ...*fitstream.RawMessage) error {
+	o, err := Create(path)
+	if err != nil {
+		return err
+	}
+	if err := fitbuild.Encode(o, fitf, raw...); err != nil {
+		o.Close()
+		return err
+	}
+	return o.Close()
+}
+
+// DecodeForWrite decodes a FIT file which the command is going to write a
+// changed copy of, as Decode does. fit.Decode leaves some messages out of
+// the fit.File, so they'd be lost when it's encoded again: the ones the fit
+// package doesn't know, the developer data IDs and field descriptions, and
+// the ones it doesn't keep for the type of file, see fitstream's Dropped.
+// With -unknown keep, the default, they're returned as they are in the file,
+// for WriteFIT to append. With -unknown drop, how many were dropped, and
+// their message numbers, are printed as a warning. The developer fields of
+// the other messages can't be written back at all, so there's always a
+// warning for those. Commands using it should call RegisterUnknown.
+func DecodeForWrite(r io.Reader, extra ...fit.DecodeOption) (*fit.File, []*fitstream.RawMessage, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fitf, err := Decode(bytes.NewReader(data), extra...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	drop := unknownFlag != nil && *unknownFlag == "drop"
+
+	sr, err := fitstream.NewReader(bytes.NewReader(data))
+	var dropped []*fitstream.RawMessage
+	var devFields int
+	if err == nil {
+		dropped, devFields, err = sr.Dropped()
+	}
+	if err != nil {
+		if drop {
+			Warnf("can't check for messages which can't be written back: %v", err)
+			return fitf, nil, nil
+		}
+		return nil, nil, fmt.Errorf("can't read the messages the fit package doesn't keep to append them, -unknown drop leaves them out: %v", err)
+	}
+
+	if devFields > 0 {
+		Warnf("dropped the developer fields of %d messages, which can't be written back", devFields)
+	}
+
+	if drop && len(dropped) > 0 {
+		seen := make(map[fit.MesgNum]bool)
+		var nums []string
+		for _, m := range dropped {
+			if !seen[m.Num] {
+				nums = append(nums, fmt.Sprint(uint16(m.Num)))
+				seen[m.Num] = true
+			}
+		}
+		Warnf("dropped %d messages the fit package doesn't keep, numbers %s", len(dropped), strings.Join(nums, ", "))
+		return fitf, nil, nil
+	}
+
+	return fitf, dropped, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/tormoder/fit"
+	"github.com/usedbytes/fit-tools/fitstream"
+)
+
+func TestDecodeForWriteDevFields(t *testing.T) {
+	RegisterUnknown()
+	defer func() { unknownFlag = nil }()
+
+	f, err := os.Open("../../fitdump/testdata/DevFields.fit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	fitf, raw, err := DecodeForWrite(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	activity, err := fitf.Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := len(activity.Records)
+
+	out := filepath.Join(t.TempDir(), "out.fit")
+	if err := WriteFIT(out, fitf, raw...); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := fit.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if activity, err := written.Activity(); err != nil || len(activity.Records) != records {
+		t.Errorf("written file lost its records: %v", err)
+	}
+
+	// The developer's ID and field descriptions are kept, though the
+	// developer fields of the records can't be
+	sr, err := fitstream.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dropped, _, err := sr.Dropped()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nums []fit.MesgNum
+	for _, m := range dropped {
+		nums = append(nums, m.Num)
+	}
+	want := []fit.MesgNum{fit.MesgNumDeveloperDataId, fit.MesgNumFieldDescription}
+	if !reflect.DeepEqual(nums, want) {
+		t.Errorf("written file has messages %v, want %v", nums, want)
+	}
+
+	*unknownFlag = "drop"
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, raw, err := DecodeForWrite(f); err != nil || len(raw) != 0 {
+		t.Errorf("-unknown drop: got %d messages to append, error %v", len(raw), err)
+	}
+}
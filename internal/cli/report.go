@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2020 Brian Starkey <stark3y@gmail.com>
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/tormoder/fit"
+)
+
+// Exit codes of the commands which take several files
+const (
+	// ExitOK means every file was processed
+	ExitOK = 0
+	// ExitFailed means at least one file failed
+	ExitFailed = 1
+	// ExitUsage means the command line was wrong, so nothing was done
+	ExitUsage = 2
+)
+
+type usageError struct {
+	error
+}
+
+// Usagef returns an error for a mistake on the command line, which ExitCode
+// maps to ExitUsage
+func Usagef(format string, args ...interface{}) error {
+	return usageError{fmt.Errorf(format, args...)}
+}
+
+// ExitCode returns the exit code for the error returned by a command
+func ExitCode(err error) int {
+	var usage usageError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.As(err, &usage):
+		return ExitUsage
+	}
+	return ExitFailed
+}
+
+// ReportEntry is the outcome for one file of a batch
+type ReportEntry struct {
+	File            string `json:"file"`
+	Status          string `json:"status"`
+	Error           string `json:"error,omitempty"`
+	MessagesDecoded int    `json:"messages_decoded"`
+}
+
+// Report records the outcome of each file of a batch as a JSON array. The
+// whole file is rewritten after every entry, replacing it atomically, so it
+// is always valid and complete up to the last file finished even if the
+// run is interrupted.
+//
+// A nil *Report discards everything, so commands can use one whether or not
+// a report was asked for.
+type Report struct {
+	path    string
+	entries []ReportEntry
+}
+
+// NewReport returns a Report writing to path, or nil if path is empty. The
+// file is created straight away, holding an empty array.
+func NewReport(path string) (*Report, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	r := &Report{path: path, entries: []ReportEntry{}}
+	return r, r.write()
+}
+
+// Add records the outcome for file. err is nil if it succeeded.
+func (r *Report) Add(file string, messages int, err error) error {
+	if r == nil {
+		return nil
+	}
+
+	entry := ReportEntry{File: file, Status: "ok", MessagesDecoded: messages}
+	if err != nil {
+		entry.Status = "failed"
+		entry.Error = err.Error()
+	}
+	r.entries = append(r.entries, entry)
+
+	return r.write()
+}
+
+// Messages counts the messages of fitf for a report: its FileId and
+// FileCreator, and those of body, its decoded contents such as a
+// *fit.ActivityFile or a fit.ActivityFile, if that isn't nil
+func Messages(fitf *fit.File, body interface{}) int {
+	messages := 1
+	if fitf.FileCreator != nil {
+		messages++
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(body))
+	if v.Kind() != reflect.Struct {
+		return messages
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		switch field := v.Field(i); field.Kind() {
+		case reflect.Slice:
+			messages += field.Len()
+		case reflect.Ptr:
+			if !field.IsNil() {
+				messages++
+			}
+		}
+	}
+	return messages
+}
+
+func (r *Report) write() error {
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), ".report-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	enc := json.NewEncoder(tmp)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(r.entries); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), r.path)
+}